@@ -0,0 +1,61 @@
+// Package cachecodec encodes protobuf messages for memcached.
+//
+// An entry is the protobuf encoding of a message behind a two byte header:
+// a zero byte, which JSON text never starts with, and the schema version of
+// the message. Entries of another schema version don't decode, so a service
+// changing a cached message in an incompatible way bumps its version and
+// entries written by older replicas are read as misses. Entries without the
+// header were written as JSON before the codec was used and are decoded by
+// a fallback while they are still around.
+package cachecodec
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/golang/protobuf/proto"
+)
+
+const headerMagic = 0x00
+
+// ErrUndecodable is returned for entries that can't be decoded, which
+// callers treat as cache misses.
+var ErrUndecodable = errors.New("cachecodec: undecodable cache entry")
+
+// Codec encodes and decodes cache entries of one schema version.
+type Codec struct {
+	Version byte
+	// Legacy decodes entries without a header into msg, if set. Entries
+	// without a header are undecodable otherwise.
+	Legacy func(value []byte, msg proto.Message) error
+}
+
+// Encode returns the cache entry of msg
+func (c Codec) Encode(msg proto.Message) ([]byte, error) {
+	b, err := proto.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte{headerMagic, c.Version}, b...), nil
+}
+
+// Decode decodes a cache entry into msg. It returns an error wrapping
+// ErrUndecodable if the entry can't be decoded, msg must not be used then.
+func (c Codec) Decode(value []byte, msg proto.Message) error {
+	if len(value) == 0 || value[0] != headerMagic {
+		if c.Legacy == nil {
+			return fmt.Errorf("%w: no header", ErrUndecodable)
+		}
+		if err := c.Legacy(value, msg); err != nil {
+			return fmt.Errorf("%w: legacy entry: %v", ErrUndecodable, err)
+		}
+		return nil
+	}
+	if len(value) < 2 || value[1] != c.Version {
+		return fmt.Errorf("%w: schema version is not %d", ErrUndecodable, c.Version)
+	}
+	if err := proto.Unmarshal(value[2:], msg); err != nil {
+		return fmt.Errorf("%w: %v", ErrUndecodable, err)
+	}
+	return nil
+}
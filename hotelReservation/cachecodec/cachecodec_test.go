@@ -0,0 +1,84 @@
+package cachecodec
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	rate "github.com/harlow/go-micro-services/services/rate/proto"
+)
+
+func jsonLegacy(value []byte, msg proto.Message) error {
+	return json.Unmarshal(value, msg)
+}
+
+func TestRoundTrip(t *testing.T) {
+	c := Codec{Version: 3}
+	plan := &rate.RatePlan{HotelId: "1", Code: "RACK", InDate: "2015-04-09", RoomType: &rate.RoomType{Code: "KNG", BookableRate: 109}}
+
+	value, err := c.Encode(plan)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if value[0] != headerMagic || value[1] != 3 {
+		t.Fatalf("entry starts with %v, want the header of version 3", value[:2])
+	}
+	decoded := new(rate.RatePlan)
+	if err := c.Decode(value, decoded); err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(decoded, plan) {
+		t.Errorf("decoded %v, want %v", decoded, plan)
+	}
+}
+
+func TestUndecodable(t *testing.T) {
+	entry, err := Codec{Version: 1}.Encode(&rate.RatePlan{HotelId: "1", Code: "RACK"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, test := range []struct {
+		name  string
+		codec Codec
+		value []byte
+	}{
+		{"other version", Codec{Version: 2}, entry},
+		{"empty", Codec{Version: 1}, []byte{}},
+		{"header only", Codec{Version: 1}, []byte{headerMagic}},
+		{"truncated", Codec{Version: 1}, entry[:len(entry)-1]},
+		{"garbage", Codec{Version: 1}, []byte{headerMagic, 1, 0xff, 0xff, 0xff}},
+		{"no header without legacy", Codec{Version: 1}, []byte(`{"hotelId":"1"}`)},
+		{"bad legacy entry", Codec{Version: 1, Legacy: jsonLegacy}, []byte(`{"hotelId":`)},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.codec.Decode(test.value, new(rate.RatePlan))
+			if !errors.Is(err, ErrUndecodable) {
+				t.Errorf("err = %v, want ErrUndecodable", err)
+			}
+		})
+	}
+}
+
+func TestLegacy(t *testing.T) {
+	c := Codec{Version: 1, Legacy: jsonLegacy}
+
+	plan := new(rate.RatePlan)
+	if err := c.Decode([]byte(`{"hotelId":"1","code":"RACK","roomType":{"code":"KNG"}}`), plan); err != nil {
+		t.Fatal(err)
+	}
+	if plan.HotelId != "1" || plan.Code != "RACK" || plan.RoomType.GetCode() != "KNG" {
+		t.Errorf("decoded %v", plan)
+	}
+
+	// entries with a header never go to the fallback
+	value, err := c.Encode(&rate.RatePlan{HotelId: "2"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	plan = new(rate.RatePlan)
+	if err := c.Decode(value, plan); err != nil || plan.HotelId != "2" {
+		t.Errorf("decoded %v, err = %v", plan, err)
+	}
+}
@@ -1,17 +1,17 @@
 package main
 
 import (
-	"strconv"
+	"encoding/json"
 
+	"github.com/harlow/go-micro-services/data"
 	"github.com/rs/zerolog/log"
 	"gopkg.in/mgo.v2"
-	"gopkg.in/mgo.v2/bson"
 )
 
 type point struct {
-	Pid  string  `bson:"hotelId"`
-	Plat float64 `bson:"lat"`
-	Plon float64 `bson:"lon"`
+	Pid  string  `bson:"hotelId" json:"hotelId"`
+	Plat float64 `bson:"lat" json:"lat"`
+	Plon float64 `bson:"lon" json:"lon"`
 }
 
 func initializeDatabase(url string) *mgo.Session {
@@ -23,91 +23,33 @@ func initializeDatabase(url string) *mgo.Session {
 	log.Info().Msg("New session successfull...")
 
 	log.Info().Msg("Generating test data...")
-	c := session.DB("geo-db").C("geo")
-	count, err := c.Find(&bson.M{"hotelId": "1"}).Count()
+	points := make([]point, 0)
+	err = json.Unmarshal(data.MustAsset("data/geo.json"), &points)
 	if err != nil {
 		log.Fatal().Msg(err.Error())
 	}
-	if count == 0 {
-		err = c.Insert(&point{"1", 37.7867, -122.4112})
-		if err != nil {
-			log.Fatal().Msg(err.Error())
-		}
-	}
 
-	count, err = c.Find(&bson.M{"hotelId": "2"}).Count()
-	if err != nil {
-		log.Fatal().Msg(err.Error())
-	}
-	if count == 0 {
-		err = c.Insert(&point{"2", 37.7854, -122.4005})
-		if err != nil {
-			log.Fatal().Msg(err.Error())
-		}
-	}
-
-	count, err = c.Find(&bson.M{"hotelId": "3"}).Count()
-	if err != nil {
-		log.Fatal().Msg(err.Error())
-	}
-	if count == 0 {
-		err = c.Insert(&point{"3", 37.7854, -122.4071})
-		if err != nil {
-			log.Fatal().Msg(err.Error())
-		}
-	}
-
-	count, err = c.Find(&bson.M{"hotelId": "4"}).Count()
-	if err != nil {
-		log.Fatal().Msg(err.Error())
-	}
-	if count == 0 {
-		err = c.Insert(&point{"4", 37.7936, -122.3930})
-		if err != nil {
-			log.Fatal().Msg(err.Error())
-		}
-	}
-
-	count, err = c.Find(&bson.M{"hotelId": "5"}).Count()
-	if err != nil {
-		log.Fatal().Msg(err.Error())
-	}
-	if count == 0 {
-		err = c.Insert(&point{"5", 37.7831, -122.4181})
-		if err != nil {
-			log.Fatal().Msg(err.Error())
-		}
-	}
-
-	count, err = c.Find(&bson.M{"hotelId": "6"}).Count()
+	// locations are added and removed at runtime, so only seed an empty
+	// collection, lest removed hotels come back at every start
+	c := session.DB("geo-db").C("geo")
+	count, err := c.Count()
 	if err != nil {
 		log.Fatal().Msg(err.Error())
 	}
 	if count == 0 {
-		err = c.Insert(&point{"6", 37.7863, -122.4015})
-		if err != nil {
-			log.Fatal().Msg(err.Error())
-		}
-	}
-
-	// add up to 80 hotels
-	for i := 7; i <= 80; i++ {
-		hotel_id := strconv.Itoa(i)
-		count, err = c.Find(&bson.M{"hotelId": hotel_id}).Count()
-		if err != nil {
-			log.Fatal().Msg(err.Error())
-		}
-		lat := 37.7835 + float64(i)/500.0*3
-		lon := -122.41 + float64(i)/500.0*4
-		if count == 0 {
-			err = c.Insert(&point{hotel_id, lat, lon})
+		for _, p := range points {
+			err = c.Insert(&p)
 			if err != nil {
 				log.Fatal().Msg(err.Error())
 			}
 		}
 	}
 
-	err = c.EnsureIndexKey("hotelId")
+	// a hotel has one location
+	err = c.EnsureIndex(mgo.Index{
+		Key:    []string{"hotelId"},
+		Unique: true,
+	})
 	if err != nil {
 		log.Fatal().Msg(err.Error())
 	}
@@ -33,11 +33,21 @@ func main() {
 	var result map[string]string
 	json.Unmarshal([]byte(byteValue), &result)
 
-	log.Info().Msgf("Read database URL: %v", result["GeoMongoAddress"])
-	log.Info().Msg("Initializing DB connection...")
-	mongo_session := initializeDatabase(result["GeoMongoAddress"])
-	defer mongo_session.Close()
-	log.Info().Msg("Successfull")
+	var store geo.GeoStore
+	switch result["storage"] {
+	case "memory":
+		log.Info().Msg("Using in-memory storage")
+		store = geo.NewMemoryStore()
+	case "", "mongodb":
+		log.Info().Msgf("Read database URL: %v", result["GeoMongoAddress"])
+		log.Info().Msg("Initializing DB connection...")
+		mongo_session := initializeDatabase(result["GeoMongoAddress"])
+		defer mongo_session.Close()
+		log.Info().Msg("Successfull")
+		store = geo.NewMongoStore(mongo_session)
+	default:
+		log.Fatal().Msgf("Unknown storage: %v", result["storage"])
+	}
 
 	serv_port, _ := strconv.Atoi(result["GeoPort"])
 	serv_ip := result["GeoIP"]
@@ -69,11 +79,11 @@ func main() {
 
 	srv := &geo.Server{
 		// Port:     *port,
-		Port:         serv_port,
-		IpAddr:       serv_ip,
-		Tracer:       tracer,
-		Registry:     registry,
-		MongoSession: mongo_session,
+		Port:     serv_port,
+		IpAddr:   serv_ip,
+		Tracer:   tracer,
+		Registry: registry,
+		Store:    store,
 	}
 
 	log.Info().Msg("Starting server...")
@@ -7,12 +7,14 @@ import (
 	"os"
 	"strconv"
 
+	"github.com/harlow/go-micro-services/media"
 	"github.com/harlow/go-micro-services/registry"
 	"github.com/harlow/go-micro-services/services/profile"
 	"github.com/harlow/go-micro-services/tracing"
 	"github.com/harlow/go-micro-services/tune"
 	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/log"
+	"gopkg.in/mgo.v2"
 
 	"time"
 )
@@ -34,16 +36,49 @@ func main() {
 	var result map[string]string
 	json.Unmarshal([]byte(byteValue), &result)
 
-	log.Info().Msgf("Read database URL: %v", result["ProfileMongoAddress"])
-	log.Info().Msg("Initializing DB connection...")
-	mongo_session := initializeDatabase(result["ProfileMongoAddress"])
-	defer mongo_session.Close()
-	log.Info().Msg("Successfull")
+	var store profile.ProfileStore
+	var mongo_session *mgo.Session
+	var memc_client *tune.MemCClient
+	switch result["storage"] {
+	case "memory":
+		log.Info().Msg("Using in-memory storage, without memcached")
+		store = profile.NewMemoryStore()
+	case "", "mongodb":
+		log.Info().Msgf("Read database URL: %v", result["ProfileMongoAddress"])
+		log.Info().Msg("Initializing DB connection...")
+		mongo_session = initializeDatabase(result["ProfileMongoAddress"])
+		defer mongo_session.Close()
+		log.Info().Msg("Successfull")
+		store = profile.NewMongoStore(mongo_session)
+
+		log.Info().Msgf("Read profile memcashed address: %v", result["ProfileMemcAddress"])
+		log.Info().Msg("Initializing Memcashed client...")
+		memc_client = tune.NewMemCClient(result["ProfileMemcAddress"])
+		log.Info().Msg("Successfull")
+	default:
+		log.Fatal().Msgf("Unknown storage: %v", result["storage"])
+	}
 
-	log.Info().Msgf("Read profile memcashed address: %v", result["ProfileMemcAddress"])
-	log.Info().Msg("Initializing Memcashed client...")
-	memc_client := tune.NewMemCClient(result["ProfileMemcAddress"])
-	log.Info().Msg("Successfull")
+	var images media.BlobStore
+	switch result["ImageStore"] {
+	case "", "file":
+		if result["ImageStoreDir"] == "" {
+			log.Fatal().Msg("Storing hotel images in files needs an ImageStoreDir")
+		}
+		log.Info().Msgf("Storing hotel images under %v", result["ImageStoreDir"])
+		images = media.NewFileStore(result["ImageStoreDir"])
+	case "gridfs":
+		if mongo_session == nil {
+			log.Fatal().Msg("Storing hotel images in GridFS needs mongodb storage")
+		}
+		log.Info().Msg("Storing hotel images in GridFS")
+		images = media.NewGridFSStore(mongo_session, "profile-db")
+	case "memory":
+		log.Info().Msg("Storing hotel images in memory")
+		images = media.NewMemoryStore()
+	default:
+		log.Fatal().Msgf("Unknown image store: %v", result["ImageStore"])
+	}
 
 	serv_port, _ := strconv.Atoi(result["ProfilePort"])
 	serv_ip := result["ProfileIP"]
@@ -75,11 +110,12 @@ func main() {
 	srv := profile.Server{
 		Tracer: tracer,
 		// Port:     *port,
-		Registry:     registry,
-		Port:         serv_port,
-		IpAddr:       serv_ip,
-		MongoSession: mongo_session,
-		MemcClient:   memc_client,
+		Registry:   registry,
+		Port:       serv_port,
+		IpAddr:     serv_ip,
+		Store:      store,
+		MemcClient: memc_client,
+		Images:     images,
 	}
 
 	log.Info().Msg("Starting server...")
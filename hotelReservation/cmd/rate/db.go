@@ -1,27 +1,29 @@
 package main
 
 import (
-	"strconv"
+	"encoding/json"
 
+	"github.com/harlow/go-micro-services/data"
 	"github.com/rs/zerolog/log"
 	"gopkg.in/mgo.v2"
 	"gopkg.in/mgo.v2/bson"
 )
 
 type RoomType struct {
-	BookableRate       float64 `bson:"bookableRate"`
-	Code               string  `bson:"code"`
-	RoomDescription    string  `bson:"roomDescription"`
-	TotalRate          float64 `bson:"totalRate"`
-	TotalRateInclusive float64 `bson:"totalRateInclusive"`
+	BookableRate       float64 `bson:"bookableRate" json:"bookableRate"`
+	Code               string  `bson:"code" json:"code"`
+	Currency           string  `bson:"currency" json:"currency"`
+	RoomDescription    string  `bson:"roomDescription" json:"roomDescription"`
+	TotalRate          float64 `bson:"totalRate" json:"totalRate"`
+	TotalRateInclusive float64 `bson:"totalRateInclusive" json:"totalRateInclusive"`
 }
 
 type RatePlan struct {
-	HotelId  string    `bson:"hotelId"`
-	Code     string    `bson:"code"`
-	InDate   string    `bson:"inDate"`
-	OutDate  string    `bson:"outDate"`
-	RoomType *RoomType `bson:"roomType"`
+	HotelId  string    `bson:"hotelId" json:"hotelId"`
+	Code     string    `bson:"code" json:"code"`
+	InDate   string    `bson:"inDate" json:"inDate"`
+	OutDate  string    `bson:"outDate" json:"outDate"`
+	RoomType *RoomType `bson:"roomType" json:"roomType"`
 }
 
 func initializeDatabase(url string) *mgo.Session {
@@ -33,121 +35,36 @@ func initializeDatabase(url string) *mgo.Session {
 	log.Info().Msg("New session successfull...")
 
 	log.Info().Msg("Generating test data...")
-	c := session.DB("rate-db").C("inventory")
-	count, err := c.Find(&bson.M{"hotelId": "1"}).Count()
-	if err != nil {
-		log.Fatal().Msg(err.Error())
-	}
-	if count == 0 {
-		err = c.Insert(&RatePlan{
-			"1",
-			"RACK",
-			"2015-04-09",
-			"2015-04-10",
-			&RoomType{
-				109.00,
-				"KNG",
-				"King sized bed",
-				109.00,
-				123.17}})
-		if err != nil {
-			log.Fatal().Msg(err.Error())
-		}
-	}
-
-	count, err = c.Find(&bson.M{"hotelId": "2"}).Count()
+	ratePlans := make([]RatePlan, 0)
+	err = json.Unmarshal(data.MustAsset("data/rates.json"), &ratePlans)
 	if err != nil {
 		log.Fatal().Msg(err.Error())
 	}
-	if count == 0 {
-		err = c.Insert(&RatePlan{
-			"2",
-			"RACK",
-			"2015-04-09",
-			"2015-04-10",
-			&RoomType{
-				139.00,
-				"QN",
-				"Queen sized bed",
-				139.00,
-				153.09}})
-		if err != nil {
-			log.Fatal().Msg(err.Error())
-		}
-	}
 
-	count, err = c.Find(&bson.M{"hotelId": "3"}).Count()
-	if err != nil {
-		log.Fatal().Msg(err.Error())
-	}
-	if count == 0 {
-		err = c.Insert(&RatePlan{
-			"3",
-			"RACK",
-			"2015-04-09",
-			"2015-04-10",
-			&RoomType{
-				109.00,
-				"KNG",
-				"King sized bed",
-				109.00,
-				123.17}})
+	c := session.DB("rate-db").C("inventory")
+	for _, ratePlan := range ratePlans {
+		count, err := c.Find(&bson.M{
+			"hotelId":       ratePlan.HotelId,
+			"code":          ratePlan.Code,
+			"roomType.code": ratePlan.RoomType.Code,
+			"inDate":        ratePlan.InDate,
+		}).Count()
 		if err != nil {
 			log.Fatal().Msg(err.Error())
 		}
-	}
-
-	// add up to 80 hotels
-	for i := 7; i <= 80; i++ {
-		if i%3 == 0 {
-			hotel_id := strconv.Itoa(i)
-			count, err = c.Find(&bson.M{"hotelId": hotel_id}).Count()
+		if count == 0 {
+			err = c.Insert(&ratePlan)
 			if err != nil {
 				log.Fatal().Msg(err.Error())
 			}
-			end_date := "2015-04-"
-			rate := 109.00
-			rate_inc := 123.17
-			if i%2 == 0 {
-				end_date = end_date + "17"
-			} else {
-				end_date = end_date + "24"
-			}
-
-			if i%5 == 1 {
-				rate = 120.00
-				rate_inc = 140.00
-			} else if i%5 == 2 {
-				rate = 124.00
-				rate_inc = 144.00
-			} else if i%5 == 3 {
-				rate = 132.00
-				rate_inc = 158.00
-			} else if i%5 == 4 {
-				rate = 232.00
-				rate_inc = 258.00
-			}
-
-			if count == 0 {
-				err = c.Insert(&RatePlan{
-					hotel_id,
-					"RACK",
-					"2015-04-09",
-					end_date,
-					&RoomType{
-						rate,
-						"KNG",
-						"King sized bed",
-						rate,
-						rate_inc}})
-				if err != nil {
-					log.Fatal().Msg(err.Error())
-				}
-			}
 		}
 	}
 
-	err = c.EnsureIndexKey("hotelId")
+	// a plan is identified by its hotel, code, room type and first night
+	err = c.EnsureIndex(mgo.Index{
+		Key:    []string{"hotelId", "inDate", "code", "roomType.code"},
+		Unique: true,
+	})
 	if err != nil {
 		log.Fatal().Msg(err.Error())
 	}
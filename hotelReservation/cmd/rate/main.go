@@ -34,16 +34,40 @@ func main() {
 	var result map[string]string
 	json.Unmarshal([]byte(byteValue), &result)
 
-	log.Info().Msgf("Read database URL: %v", result["RateMongoAddress"])
-	log.Info().Msg("Initializing DB connection...")
-	mongo_session := initializeDatabase(result["RateMongoAddress"])
-	defer mongo_session.Close()
-	log.Info().Msg("Successfull")
+	var store rate.RatePlanStore
+	var memc_client *tune.MemCClient
+	switch result["storage"] {
+	case "memory":
+		log.Info().Msg("Using in-memory storage, without memcached")
+		store = rate.NewMemoryStore()
+	case "", "mongodb":
+		log.Info().Msgf("Read database URL: %v", result["RateMongoAddress"])
+		log.Info().Msg("Initializing DB connection...")
+		mongo_session := initializeDatabase(result["RateMongoAddress"])
+		defer mongo_session.Close()
+		log.Info().Msg("Successfull")
+		store = rate.NewMongoStore(mongo_session)
+
+		log.Info().Msgf("Read profile memcashed address: %v", result["RateMemcAddress"])
+		log.Info().Msg("Initializing Memcashed client...")
+		memc_client = tune.NewMemCClient(result["RateMemcAddress"])
+		log.Info().Msg("Successfull")
+	default:
+		log.Fatal().Msgf("Unknown storage: %v", result["storage"])
+	}
 
-	log.Info().Msgf("Read profile memcashed address: %v", result["RateMemcAddress"])
-	log.Info().Msg("Initializing Memcashed client...")
-	memc_client := tune.NewMemCClient(result["RateMemcAddress"])
-	log.Info().Msg("Successfull")
+	var pricing_rules rate.PricingRules
+	switch result["RatePricing"] {
+	case "", "static":
+	case "dynamic":
+		log.Info().Msgf("Read pricing rules: %v", result["RatePricingRules"])
+		pricing_rules, err = rate.LoadPricingRules(result["RatePricingRules"])
+		if err != nil {
+			log.Fatal().Msgf("Got error while reading pricing rules: %v", err)
+		}
+	default:
+		log.Fatal().Msgf("Unknown pricing: %v", result["RatePricing"])
+	}
 
 	serv_port, _ := strconv.Atoi(result["RatePort"])
 	serv_ip := result["RateIP"]
@@ -78,8 +102,9 @@ func main() {
 		Registry:     registry,
 		Port:         serv_port,
 		IpAddr:       serv_ip,
-		MongoSession: mongo_session,
+		Store:        store,
 		MemcClient:   memc_client,
+		PricingRules: pricing_rules,
 	}
 
 	log.Info().Msg("Starting server...")
@@ -34,11 +34,21 @@ func main() {
 	var result map[string]string
 	json.Unmarshal([]byte(byteValue), &result)
 
-	log.Info().Msgf("Read database URL: %v", result["RecommendMongoAddress"])
-	log.Info().Msg("Initializing DB connection...")
-	mongo_session := initializeDatabase(result["RecommendMongoAddress"])
-	defer mongo_session.Close()
-	log.Info().Msg("Successfull")
+	var store recommendation.RecommendationStore
+	switch result["storage"] {
+	case "memory":
+		log.Info().Msg("Using in-memory storage")
+		store = recommendation.NewMemoryStore()
+	case "", "mongodb":
+		log.Info().Msgf("Read database URL: %v", result["RecommendMongoAddress"])
+		log.Info().Msg("Initializing DB connection...")
+		mongo_session := initializeDatabase(result["RecommendMongoAddress"])
+		defer mongo_session.Close()
+		log.Info().Msg("Successfull")
+		store = recommendation.NewMongoStore(mongo_session)
+	default:
+		log.Fatal().Msgf("Unknown storage: %v", result["storage"])
+	}
 
 	serv_port, _ := strconv.Atoi(result["RecommendPort"])
 	serv_ip := result["RecommendIP"]
@@ -71,10 +81,10 @@ func main() {
 	srv := &recommendation.Server{
 		Tracer: tracer,
 		// Port:     *port,
-		Registry:     registry,
-		Port:         serv_port,
-		IpAddr:       serv_ip,
-		MongoSession: mongo_session,
+		Registry: registry,
+		Port:     serv_port,
+		IpAddr:   serv_ip,
+		Store:    store,
 	}
 
 	log.Info().Msg("Starting server...")
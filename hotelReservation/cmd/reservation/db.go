@@ -1,24 +1,48 @@
 package main
 
 import (
-	"strconv"
+	"encoding/json"
+	"strings"
+	"time"
 
+	"github.com/google/uuid"
+	"github.com/harlow/go-micro-services/data"
 	"github.com/rs/zerolog/log"
 	"gopkg.in/mgo.v2"
 	"gopkg.in/mgo.v2/bson"
 )
 
 type Reservation struct {
-	HotelId      string `bson:"hotelId"`
-	CustomerName string `bson:"customerName"`
-	InDate       string `bson:"inDate"`
-	OutDate      string `bson:"outDate"`
-	Number       int    `bson:"number"`
+	ReservationId string `bson:"reservationId"`
+	HotelId       string `bson:"hotelId"`
+	RoomType      string `bson:"roomType"`
+	CustomerName  string `bson:"customerName"`
+	InDate        string `bson:"inDate"`
+	OutDate       string `bson:"outDate"`
+	Number        int    `bson:"number"`
+}
+
+type Inventory struct {
+	HotelId  string `bson:"hotelId"`
+	RoomType string `bson:"roomType"`
+	Date     string `bson:"date"`
+	Booked   int    `bson:"booked"`
+	Version  int    `bson:"version"`
 }
 
 type Number struct {
-	HotelId string `bson:"hotelId"`
-	Number  int    `bson:"numberOfRoom"`
+	HotelId  string `bson:"hotelId"`
+	RoomType string `bson:"roomType"`
+	Number   int    `bson:"numberOfRoom"`
+}
+
+// Capacity is an entry of data/inventory.json.
+type Capacity struct {
+	HotelId  string `json:"hotelId"`
+	RoomType struct {
+		Code   string `json:"code"`
+		Number int    `json:"numberOfRoom"`
+	} `json:"roomType"`
 }
 
 func initializeDatabase(url string) *mgo.Session {
@@ -35,103 +59,216 @@ func initializeDatabase(url string) *mgo.Session {
 		log.Fatal().Msg(err.Error())
 	}
 	if count == 0 {
-		err = c.Insert(&Reservation{"4", "Alice", "2015-04-09", "2015-04-10", 1})
+		err = c.Insert(&Reservation{uuid.New().String(), "4", "KNG", "Alice", "2015-04-09", "2015-04-10", 1})
 		if err != nil {
 			log.Fatal().Msg(err.Error())
 		}
 	}
 
-	c = session.DB("reservation-db").C("number")
-	count, err = c.Find(&bson.M{"hotelId": "1"}).Count()
+	err = c.EnsureIndex(mgo.Index{
+		Key:    []string{"reservationId"},
+		Unique: true,
+		Sparse: true,
+	})
 	if err != nil {
 		log.Fatal().Msg(err.Error())
 	}
-	if count == 0 {
-		err = c.Insert(&Number{"1", 200})
-		if err != nil {
-			log.Fatal().Msg(err.Error())
-		}
+
+	err = c.EnsureIndexKey("customerName")
+	if err != nil {
+		log.Fatal().Msg(err.Error())
 	}
 
-	count, err = c.Find(&bson.M{"hotelId": "2"}).Count()
+	capacities := make([]Capacity, 0)
+	err = json.Unmarshal(data.MustAsset("data/inventory.json"), &capacities)
 	if err != nil {
 		log.Fatal().Msg(err.Error())
 	}
-	if count == 0 {
-		err = c.Insert(&Number{"2", 200})
-		if err != nil {
-			log.Fatal().Msg(err.Error())
-		}
+
+	c = session.DB("reservation-db").C("inventory")
+	err = c.EnsureIndex(mgo.Index{
+		Key:    []string{"hotelId", "roomType", "date"},
+		Unique: true,
+	})
+	if err != nil {
+		log.Fatal().Msg(err.Error())
 	}
 
-	count, err = c.Find(&bson.M{"hotelId": "3"}).Count()
+	migrateReservations(session, capacities)
+
+	count, err = c.Find(&bson.M{"hotelId": "4"}).Count()
 	if err != nil {
 		log.Fatal().Msg(err.Error())
 	}
 	if count == 0 {
-		err = c.Insert(&Number{"3", 200})
+		err = c.Insert(&Inventory{"4", "KNG", "2015-04-09", 1, 0})
 		if err != nil {
 			log.Fatal().Msg(err.Error())
 		}
 	}
 
-	count, err = c.Find(&bson.M{"hotelId": "4"}).Count()
+	c = session.DB("reservation-db").C("idempotency")
+	// keys used to be unique across customers
+	err = c.DropIndex("key")
+	if err != nil && !strings.Contains(err.Error(), "index not found") {
+		log.Fatal().Msg(err.Error())
+	}
+	err = c.EnsureIndex(mgo.Index{
+		Key:    []string{"customerName", "key"},
+		Unique: true,
+	})
 	if err != nil {
 		log.Fatal().Msg(err.Error())
 	}
-	if count == 0 {
-		err = c.Insert(&Number{"4", 200})
-		if err != nil {
-			log.Fatal().Msg(err.Error())
-		}
+
+	// results of idempotent requests are kept for a day
+	err = c.EnsureIndex(mgo.Index{
+		Key:         []string{"createdAt"},
+		ExpireAfter: 24 * time.Hour,
+	})
+	if err != nil {
+		log.Fatal().Msg(err.Error())
 	}
 
-	count, err = c.Find(&bson.M{"hotelId": "5"}).Count()
+	c = session.DB("reservation-db").C("hold")
+	err = c.EnsureIndex(mgo.Index{
+		Key:    []string{"holdId"},
+		Unique: true,
+	})
 	if err != nil {
 		log.Fatal().Msg(err.Error())
 	}
-	if count == 0 {
-		err = c.Insert(&Number{"5", 200})
+
+	err = c.EnsureIndexKey("expiresAt")
+	if err != nil {
+		log.Fatal().Msg(err.Error())
+	}
+
+	c = session.DB("reservation-db").C("number")
+	migrateNumbers(c)
+	for _, capacity := range capacities {
+		count, err = c.Find(&bson.M{"hotelId": capacity.HotelId, "roomType": capacity.RoomType.Code}).Count()
 		if err != nil {
 			log.Fatal().Msg(err.Error())
 		}
+		if count == 0 {
+			err = c.Insert(&Number{capacity.HotelId, capacity.RoomType.Code, capacity.RoomType.Number})
+			if err != nil {
+				log.Fatal().Msg(err.Error())
+			}
+		}
 	}
 
-	count, err = c.Find(&bson.M{"hotelId": "6"}).Count()
+	err = c.EnsureIndexKey("hotelId", "roomType")
 	if err != nil {
 		log.Fatal().Msg(err.Error())
 	}
-	if count == 0 {
-		err = c.Insert(&Number{"6", 200})
-		if err != nil {
-			log.Fatal().Msg(err.Error())
+
+	return session
+}
+
+// legacy matches the documents written before hotels had room types
+var legacy = bson.M{"roomType": bson.M{"$in": []interface{}{nil, ""}}}
+
+// migrateNumbers removes the capacities of whole hotels, which predate room
+// types. They would read as a room type without a code, booked before any
+// other since its code sorts first.
+func migrateNumbers(c *mgo.Collection) {
+	info, err := c.RemoveAll(legacy)
+	if err != nil {
+		log.Fatal().Msg(err.Error())
+	}
+	if info.Removed > 0 {
+		log.Info().Msgf("Removed %d capacities without a room type", info.Removed)
+	}
+}
+
+// migrateReservations counts the reservations that predate room types, one
+// row per night without a confirmation id, into the inventory. Each is
+// given the first room type of its hotel, which is the one it would be
+// booked into now, and a confirmation id. Rows are claimed one by one, so
+// replicas starting at once count each of them once.
+func migrateReservations(session *mgo.Session, capacities []Capacity) {
+	first := make(map[string]string)
+	for _, capacity := range capacities {
+		code := capacity.RoomType.Code
+		if first[capacity.HotelId] == "" || code < first[capacity.HotelId] {
+			first[capacity.HotelId] = code
 		}
 	}
 
-	for i := 7; i <= 80; i++ {
-		hotel_id := strconv.Itoa(i)
-		count, err = c.Find(&bson.M{"hotelId": hotel_id}).Count()
+	reservations := session.DB("reservation-db").C("reservation")
+	inventory := session.DB("reservation-db").C("inventory")
+
+	migrated := 0
+	var r struct {
+		Id          bson.ObjectId `bson:"_id"`
+		Reservation `bson:",inline"`
+	}
+	iter := reservations.Find(legacy).Iter()
+	for iter.Next(&r) {
+		roomType, ok := first[r.HotelId]
+		if !ok {
+			log.Warn().Msgf("Leaving reservation of unknown hotel [%v] without a room type", r.HotelId)
+			continue
+		}
+		nights, err := nightsOf(r.InDate, r.OutDate)
 		if err != nil {
-			log.Fatal().Msg(err.Error())
+			log.Warn().Msgf("Leaving reservation of hotel [%v] without a room type: %s", r.HotelId, err)
+			continue
 		}
-		room_num := 200
-		if i%3 == 1 {
-			room_num = 300
-		} else if i%3 == 2 {
-			room_num = 250
+
+		claim := bson.M{"_id": r.Id}
+		for k, v := range legacy {
+			claim[k] = v
 		}
-		if count == 0 {
-			err = c.Insert(&Number{hotel_id, room_num})
+		err = reservations.Update(claim, bson.M{"$set": bson.M{
+			"roomType":      roomType,
+			"reservationId": uuid.New().String(),
+		}})
+		if err == mgo.ErrNotFound {
+			// migrated by another replica
+			continue
+		}
+		if err != nil {
+			log.Fatal().Msg(err.Error())
+		}
+
+		for _, date := range nights {
+			night := bson.M{"hotelId": r.HotelId, "roomType": roomType, "date": date}
+			book := bson.M{"$inc": bson.M{"booked": r.Number, "version": 1}}
+			_, err = inventory.Upsert(night, book)
+			if mgo.IsDup(err) {
+				// another replica created the counter first
+				_, err = inventory.Upsert(night, book)
+			}
 			if err != nil {
 				log.Fatal().Msg(err.Error())
 			}
 		}
+		migrated++
 	}
-
-	err = c.EnsureIndexKey("hotelId")
-	if err != nil {
+	if err := iter.Close(); err != nil {
 		log.Fatal().Msg(err.Error())
 	}
+	if migrated > 0 {
+		log.Info().Msgf("Counted %d reservations without a room type into the inventory", migrated)
+	}
+}
 
-	return session
+// nightsOf returns the dates of the nights from inDate to outDate
+func nightsOf(inDate, outDate string) ([]string, error) {
+	in, err := time.Parse("2006-01-02", inDate)
+	if err != nil {
+		return nil, err
+	}
+	out, err := time.Parse("2006-01-02", outDate)
+	if err != nil {
+		return nil, err
+	}
+	nights := make([]string, 0)
+	for d := in; d.Before(out); d = d.AddDate(0, 0, 1) {
+		nights = append(nights, d.Format("2006-01-02"))
+	}
+	return nights, nil
 }
@@ -35,16 +35,27 @@ func main() {
 	var result map[string]string
 	json.Unmarshal([]byte(byteValue), &result)
 
-	log.Info().Msgf("Read database URL: %v", result["ReserveMongoAddress"])
-	log.Info().Msg("Initializing DB connection...")
-	mongo_session := initializeDatabase(result["ReserveMongoAddress"])
-	defer mongo_session.Close()
-	log.Info().Msg("Successfull")
-
-	log.Info().Msgf("Read profile memcashed address: %v", result["ReserveMemcAddress"])
-	log.Info().Msg("Initializing Memcashed client...")
-	memc_client := tune.NewMemCClient(result["ReserveMemcAddress"])
-	log.Info().Msg("Successfull")
+	var store reservation.ReservationStore
+	var memc_client *tune.MemCClient
+	switch result["storage"] {
+	case "memory":
+		log.Info().Msg("Using in-memory storage, without memcached")
+		store = reservation.NewMemoryStore()
+	case "", "mongodb":
+		log.Info().Msgf("Read database URL: %v", result["ReserveMongoAddress"])
+		log.Info().Msg("Initializing DB connection...")
+		mongo_session := initializeDatabase(result["ReserveMongoAddress"])
+		defer mongo_session.Close()
+		log.Info().Msg("Successfull")
+		store = reservation.NewMongoStore(mongo_session)
+
+		log.Info().Msgf("Read profile memcashed address: %v", result["ReserveMemcAddress"])
+		log.Info().Msg("Initializing Memcashed client...")
+		memc_client = tune.NewMemCClient(result["ReserveMemcAddress"])
+		log.Info().Msg("Successfull")
+	default:
+		log.Fatal().Msgf("Unknown storage: %v", result["storage"])
+	}
 
 	serv_port, _ := strconv.Atoi(result["ReservePort"])
 	serv_ip := result["ReserveIP"]
@@ -76,11 +87,11 @@ func main() {
 	srv := &reservation.Server{
 		Tracer: tracer,
 		// Port:     *port,
-		Registry:     registry,
-		Port:         serv_port,
-		IpAddr:       serv_ip,
-		MongoSession: mongo_session,
-		MemcClient:   memc_client,
+		Registry:   registry,
+		Port:       serv_port,
+		IpAddr:     serv_ip,
+		Store:      store,
+		MemcClient: memc_client,
 	}
 
 	log.Info().Msg("Starting server...")
@@ -1,18 +1,17 @@
 package main
 
 import (
-	"crypto/sha256"
-	"fmt"
-	"strconv"
+	"encoding/json"
 
+	"github.com/harlow/go-micro-services/data"
 	"github.com/rs/zerolog/log"
 	"gopkg.in/mgo.v2"
 	"gopkg.in/mgo.v2/bson"
 )
 
 type User struct {
-	Username string `bson:"username"`
-	Password string `bson:"password"`
+	Username string `bson:"username" json:"username"`
+	Password string `bson:"password" json:"password"`
 }
 
 func initializeDatabase(url string) *mgo.Session {
@@ -24,28 +23,25 @@ func initializeDatabase(url string) *mgo.Session {
 	log.Info().Msg("New session successfull...")
 
 	log.Info().Msg("Generating test data...")
-	c := session.DB("user-db").C("user")
-	for i := 0; i <= 500; i++ {
-		suffix := strconv.Itoa(i)
-		user_name := "Cornell_" + suffix
-		password := ""
-		for j := 0; j < 10; j++ {
-			password += suffix
-		}
+	users := make([]User, 0)
+	err = json.Unmarshal(data.MustAsset("data/users.json"), &users)
+	if err != nil {
+		log.Fatal().Msg(err.Error())
+	}
 
-		count, err := c.Find(&bson.M{"username": user_name}).Count()
+	// passwords are stored as sha256 hashes
+	c := session.DB("user-db").C("user")
+	for _, user := range users {
+		count, err := c.Find(&bson.M{"username": user.Username}).Count()
 		if err != nil {
 			log.Fatal().Msg(err.Error())
 		}
 		if count == 0 {
-			sum := sha256.Sum256([]byte(password))
-			pass := fmt.Sprintf("%x", sum)
-			err = c.Insert(&User{user_name, pass})
+			err = c.Insert(&user)
 			if err != nil {
 				log.Fatal().Msg(err.Error())
 			}
 		}
-
 	}
 
 	err = c.EnsureIndexKey("username")
@@ -2,8 +2,13 @@
 // sources:
 // data/geo.json
 // data/hotels.json
+// data/images.json
 // data/inventory.json
 // data/locales.json
+// data/pricing.json
+// data/rates.json
+// data/recommendations.json
+// data/users.json
 // DO NOT EDIT!
 
 package data
@@ -71,7 +76,7 @@ func (fi bindataFileInfo) Sys() interface{} {
 	return nil
 }
 
-var _dataGeoJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8a\xe6\x52\x50\x50\x50\xa8\x06\x93\x20\xa0\x94\x91\x5f\x92\x9a\xe3\x99\xa2\x64\xa5\xa0\x64\xa8\xa4\x83\x10\xcf\x49\x2c\x51\xb2\x52\x30\x36\xd7\x33\xb7\x30\x33\x47\x16\xcf\xcf\x53\xb2\x52\xd0\x35\x34\x32\xd2\x33\x31\x34\x34\x02\x4b\xd4\xea\xe0\x31\xd5\x08\x87\xa9\xa6\x26\x38\x4c\x35\x30\x30\x25\x6c\xaa\x31\x0e\x53\x8d\x71\x9a\x6a\x6e\x48\xd8\x54\x13\xec\xa6\x5a\x1a\x9b\x61\x37\xd5\xd8\xd2\xd8\x80\xb0\xa9\xa6\xb8\xdc\x6a\x88\x2b\x5c\x2d\x88\x70\xab\x19\xae\xd8\x32\xc6\x15\x02\x86\xd0\x70\xe5\x8a\xe5\x02\x04\x00\x00\xff\xff\xc2\xb0\xd9\xce\x07\x02\x00\x00")
+var _dataGeoJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x95\x99\x31\x6e\x5b\x41\x0c\x44\x7b\x9f\xc2\x50\xed\x08\x4b\x72\x49\x2e\x73\x83\x9c\x21\x48\x11\x20\x01\x52\x18\x71\xe3\x2e\xc8\xdd\xf3\xe5\x14\x56\x00\x4e\xfe\xac\x0a\x17\x32\xf0\xc6\xd8\xc1\xd3\x1f\xad\x3f\x3f\x3c\x1e\xaf\x5f\x6f\x3f\x6f\xaf\xcb\x8f\x97\xd7\xef\xcf\x9f\xbe\x5d\x3e\x3e\x5e\xe4\xf2\xf4\xfe\xfe\xf3\xd7\xd7\xe3\x3d\xcb\x6b\xae\xc8\xfb\xf7\x5f\x7e\x1e\xef\x7f\x10\xd5\xeb\x14\xd1\xb7\x5f\xfc\x7e\xfa\x0f\x55\x01\xd5\x27\xa0\x8e\xe1\xe7\x54\x03\x54\x83\xd4\x94\x73\xea\xec\xa9\x65\xd1\x53\xad\x6c\x9c\x53\x1d\xfd\xad\x82\xce\x75\x11\x7f\x6b\xa0\xb6\x0c\x9d\x80\x10\xe7\x9a\x2d\x75\xa9\x3b\x38\x01\x9f\xe7\xd0\xd5\x43\x4d\x10\x74\xc6\x39\xb4\x00\x34\x11\xd4\xd6\x39\x54\x46\x4f\x9d\x06\xa9\x04\x54\x00\xb4\x10\x54\x09\xaf\xa4\x17\x6b\x39\xac\x4a\x88\xaa\xa4\x17\x6b\x05\xec\x6a\x10\x5d\xc9\x04\x54\x54\x96\x16\x53\x56\x2f\xd6\x4a\x54\x96\xae\xba\x7b\x11\x01\x01\x02\x6a\xbe\x63\x50\x14\x53\x21\xb0\xed\xf8\x6c\x3c\xe5\x27\x53\x26\x10\xaf\xe4\x9c\x1f\xbe\x77\x54\x40\xc7\xca\xbb\x28\xf0\xd9\xac\x4e\x94\xad\xbd\x99\x35\x8c\x09\x20\xf8\x02\xf8\x45\xf0\xa7\x6c\x1d\x96\xf6\xea\x96\x38\x91\x65\x44\xf1\xda\x5b\x5c\x7a\x5f\x3c\x92\x44\x09\xa1\x75\x82\x80\x24\x02\x84\xa9\xbb\x77\xbb\xcc\x98\x00\x82\x1f\x80\x5f\x04\x7f\x30\xb3\xa7\x77\xbb\xe6\x7d\xc7\x60\x56\x48\x31\x1d\xf7\x72\x97\x0b\x11\xb0\xf6\xec\xd6\x02\x59\x49\x64\x25\x51\xb7\x01\xbb\xc3\x98\x00\x82\x0f\xec\x0e\xd4\xb1\xc4\x9e\xd2\x06\x94\x4e\xf4\x34\x16\x66\x38\x19\xf0\x78\xa1\xa7\xb1\x30\xcb\xc9\x80\xbc\x0b\x3d\x8d\xc5\x72\xef\x30\x80\xbc\x65\x30\x80\x80\x02\x63\xa1\xa6\xc2\xac\x28\xeb\x34\x5d\xd7\xe3\x3b\x08\xa2\x8a\xed\x9d\xc5\xea\x03\x04\x56\xc8\x0c\x2a\x2b\x40\x45\x15\x0e\x66\x50\xcd\xd1\x53\xd5\x20\x95\x80\x0a\x80\xa2\xde\x06\x33\x9d\xa6\xf6\x54\x43\xbd\x0d\x66\x30\x4d\xeb\xa9\x13\x95\x35\x82\x28\x6b\x4e\x40\x85\x65\xf9\x9e\x6f\xd3\xfb\x00\x87\xbd\x11\x83\x68\x06\x80\xc2\xde\x26\xd3\x1b\xf0\x2d\x60\x6f\xb6\xe7\xdb\x04\xbe\xe5\xf9\xe6\x1d\xcc\xf2\x99\xc0\xbc\xcc\x73\x3e\x33\x7c\x1c\x38\xb8\x88\x9d\x3b\xc6\xde\xf7\x1b\x07\x6a\xae\x62\xb2\x88\xb6\x1d\x58\x5a\xa7\x43\x57\x8e\x0f\x76\x42\x58\xef\x85\x95\x21\x44\xc0\xe6\x08\xf2\x09\xb2\x4e\x37\xef\x91\xc5\x8c\x20\xef\x35\x3e\x9e\x38\x4c\x00\xc1\x0f\xc0\x2f\x82\x1f\x4c\xdd\xbd\xdc\xa2\x4e\x04\x30\x7b\xc8\x7b\xb9\xc5\x4e\x37\xef\x11\xc0\x4c\x23\x2f\x10\x90\x44\x00\x73\xc1\x14\xbd\xde\x32\x8d\x08\xd0\x3d\xbd\x43\x40\x56\x31\x59\x44\xdf\xd1\xeb\x2d\xee\x44\x00\x73\x1b\x15\x40\x6f\x70\x1b\x75\x50\xc7\x9e\xd3\x01\x9c\x06\x17\x53\x72\x5d\xcc\x8e\x0a\x20\x32\xb8\x98\xba\x51\x09\x28\xb0\x37\x91\x51\x6b\xed\x7d\x85\x09\x60\xef\x72\x14\xc0\x4c\xaa\x00\xca\x16\xaa\x70\x31\x93\x2a\x80\xa7\x05\x7b\xdb\x9c\x54\xd9\x7b\xaa\x03\x56\xc8\x5c\xae\x0b\x80\xc2\x0a\x99\x49\x95\xbd\x85\x2a\xb0\x37\xe6\x0a\x29\x7b\xf5\x54\x61\x6f\xcc\x7a\xca\x09\xa8\xb0\x37\x66\x33\x65\xef\x9b\x1a\x2c\x8b\xb8\x21\xca\x00\x50\x58\xd6\xd8\xf3\x2d\x7b\xdf\x74\xa2\xde\x92\x59\x44\xd9\xfb\xa6\x7e\xb6\x7f\xe5\xf6\xbf\x23\x82\x5f\x80\x9f\xe7\xfc\xdc\x73\x70\x01\x07\xff\xb9\x14\x42\x51\x7f\xf1\x0f\x5f\x1e\xfe\x00\x48\x47\xed\xc2\xf2\x1c\x00\x00")
 
 func dataGeoJsonBytes() ([]byte, error) {
 	return bindataRead(
@@ -86,12 +91,12 @@ func dataGeoJson() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "data/geo.json", size: 519, mode: os.FileMode(420), modTime: time.Unix(1502238721, 0)}
+	info := bindataFileInfo{name: "data/geo.json", size: 7410, mode: os.FileMode(420), modTime: time.Unix(1792280311, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _dataHotelsJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd4\x96\xcd\x6e\x23\x45\x10\xc7\xef\xfb\x14\xa5\x9c\x40\xb2\x23\x8f\x67\xc6\x1f\x7b\x4b\xb2\xec\x2e\x12\x46\xd1\x3a\x0b\x02\xc4\xa1\xdc\x5d\xf6\x14\x69\x77\x9b\xea\x9a\xcd\x5a\x68\x25\x5e\x83\xd7\xe3\x49\x50\x8f\x1d\xe2\xc4\xf6\x24\xe4\x14\x72\x88\xac\xae\x9a\xea\x8f\xfa\xe9\x5f\xff\x5f\x5e\x01\x00\xfc\xd1\xfc\x4f\x7f\x27\x6c\x4f\x5e\xc3\x49\x76\xd2\xb9\x5b\xf2\xb8\xa4\xb4\x78\xe1\x78\xae\xf0\x3e\x28\xb9\xdd\xf0\xaa\x0a\x9e\xbe\xaf\x97\x33\x92\x94\xf5\x55\x91\x95\x5f\xc3\x70\x58\x76\x8b\x61\xaf\xb7\x9b\x68\x29\x1a\xe1\x95\x72\xf0\x29\xf1\x0c\x06\xdd\x25\xfb\x5a\x09\x6e\xd0\x5d\xc3\x5c\xc2\x12\x3e\x7a\x0e\x1e\xa6\xbf\xd7\x28\x04\xe8\x2d\x14\xb0\xc9\x89\x9b\x38\xc2\xa4\xf6\x0c\x13\x52\x09\x10\x15\x53\xb1\x0e\x68\xc5\x11\x5c\xfd\xb9\x96\x35\x54\xe9\x7c\x60\x29\xf2\xc2\x93\x85\xd9\x1a\x2e\x2b\x76\xbc\x5a\x11\x4c\x15\xc5\x5c\xc3\x9c\x50\x6b\xa1\x08\xe8\x01\x45\xe3\x1a\xe6\xb5\x78\x4e\x6b\x60\x82\x73\x64\x52\x55\x60\x0f\x5a\x11\xb8\x30\x9b\xad\x3b\xc0\xde\xb8\xda\xb2\x5f\xc0\x4d\x90\xeb\x54\x76\x8a\xee\x13\xda\x20\xf0\x06\x1d\x9f\xee\x5e\x14\xad\x15\x8a\xf1\xe4\xf5\xce\xc3\x36\x81\xa8\x42\xa4\x77\x6f\x55\x8c\xcb\x9d\xef\x76\x53\xb6\x4f\xfe\x8e\x50\xd6\x30\xd5\x87\x59\x86\x75\x9d\xe2\x53\xf4\xf0\x56\xd0\x1b\x8e\x26\xec\x97\x42\xdd\x34\xee\x6c\xef\xfb\x50\x7b\x95\xa6\xc4\x47\xcf\x4a\x36\xbd\x8d\x52\x7c\x98\xb7\x0a\x51\xd1\x5d\x04\xdb\xd4\x19\x17\x59\xaf\xff\x30\xc5\xa1\x9e\xbc\x86\x7c\x78\x3a\x1c\x0d\x86\x0f\x63\x4d\xa7\xbb\x59\xbf\x7f\x5a\x64\x59\xff\xdf\xe0\x97\xe6\xd7\x97\xce\x61\xf8\xfa\x87\xe0\xfb\x11\x8e\xdd\xf5\x18\x80\xc3\x6e\x99\xb7\x02\xf8\x1d\xc5\x08\x5a\x25\x0a\x60\xe6\x82\xd9\x22\x98\x9a\xfe\x13\xc9\x0c\xe1\xbc\x26\x8f\x70\x41\x5e\x49\x60\x1e\xa4\x09\x9d\x89\xc6\x2d\x71\x2a\xe4\xed\x2d\x71\x1c\x01\x21\xeb\xb7\x23\xfd\x3c\x4c\xb2\x51\xd6\x8e\x49\x2e\xf6\x45\x41\x92\xb7\x40\x52\x16\x2d\x90\xf4\x7a\xe5\x13\x21\xc9\x0f\x41\xd2\x68\x13\xfc\x4c\xaa\xf8\x28\x20\x65\x91\x77\x47\x65\x59\xb6\x2a\x54\xbe\xdf\xce\xc4\xc0\x65\xb8\x21\xe7\x60\xda\x34\x01\x0c\xce\x1c\x75\x0d\x0a\x68\x2d\x1e\x25\xd4\xde\x36\xda\x75\x7e\xf6\xe1\x0a\x04\xd9\x3d\x50\xaa\x8a\x57\x5b\x68\xc6\xf7\xd5\xed\x9e\xfa\x99\xb0\x9c\xb1\xa7\x94\xbe\xa8\xba\x4a\xa6\x02\x17\xec\xa2\x91\x20\xd6\x6a\x2b\x5d\x1a\x6a\x53\x51\x7c\x1e\x58\xe5\x23\xf2\x53\x6a\xf5\xbf\xe1\x2a\x6f\xe5\x6a\x98\x3d\x91\xab\xe2\x38\x57\x3f\xb0\xa2\xa3\x47\xc1\xea\x0f\x47\xdd\xbc\x7d\xf4\x5d\x25\x0a\x6e\x50\x49\xe6\x12\xbc\x6e\x61\x68\xba\x7a\x8e\x6b\x38\x17\xb6\x0b\x82\x4f\x4c\x37\x31\xe9\x4a\xbe\x91\xa7\x78\x07\xe0\x5b\xf6\xe9\xdd\xd1\xc1\x1b\x8e\x2a\x6c\xb4\x01\x0e\xa1\x38\x0c\xec\x5b\x12\x59\xc3\x79\xcd\x2e\x8d\xb0\xe7\xc1\x32\x6a\x67\x65\xc2\x31\x36\xf4\xbe\x20\x5e\xf6\xf0\xbe\xe3\x65\x9c\x0f\x8e\xf3\x92\x8f\xf3\xde\x13\x79\x29\x0f\xf1\x72\x59\x05\xf2\xfc\xf9\xc9\x5e\x69\xd0\xcd\xf2\x51\xeb\xa8\x0a\x06\xd3\xc5\xb7\x9e\xe4\x8a\xbc\x25\x71\x81\x3d\x78\xe2\x45\x35\x0b\x52\x85\x60\x3b\x69\x02\xf5\xf6\x09\xc0\xa3\x4a\x24\x8d\x8f\x5a\x06\x0d\xd2\x88\x0b\x41\x85\x11\xaa\x10\xd3\x66\x4b\xf4\x6b\x90\x34\x17\x97\x75\x64\xc3\xe8\x63\x43\x59\xd0\x8a\x04\x0c\x39\x9a\x09\x2b\x53\x84\xc8\xde\x50\x73\xb2\x6c\x5c\xf6\xe2\x29\x7c\xab\x7f\xff\xf9\x57\x3c\xce\x63\xc5\x51\x83\xb0\x81\x77\x42\xa8\x70\xb6\x24\x61\x83\x1e\x26\x69\x23\x78\x8f\xce\x81\xe7\x45\xa5\xc6\xd5\xb3\xe7\xd1\x3a\xe8\x3d\x32\x33\xbf\xb1\xf6\x65\x39\xab\x71\x9b\xb8\x65\x6d\xce\x6a\xf4\x54\x71\x1b\x1c\x82\x75\xaa\xa7\xf0\x81\x16\x1c\xff\xa3\xc3\xea\x8f\x8a\x6e\xd1\x6b\xd5\xb9\xbb\xd2\x93\x3a\x52\xbd\x84\xab\x70\x43\xb2\xb1\x4a\x45\xbf\x9b\x18\x58\x77\xa0\x18\x15\x30\x57\x88\xd7\xeb\x68\x04\x57\x29\x61\x83\xf9\x34\xd4\x5a\x41\x98\xc3\x04\xe5\x9a\x14\xec\xad\xd6\x85\xf9\xfd\xb3\x76\xe0\x02\x1d\xcf\x83\x78\xc6\x0e\xa0\xfd\x0d\x0d\x79\x05\x0d\xf7\x8c\xdc\x3b\x14\x4b\x3e\x76\x60\x12\xa2\x09\x9e\xb6\xce\xae\x03\x97\x68\xce\xd3\x3c\xbf\xd5\xc7\x86\xf2\xe6\x00\xbb\x9b\xdc\xde\x21\x9d\x27\x58\x12\x9f\xac\xe0\x33\x0d\x5d\xff\x91\xc1\xfb\xd2\x0c\x5d\x1b\x9b\x83\xbc\x6d\xf0\x66\x7b\x86\xee\xd5\xaf\xaf\xfe\x09\x00\x00\xff\xff\xd4\xa1\x82\xaf\x76\x0e\x00\x00")
+var _dataHotelsJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xed\x9d\xdd\x6e\xe3\xb8\x15\x80\xef\xe7\x29\x88\x5c\xb5\x40\x1c\x88\xff\x52\xef\x92\xd9\xce\x6c\x81\xa6\x18\x6c\xa6\x2d\xda\xc5\x5e\xd0\x12\x13\xab\x23\x4b\xa9\x24\x6f\x36\x28\x0a\xf4\x35\xfa\x7a\x7d\x92\x1e\x39\x4e\xf3\x7f\x64\x79\x75\x8a\x59\x90\xb9\x98\x89\x2d\x59\x92\xa9\x2f\xe4\x39\xfc\x48\xea\xfb\x77\x0c\x7e\xfe\xb1\xfd\x77\xf8\x39\x2a\x8b\xa3\xdf\xb0\x23\x7e\x74\xfc\xf0\x56\xed\xd6\x7e\x78\xf3\x7d\x55\x5e\xf6\xec\xdb\xa6\xf7\xd5\xe3\xcd\xd7\xab\xa6\xf6\x7f\xd8\xac\x97\xbe\x1d\xf6\xfa\x95\xe2\xfa\xd7\xcc\x5a\xbd\x50\x36\x49\x1e\xef\x58\xf8\x2e\x6f\xcb\xeb\xbe\x6c\xea\x61\xc7\x53\x66\x16\xeb\xb2\xde\xf4\x9e\xdd\xb8\xea\x0b\xbb\x6c\x9b\x35\xfb\x63\x0d\x5b\xd9\xc5\xdf\x37\xae\xf5\xcc\xd5\x05\x53\xec\x6e\x9f\xee\x6e\xbb\x63\xe7\x9b\xba\x64\xe7\xbe\x6f\x1b\xd6\xf5\x6e\x38\xd8\x31\xeb\x57\x65\xc7\xaa\xcd\x4f\x9b\xf6\x96\xad\x86\xeb\x63\x70\xae\xf2\xaa\xf6\x05\x5b\xde\xb2\x4f\xab\xb2\x2a\xaf\xaf\x3d\xbb\xe8\x5d\x9b\xc3\x89\xbc\xeb\x37\x2d\x1c\xd1\xd5\xcc\xb5\x7d\x77\xcb\x2e\x37\x6d\x5d\x0e\xef\xb1\xbc\xa9\x2a\x9f\x0f\x47\x65\x65\x0d\xc7\xf5\xac\x6a\x96\xcb\xdb\x63\x78\x95\x57\x9b\xa2\xac\xaf\xd8\x4d\xd3\x7e\x19\x0e\x7b\xe1\xaa\x1f\x5d\xd1\xb4\xec\x1b\x57\x95\x27\x8f\xbf\xa8\x2b\x0a\x38\x7c\x07\x5f\xf2\xa1\x60\xb7\x1b\xba\xbe\xf5\xbe\x7f\x28\x2b\x95\xe9\x47\x9f\x7b\xbc\xcb\xae\xc8\x3f\x7a\x07\x5f\xe9\xa2\x7f\xbe\x57\x5e\xf6\xb7\xc3\xf6\x0b\xf8\x0a\x1f\x5a\x57\xe7\x65\x97\x37\x2f\x0f\xe5\xfa\xbb\x1b\x77\xfa\xe2\xf3\xcd\xa6\xee\xdb\xed\x21\xa0\xc4\x7b\x28\xa7\x8b\x61\xe7\xee\xf9\x7e\xd7\x0d\x1c\xa4\x7a\xdf\x14\xdb\xe3\x64\x8a\x27\xe2\xf9\x2e\x95\xeb\x61\x9b\xb4\x27\x36\x35\xf6\xf9\xb6\xed\x9d\x5e\x70\x21\x4e\x14\xe7\xe2\x7f\x1b\xff\xf9\xb8\xb0\xd6\x1e\xae\xa0\xf4\x43\x71\x7d\x7f\x74\x53\x5e\x96\x47\x3f\xbc\x7b\xb4\xd3\x0b\x36\xc5\x6b\x6c\xfe\x99\xbd\x55\x14\x6f\xf1\x69\x17\x5a\xa2\x7c\xfe\x1e\x6e\x21\x10\x30\x40\xc2\x96\x55\x93\xef\x08\x1d\x98\xf8\x8b\x6f\x97\x8e\x9d\x6d\x7c\xed\xd8\x7b\x5f\xf7\xbe\x65\x97\x00\xc2\xb0\xe9\x14\x80\xda\x01\x09\x77\xb2\x2e\xee\x81\x84\xd7\x8e\x71\x81\x13\x7f\x18\x45\x3c\xe5\x38\x45\xb2\x2d\xbe\x2a\x86\x24\xc2\x90\x56\x08\x43\x49\xa2\xf7\x65\xe8\x78\x38\x71\x53\x0d\xff\x2f\x5d\x3b\x82\x94\x7c\x0d\xa9\x6d\x45\xc7\xfe\xea\xfb\xde\x8d\xe2\xa4\x95\x5c\xa4\x5a\x6b\xb4\xba\x93\x2f\x6f\xfe\x40\xcc\xa7\xe6\xc6\x57\x15\x14\xde\x70\xcb\x58\xee\x96\x95\x5f\xe4\x0e\x68\x82\x4a\xc9\xb5\x50\xc8\xc5\xb6\x22\x3c\x3b\xfd\xee\x33\x6b\x5d\x59\x3d\xab\xf6\x56\xe5\xf5\x0e\xb1\xec\x69\x55\xf9\xa4\x2a\xcd\x9b\xf5\xb2\xac\xfd\xb0\xfb\xd5\x6a\xd1\xfb\x7c\x05\x15\x5b\x71\xb5\xad\xcf\xca\x7e\xb5\xab\x07\xfb\x66\x93\xaf\x7c\x77\x18\x86\x7a\xa4\x2e\xd3\x70\x9a\x5f\x0a\x85\x12\xa5\xd0\xf2\x29\x14\xba\xf6\x0b\x14\xf3\xf0\xeb\xd5\xed\x7a\x04\x44\xf5\x36\x88\x7f\x2a\xe1\xfa\xfd\x28\x89\xc2\xa6\x0b\x89\x37\xbc\x9f\x07\x6c\x6e\xa0\x90\x5a\xe0\xa4\xee\x77\xf4\x6c\x31\x38\x73\xb7\xec\xac\x2d\x8b\x2b\xcf\x7e\x2c\xfd\x4d\x37\x54\x5b\xf2\xae\xf6\xeb\x1e\x88\xfd\x50\xd6\xc3\x8d\x72\x15\xfb\xa6\x84\x7b\x5c\xe6\xfd\x96\x50\xc7\xd4\xeb\x84\x7f\xf0\x2d\xb4\x62\x67\x9b\xb2\x1a\x1a\xd0\xc3\xe8\x4a\x71\xb8\xce\xcb\xae\xdb\xe2\xfe\x15\x01\xa6\xdf\x06\x2c\x93\xe6\x6d\xc0\x64\x26\x93\x09\x80\xb9\x3c\x87\x12\x2c\xa1\xda\x18\x81\x4b\xbf\x06\xd7\xa7\x55\x03\x07\xfd\x69\xef\xb0\xce\x2c\xb8\x4c\xd1\x66\xb3\xc9\xdd\x50\x4a\xbb\xf0\xe9\x33\xb4\x81\xbe\xad\x1a\x78\x59\x7b\xa8\x7b\x96\x4d\xbb\x6a\x9a\xe2\x78\x68\x0d\x93\x97\xb8\xb8\x37\xeb\xb9\x76\x1b\xf2\xad\x9b\x1e\x1a\xda\xa1\xea\xf2\x6c\xe5\xa0\x3a\x83\x52\x87\x93\xad\x5d\x7d\xcb\xda\xa1\x8d\x5e\x6f\xba\x12\xd0\xac\xbb\x2d\x92\x0d\x5c\x42\xcb\x72\x5f\xf9\x65\xbb\x2d\x39\xd6\x41\x20\xe7\xb7\x57\xc6\x33\x9d\x74\x27\xec\x77\xfd\x7f\xfe\xf5\xef\xee\x6d\x78\xe1\xdc\x70\xca\x32\x67\x1f\x5b\x88\x1b\xd9\xe9\xda\xc3\x0b\x60\xe9\x7c\x38\x11\xfb\xd6\x41\xd5\x5d\xc3\xf7\xea\x21\x3c\x5c\x1e\x86\xb6\x49\x46\xda\xef\xdf\x16\xc5\xd7\x15\x04\x66\x58\xd5\xc9\xb1\x20\x30\xe5\xf3\x04\x81\xe6\x35\x96\x2f\xfa\x13\xf6\x9d\xbf\x02\x58\xa6\x05\x83\x22\x55\x0b\x88\x2d\x30\xaa\x1f\x0e\x0d\xf7\xdd\x6f\xd6\xec\x33\xb4\xda\xed\x5d\x54\xa7\xc4\x62\x40\x04\x92\x04\x95\x2a\x06\x29\x52\xf7\xe5\x16\x3e\xec\xae\x87\x1d\xee\xfe\x0a\x2e\x9a\x0d\xd4\xae\xcd\x25\x3b\x87\xd6\x00\x5a\xf9\xe2\xbe\xde\x84\xb7\x9e\x5c\xeb\x31\x7b\x0f\xd9\x04\x04\x93\x75\xe9\xe0\x6f\xa4\xf8\x9b\xcb\x21\xbe\x84\x86\xf9\x49\xcc\xf9\xd1\xb5\x85\xaf\x21\xce\x3c\x6f\xe0\x33\xb5\xdf\x05\xa1\xc7\xec\x93\xcb\xcf\x86\x60\xe2\xbe\xae\xdd\xfe\x11\x6c\x2f\xe0\xf1\x49\xee\xbf\xc3\x70\x3d\x70\x57\xdb\x7a\x88\x5a\x0f\x8c\x3d\x85\xfe\x65\xc5\x9e\x18\xba\x46\x62\xad\x3e\xd7\x87\xb5\xfa\xf7\x61\x68\x77\xed\xb6\xd1\x28\x54\x23\x5f\x2e\x5d\xd7\x8f\x10\x6e\xe7\x27\xdc\x46\xc0\x43\x06\x3c\x15\x5a\x23\x51\x87\x86\x2a\x50\xcd\x53\x3b\xa7\xf3\xb3\x9b\x46\x76\x83\x66\x57\x72\x8c\x5d\x65\x26\xd4\xcd\x43\x1a\x36\xd4\xcb\xbe\xef\x46\x38\xce\xe6\xe7\x38\x8b\x1c\x87\xcd\xb1\xc5\x38\x86\xd4\x2a\x39\x24\xcc\xc0\x31\xe6\xc9\xfc\x1c\xf3\x18\x2d\x87\x0d\xb2\x92\x3a\x11\x18\xca\x24\x3d\xb5\x9c\x13\xa0\xcc\x23\xca\x61\xa3\x9c\xe9\x04\x49\xfd\xa4\x10\xc9\x4c\xdd\x16\x5c\x10\xe0\x2b\x22\xbe\x41\xe3\xab\xd1\xb4\x8e\xab\xc3\xba\x2d\x86\xee\x8a\x11\x98\x25\x01\xcc\x32\xc2\x1c\x34\xcc\x06\xcd\xf3\x12\x93\xf0\x89\x99\xde\x08\xc3\x8a\x80\x61\x15\x19\x0e\x9b\x61\x2c\xc7\x13\x59\x3a\xa1\x9f\xed\x3e\x38\x1e\xa1\x58\x13\x50\xac\x23\xc5\x41\x53\x6c\x25\x4a\xf1\x61\x51\xc5\x83\xfd\x98\x62\xae\x39\x81\xee\x7b\x7a\xcc\xc8\x77\x78\x7c\x67\x18\xdf\xa9\x98\xd0\x13\x37\x42\x2f\x81\xca\xe3\xd1\xe5\x85\x4d\x6f\x8a\x25\x7d\xc2\xce\x24\xf2\x38\x81\xc9\xe3\x51\xe5\x85\x8d\x6e\xc6\xd1\x9e\x63\x61\xa6\x25\x79\xaf\x0c\xb5\xd8\x19\xbe\xfb\x11\x17\xae\xdd\xd3\xf7\x71\x02\xe1\xc7\xa3\xf1\x0b\x1c\x77\x34\x1b\xd4\xe9\x4c\xb3\x22\x08\x1c\x9f\x88\x8e\x2f\x68\x74\xb3\x04\x4d\x01\x67\x0a\x8f\x05\x81\xd2\x13\x51\xe9\x05\x4e\x2e\x9a\xdc\x29\x31\xb1\x0b\x6e\xaf\x61\x16\x82\x40\xee\x89\x28\xf7\xc2\x06\x99\xa3\x79\x9e\x9c\xb9\x23\x59\x10\x28\x3d\x11\x95\x5e\xd8\x08\x0b\x4c\xe9\x09\x61\x92\xc4\xcc\xea\xf4\x04\x81\xd3\x13\xd1\xe9\x05\x0e\x31\x9a\xc5\xf1\xf4\xf0\x41\x16\x13\xe6\x84\x08\x02\xcf\x27\xa2\xe7\x0b\x9b\x6c\x89\x0f\xe4\x14\x3c\x99\x6b\xf4\x9b\x20\xd0\x78\x22\x6a\xbc\xc0\xf1\x45\x33\xbd\x44\x1c\x38\x0c\x79\x8a\x9e\x16\x04\x82\x4f\x44\xc1\x17\x36\xd7\x0a\x4b\xfc\x78\x46\xd0\x7d\x41\xe0\xfa\x44\x74\x7d\x61\x53\xac\xb1\xdc\x8f\xa7\x66\xda\x74\xa7\xfd\x67\xee\x09\x02\x93\x27\xa2\xc9\x0b\x1c\x66\x2c\x07\xe4\x76\x26\x93\x27\x09\x4c\x9e\x8c\x26\x2f\x6c\x74\x8d\x44\xd1\x4d\xa6\xf5\xc1\xbd\xb1\xba\xc5\x08\xd7\x04\x9e\x4f\x46\xcf\x17\x38\xd7\x58\xf6\xc7\xcd\x4c\x4b\x4e\x4a\x02\xb3\x27\xa3\xd9\x0b\x1b\x5d\xab\xd1\x7e\x37\xae\xd5\x5c\xfd\x6e\x92\xc0\xea\xc9\x68\xf5\xc2\xc6\x37\xe5\xe8\xa4\x69\xae\xcc\xe1\x8b\x64\x4e\x50\x22\x92\x40\xf6\xc9\x28\xfb\x02\x67\x1b\x4d\xf4\x64\x4a\xb3\x08\x31\x81\xdc\x93\x51\xee\x85\x4d\x72\x86\xe6\x7d\x32\x99\x6d\x92\x93\x24\x70\x7b\x32\xba\xbd\xc0\xe9\x45\xb3\x3b\x21\xa8\x56\xb6\x90\x04\x42\x4f\x46\xa1\x17\x30\xcc\xe9\x09\xd4\xb4\x18\xcc\x5c\x4d\xeb\x82\xdb\x5b\x4e\x4b\x02\xad\x27\xa3\xd6\x0b\x9b\x65\x8e\x6a\xbd\x69\x7d\xc9\x4f\xe7\xec\xed\xe1\xf6\x24\x81\xdb\x93\xd1\xed\x05\x4e\x34\x96\xf2\x25\x59\x7a\xd0\x94\xd4\x91\x87\x7d\x10\x88\x3e\x15\x45\x5f\xd8\x1c\x0b\x7c\x34\x67\x92\x25\xb3\xad\xf2\xad\x08\x84\x9e\x8a\x42\x2f\x70\x7e\xb1\x94\x2f\x49\x67\x12\x7a\x8a\x40\xe8\xa9\x28\xf4\xc2\x46\x57\x62\x09\x5e\x62\xd5\x61\x4b\x7b\xbf\xf2\x04\x91\xbb\xe1\xc9\xfb\x1a\x12\x45\x60\xff\x54\xb4\x7f\x61\xb3\xae\xb0\x04\x30\x31\xf3\x4e\xe8\x53\x04\x8e\x4f\x45\xc7\x17\x38\xc1\x68\xc2\xa7\xd3\xb9\x06\x5f\x28\x02\xad\xa7\xa2\xd6\x0b\x1b\x5e\x2d\x51\x78\xe7\xef\xaa\x20\xb0\x7b\x2a\xda\xbd\xc0\x21\x46\x53\x3d\x25\xa6\x75\xb9\x8d\x2f\x6e\xa1\x08\xac\x9e\x8a\x56\x2f\x6c\x88\x0d\x3e\x8a\x33\x91\xf3\x3d\x55\x4f\x11\x98\x3c\x15\x4d\x5e\xd8\xfc\x5a\x34\x91\x13\x3f\x6f\x10\xe7\xae\xc3\x62\xeb\xf4\xa6\x38\x6b\x45\x60\xf8\x54\x34\x7c\x81\x93\x8e\x26\x7c\x3c\x9d\x6d\x28\x9c\x26\xf0\x7a\x3a\x7a\xbd\xb0\xe9\x4d\xd1\x8c\x8f\x93\x8c\x48\xd6\x04\x82\x4f\x47\xc1\x17\x38\xc8\x68\xd6\x97\x88\x43\xd7\xfe\xde\x57\x87\x68\x02\xf5\xa7\xa3\xfa\x0b\x1b\xea\xec\x6d\xf5\xc7\x4f\xb2\x6c\xa6\x04\x50\x13\x98\x3c\x1d\x4d\x5e\xd0\xe8\xf2\x84\x63\xe8\xa6\xf3\x9a\x3c\x4d\x60\xf2\x74\x34\x79\x81\x13\x6c\x91\x2e\x38\x60\xd8\xa6\x87\xad\xf6\x3d\x69\x75\x0b\x4d\xa0\xf9\x74\xd4\x7c\x61\x93\xcd\x25\x32\xc7\x7a\x20\x7b\xa6\xb8\x82\xc0\xee\xe9\x68\xf7\x02\x67\x37\xc3\xe2\x0a\x23\x26\x4c\x77\x1a\xa1\x97\x40\xeb\xe9\xa8\xf5\xc2\xa6\x57\xa0\x09\x9d\x9e\x7f\xf5\x4d\x4d\x20\xf7\x74\x94\x7b\x61\x53\x2c\xd1\xdc\x4e\x99\x83\xfa\x8d\xef\x17\x68\xd9\xfb\xa9\x7a\x9a\xc0\xe6\xe9\x68\xf3\x02\x47\xdb\x62\x68\x4f\x5a\xa2\x65\x6f\x2d\x6d\x08\xc4\x9e\x89\x62\x2f\x6c\x90\x95\x44\x41\xfe\xbf\x3c\x57\xc4\x10\x88\x3e\x13\x45\x5f\xe0\x60\xa3\x09\xa0\x98\xef\xf9\xea\x86\xc0\xe8\x99\x68\xf4\xc2\xa6\x57\x6b\xbc\x53\x99\xcf\xfc\xe4\x3d\x43\xe0\xf6\x4c\x74\x7b\x61\x43\x6c\xd0\xfc\x2f\x31\x07\xaf\xfa\x3d\x6e\xfa\x0c\x81\xe9\x33\xd1\xf4\x05\xce\x33\x96\xf4\xa5\xd9\x4c\x0f\x60\x30\x04\x2a\xcf\x44\x95\x17\x36\xba\x56\xa2\xe8\xce\xf6\x8c\x3d\x43\xe0\xf2\x4c\x74\x79\x81\xc3\x8b\xa5\x72\x69\x2a\x66\x5b\xd6\x62\x84\x6d\x02\xd3\x67\xa2\xe9\x0b\x9b\xed\x14\x33\x7d\xa9\x9d\x69\xe8\xa6\x21\xd0\x7b\x26\xea\xbd\xb0\xd1\xcd\xb0\xf4\x2e\x35\x64\x0f\xd7\x33\x04\x42\xcf\x44\xa1\x17\x38\xcc\xf8\x28\xce\x54\xa7\xb4\xf3\x42\x2c\x81\xdc\xb3\x51\xee\x05\x0d\xb5\x48\xd0\xac\x4f\x4f\x7d\xec\xde\x2e\x60\xde\x5b\x58\x5b\x02\xaf\x67\xa3\xd7\x0b\x9c\x69\x34\x19\x54\x33\xad\xd0\x69\x09\xa4\x9e\x8d\x52\x2f\x6c\x74\x39\x9a\xeb\x49\x75\xe8\xdc\xd3\xf1\xe9\x21\x96\x40\xef\xd9\xa8\xf7\xc2\xc6\x59\xa0\xf9\x9f\x30\xd3\xa6\x3d\x8d\x3b\x3d\x4b\xe0\xf4\x6c\x74\x7a\x81\x43\x8c\x3a\x3d\x4e\xf3\xac\x3d\x4b\xa0\xf8\x6c\x54\x7c\x61\x93\x2c\xd1\x64\x8f\x27\x09\xc1\xc2\x9c\x96\x40\xf7\xd9\xa8\xfb\x02\x07\x39\xc3\xbb\xe2\x92\xb9\x72\x3c\x02\x9f\x67\xa3\xcf\x0b\x1b\x5e\x85\x0f\xdc\xb4\xd9\x6c\x8f\x55\xb7\x04\x4e\xcf\x46\xa7\x17\x36\xbe\x1a\xcb\xe9\x6c\x6a\x7e\xde\x50\x8b\xa7\xeb\x72\x3e\xa8\x91\xfd\xbc\x9f\x25\xf0\x7e\x36\x7a\xbf\xc0\x81\xc7\xf2\x3f\x6b\x67\x1a\xd3\x99\x12\xd8\xbd\x34\xda\xbd\xb0\xd1\x35\x12\x45\xf7\x80\x27\xed\xbd\xfb\xe1\xdd\x7f\x01\xab\x9c\xf6\x78\x92\xdd\x00\x00")
 
 func dataHotelsJsonBytes() ([]byte, error) {
 	return bindataRead(
@@ -106,12 +111,32 @@ func dataHotelsJson() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "data/hotels.json", size: 3702, mode: os.FileMode(420), modTime: time.Unix(1502238721, 0)}
+	info := bindataFileInfo{name: "data/hotels.json", size: 56722, mode: os.FileMode(420), modTime: time.Unix(1792281160, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _dataInventoryJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8a\xe6\x52\x50\x50\x50\xa8\x06\x93\x20\xa0\x94\x91\x5f\x92\x9a\xe3\x99\xa2\x64\xa5\xa0\x64\xa8\xa4\x83\x10\x4f\xce\x4f\x49\x05\x09\x06\x39\x3a\x7b\x23\x8b\x67\xe6\xb9\x24\x96\x80\x65\x8c\x0c\x0c\x4d\x75\x0d\x4c\x74\x0d\x2c\x91\xe5\xf3\x4b\x4b\xd0\x15\x18\x1a\x20\x2b\x28\xca\xcf\xcf\x0d\xa9\x2c\x00\xa9\x40\x38\x43\x41\x41\x29\x29\x3f\x3f\x3b\x31\x29\x27\x35\x08\xa2\xdb\xd0\xc0\x52\xcf\xc0\x40\x07\x59\x05\xcc\x49\xde\x7e\xee\x4a\x28\x12\x29\xa9\xc5\xc9\x45\x99\x05\x25\x99\xf9\x79\x60\xf9\xcc\xbc\x74\x85\xe2\xcc\xaa\xd4\x14\x85\xa4\xd4\x14\x54\xa5\x25\xf9\x25\x89\x39\xb8\xad\x80\x4b\x7b\xe6\x25\xe7\x94\x16\x67\x96\x81\xd5\x19\x19\xeb\x19\x9a\xc3\x95\xd5\x82\x59\xb5\x3a\x78\x42\xd2\x68\x50\x85\xa4\x31\xce\x90\x0c\xf4\xc3\x1b\x90\x81\xa5\xa9\xa9\x79\x44\x85\x24\xa6\x15\xd8\x43\xd2\xd4\x58\xcf\xc0\x92\x94\x90\x34\x1e\x54\x21\x39\xd8\xd3\x24\x57\x2c\x17\x20\x00\x00\xff\xff\x34\xed\xf5\xf8\xe1\x03\x00\x00")
+var _dataImagesJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb5\xdc\xbd\x6e\x53\x41\x10\x80\xd1\xde\x4f\x11\xb9\x4e\x91\xdd\x99\x9d\xb1\x79\x03\x9e\x01\x51\x24\x8a\x23\x90\x1c\x2c\x19\x47\x02\x21\xde\x9d\x24\x0d\x14\x34\x04\x9d\x5b\xdc\xe2\x5e\x69\x8b\xaf\x3a\xda\xbf\x0f\x9b\xab\xe7\xe7\xc7\xeb\xfb\xe5\xd9\x7e\x3a\x5d\x0e\xc7\xf7\xf7\xdb\x77\x57\xdb\xb1\xbd\xfe\xfd\xfd\xcb\xed\xe3\xe1\xe5\xe3\xe1\xdb\xe5\x70\xfe\x7c\x3a\xff\xf9\xef\xfe\xf0\x70\xfb\x74\xbc\x3c\xff\xbe\x9c\x9f\x0e\xaf\xdf\x7f\x5e\xff\xeb\xc0\xc7\xd3\xdd\xdd\xf7\xbf\x8f\xfa\x70\x7b\xfc\xfa\xd6\x61\xcf\xa7\xd3\xe3\x7f\x8d\x3a\x55\x85\x69\x2a\x4c\x52\x21\x54\x85\x30\x15\x82\x54\x48\x55\x21\x4d\x85\x24\x15\x96\xaa\xb0\x4c\x85\x45\x2a\x94\xaa\x50\xa6\x42\x91\x0a\xad\x2a\xb4\xa9\xd0\xa4\xc2\x4e\x55\xd8\x99\x0a\x3b\x52\x61\xaf\x2a\xec\x4d\x85\x3d\xa9\x30\x6e\x18\x9b\x6e\x90\x9b\x6e\x4c\x08\xe7\x47\x05\x48\x23\xc8\xc1\x08\x39\x90\x21\x87\x41\xe4\x60\x8a\x1c\x88\x91\xc3\x38\x72\x30\x48\x0e\x24\xc9\x61\x28\x39\x98\x25\x07\xc2\xe4\x30\x9a\x1c\x8c\x93\x03\x79\x72\x18\x50\x0e\x26\xca\x81\x48\x39\x8c\x29\x07\x43\xe5\x40\xaa\x1c\x86\x95\x83\xb9\x72\x20\x58\x0e\x23\xcb\xc9\x64\x39\x91\x2c\xa7\x91\xe5\x64\xb2\x9c\x48\x96\x13\xcd\x4d\xba\xc9\x49\x35\x3b\x69\x64\x39\x99\x2c\x27\x92\xe5\x34\xb2\x9c\x4c\x96\x13\xc9\x72\x1a\x59\x4e\x26\xcb\x89\x64\x39\x8d\x2c\x27\x93\xe5\x44\xb2\x9c\x46\x96\x93\xc9\x72\x22\x59\x4e\x23\xcb\xc9\x64\x39\x91\x2c\xa7\x91\xe5\x64\xb2\x9c\x48\x96\xd3\xc8\x32\x98\x2c\x03\xc9\x32\x8c\x2c\x83\xc9\x32\x90\x2c\xc3\xc8\x32\x98\x2c\x03\xc9\x32\xd0\xc2\xb7\x5b\xf9\x56\x4b\xdf\x46\x96\xc1\x64\x19\x48\x96\x61\x64\x19\x4c\x96\x81\x64\x19\x46\x96\xc1\x64\x19\x48\x96\x61\x64\x19\x4c\x96\x81\x64\x19\x46\x96\xc1\x64\x19\x48\x96\x61\x64\x19\x4c\x96\x81\x64\x19\x46\x96\xc9\x64\x99\x48\x96\x69\x64\x99\x4c\x96\x89\x64\x99\x46\x96\xc9\x64\x99\x48\x96\x69\x64\x99\x4c\x96\x89\x64\x99\x68\x57\xa5\xdb\x56\xa9\xf6\x55\x1a\x59\x26\x93\x65\x22\x59\xa6\x91\x65\x32\x59\x26\x92\x65\x1a\x59\x26\x93\x65\x22\x59\xa6\x91\x65\x32\x59\x26\x92\x65\x1a\x59\x26\x93\x65\x22\x59\xa6\x91\xe5\x62\xb2\x5c\x48\x96\xcb\xc8\x72\x31\x59\x2e\x24\xcb\x65\x64\xb9\x98\x2c\x17\x92\xe5\x32\xb2\x5c\x4c\x96\x0b\xc9\x72\x19\x59\x2e\x26\xcb\x85\x64\xb9\xd0\x91\x1d\x77\x66\x47\x1d\xda\x31\xb2\x5c\x4c\x96\x0b\xc9\x72\x19\x59\x2e\x26\xcb\x85\x64\xb9\x8c\x2c\x17\x93\xe5\x42\xb2\x5c\x46\x96\x8b\xc9\x72\x21\x59\x2e\x23\xcb\x62\xb2\x2c\x24\xcb\x32\xb2\x2c\x26\xcb\x42\xb2\x2c\x23\xcb\x62\xb2\x2c\x24\xcb\x32\xb2\x2c\x26\xcb\x42\xb2\x2c\x23\xcb\x62\xb2\x2c\x24\xcb\x32\xb2\x2c\x26\xcb\x42\xb2\x2c\x74\x1e\xdc\x1d\x08\x57\x27\xc2\x8d\x2c\x8b\xc9\xb2\x90\x2c\xcb\xc8\xb2\x98\x2c\x0b\xc9\xb2\x8c\x2c\x8b\xc9\xb2\x90\x2c\xcb\xc8\xb2\x99\x2c\x1b\xc9\xb2\x8d\x2c\x9b\xc9\xb2\x91\x2c\xdb\xc8\xb2\x99\x2c\x1b\xc9\xb2\x8d\x2c\x9b\xc9\xb2\x91\x2c\xdb\xc8\xb2\x99\x2c\x1b\xc9\xb2\x8d\x2c\x9b\xc9\xb2\x91\x2c\xdb\xc8\xb2\x99\x2c\x1b\xc9\xb2\xd1\x65\x43\xee\xb6\x21\x75\xdd\x90\x91\x65\x33\x59\x36\x92\x65\x1b\x59\x36\x93\x65\x23\x59\xb6\x91\xe5\x8e\xc9\x72\x87\x64\xb9\x7b\xbb\x2c\x37\x1f\x37\xbf\x00\xa7\x91\xee\x5a\xb8\x52\x00\x00")
+
+func dataImagesJsonBytes() ([]byte, error) {
+	return bindataRead(
+		_dataImagesJson,
+		"data/images.json",
+	)
+}
+
+func dataImagesJson() (*asset, error) {
+	bytes, err := dataImagesJsonBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "data/images.json", size: 21176, mode: os.FileMode(420), modTime: time.Unix(1792282893, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _dataInventoryJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbd\xdb\x3d\x6b\xc2\x50\x14\x80\xe1\xdd\x5f\x11\x32\x3b\xe4\xde\xf3\x95\xf4\x0f\x94\x52\xb0\x58\xba\x95\x2e\x9a\xb4\x15\xaa\x11\x3f\x86\x56\xfc\xef\xd5\xce\xb5\xa0\xd8\xd7\x49\x62\xf0\x70\xe0\x1d\xc2\x7d\xf4\x79\x50\x1c\x5e\xbb\xf2\xbd\xdf\x74\x1f\x77\x6d\x79\x53\x94\xa9\x1c\x16\xe5\xaa\xef\xe7\x4f\x9f\xcb\xee\x70\x61\x57\x4e\xfb\xf6\xf8\xa6\xbc\x1f\xdd\x1e\x3f\x6b\xbb\xf5\x74\x35\x5b\x6e\x66\xfd\xe2\xe7\xea\x6c\xf1\x56\xac\x67\x5f\x5d\x5b\x4c\xba\xf6\x78\xc3\x62\x3b\x9f\x74\xab\x87\xd7\xc7\xc3\x97\x1c\xee\x48\x55\xb5\xdf\x0f\xcf\x19\x34\x1e\xfd\x32\x67\xbc\xed\xba\xc5\x45\x83\x32\xb5\x51\xa6\x36\x12\x6a\x23\xa1\x36\x52\x6a\x23\xa5\x36\x32\x6a\x23\xa3\x36\x72\x6a\x23\xa7\x36\x8a\xab\x6f\x64\xe7\x0e\xba\x74\xa3\x13\x83\xea\xab\x6f\x94\xed\xcc\x41\x97\x6e\x74\x62\x50\x43\x55\xd7\x50\xd5\xa5\x8a\xca\xee\x8f\x49\x57\xee\x2e\x25\x2a\xbc\x94\xa8\xf2\x12\xf6\xe0\x90\xb0\x27\x87\x24\x58\x7b\x82\xb5\xa7\x58\x7b\x8a\xb5\x87\x3d\x3e\x24\xec\xf9\x21\x39\xd6\x9e\x63\xed\x05\xd6\x5e\x60\xed\xd5\x58\x7b\x35\xd6\x5e\x83\xb5\xd7\x50\xed\xe5\x8a\x6a\x2f\x57\x54\x7b\x19\x3b\x7e\xc8\xdc\xf9\x43\xa6\xda\xcb\x19\x6b\x4f\xb0\xf6\x04\x6b\x0f\x3b\x84\xc8\xd8\x29\x44\x36\xac\x3d\xc3\xda\x73\xac\x3d\xc7\xda\x0b\xac\xbd\xc0\xda\xab\xb1\xf6\x6a\xac\xbd\x06\x6b\xaf\xa1\xda\x93\x0a\x3b\x4e\xae\xb0\x13\xf2\x44\xb5\x27\x89\x6a\x4f\x32\xd5\x9e\x64\xac\x3d\x8e\x32\x30\xcb\x10\xc5\xda\x53\xac\x3d\xc3\xda\x33\xac\x3d\x0c\x34\x04\x13\x0d\xc1\x48\x43\x30\xd3\x10\x0c\x35\x04\x53\x0d\xc1\x58\x43\x30\xd7\x50\xcc\x35\x14\x73\x0d\xc5\x5c\x43\x31\xd7\x50\xcc\x35\x14\x73\x0d\xc5\x5c\x43\x31\xd7\x50\xcc\x35\x14\x73\x0d\xc5\x5c\x43\x31\xd7\x50\xcc\x35\x14\x73\x0d\xc5\x5c\x43\x31\xd7\x50\xcc\x35\x14\x73\x0d\xc5\x5c\x43\x31\xd7\x30\xcc\x35\x0c\x73\x0d\xc3\x5c\xc3\x30\xd7\x30\xcc\x35\x0c\x73\x0d\xc3\x5c\xc3\x30\xd7\x30\xcc\x35\x8c\xfb\x75\x25\xe6\x1a\x86\xb9\x86\x61\xae\x61\x98\x6b\x18\xe6\x1a\x86\xb9\x86\x61\xae\x61\x98\x6b\x18\xe6\x1a\x86\xb9\x86\x63\xae\xe1\x98\x6b\x38\xe6\x1a\x8e\xb9\x86\x63\xae\xe1\x98\x6b\x38\xe6\x1a\x8e\xb9\x86\x63\xae\xe1\x98\x6b\x38\xe6\x1a\x8e\xb9\x86\x73\x7f\xd4\xc0\x5c\xc3\x31\xd7\x70\xcc\x35\x1c\x73\x0d\xc7\x5c\xc3\x31\xd7\x70\xcc\x35\x02\x73\x8d\xc0\x5c\x23\x30\xd7\x08\xcc\x35\x02\x73\x8d\xc0\x5c\x23\x30\xd7\x08\xcc\x35\x02\x73\x8d\xc0\x5c\x23\x30\xd7\x08\xcc\x35\x02\x73\x8d\xc0\x5c\x23\x30\xd7\x08\xcc\x35\x02\x73\x8d\xc0\x5c\x23\x30\xd7\x08\xcc\x35\x6a\xcc\x35\xea\xff\x71\x8d\xc1\xcb\xe0\x1b\x95\x7b\xb9\xa6\x31\x42\x00\x00")
 
 func dataInventoryJsonBytes() ([]byte, error) {
 	return bindataRead(
@@ -126,12 +151,12 @@ func dataInventoryJson() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "data/inventory.json", size: 993, mode: os.FileMode(420), modTime: time.Unix(1502245313, 0)}
+	info := bindataFileInfo{name: "data/inventory.json", size: 16945, mode: os.FileMode(420), modTime: time.Unix(1792279400, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _dataLocalesJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x4c\x8f\x31\x4b\xf4\x40\x10\x86\xfb\xfb\x15\x2f\xa9\xf3\x7d\x20\x88\x85\x9d\x60\x63\x71\x20\x04\x2b\xb1\x98\xec\x4e\x2e\x43\xe6\x66\xe3\xec\xae\x67\x10\xff\xbb\x6c\xae\xd0\x29\xa6\x78\xde\xe1\x61\xde\xd7\x03\x00\x7c\xed\xbb\x4d\x37\xa7\xc2\xfa\x14\xbb\x7b\x74\x37\x5d\xff\xcb\x35\x05\x52\x6e\x98\xed\x2f\x8f\x9c\x83\xcb\x5a\x24\x59\x0b\x1f\x70\xf7\xef\x2c\x56\x0b\xe3\x42\xba\x60\xf2\x74\xc6\x8b\x49\x32\x0c\xef\x95\x9c\x41\x16\x71\x8b\xeb\x4d\xbe\xe6\x84\x63\x35\xc1\x91\x8b\x27\xe4\x42\x4d\xd6\xa3\xcc\x92\xa1\xf5\xb3\xfa\x86\xfd\x2b\x44\xce\x72\x32\x8e\x18\x37\x3c\xcf\xa2\xb2\xae\x8c\xa1\x90\x87\x05\x13\x53\xa9\xce\x19\x64\x20\x2f\x79\xc3\x54\xdd\xa4\x31\x84\xa4\xca\xa1\x59\x21\x86\x32\x33\x34\x8d\xe3\xd6\x43\x2c\x68\x8d\x62\x27\x5c\x92\x2f\x4d\x3b\x90\x7e\x50\x4c\x8e\x47\x52\xf9\xdf\xed\x3d\xbf\x0f\x6f\x87\x9f\x00\x00\x00\xff\xff\x84\x3e\x09\x90\x28\x01\x00\x00")
+var _dataLocalesJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb5\x58\x4d\x4f\x5b\x47\x14\xdd\xe7\x57\x5c\xb1\xc9\xc6\x8e\x42\x20\x51\xe9\xce\x90\x0f\x22\x05\x09\x85\xa4\x51\x5b\x75\x31\x9e\x37\xb6\x27\xcc\x9b\x71\xe6\xc3\xe0\x54\x95\xfa\x1f\xfa\x07\xda\x6e\x2a\x16\x54\xaa\x52\x75\x51\x75\xf7\xd4\xff\xd5\x73\xe7\x19\xe3\x87\x8d\x31\x38\x45\x08\xc3\xcc\x7b\x33\x73\xcf\x9c\x73\xee\xbd\x7c\x7b\x8f\xf0\xf5\x7d\xfe\xc9\x5f\x1b\x03\x17\x95\x79\x59\x6c\x7c\x49\x1b\x9b\x1b\xad\xcb\x71\xe3\xa4\x30\x8a\x87\x95\x9d\x1d\x2f\x54\x90\x5e\x0f\xa3\x76\x96\x27\x3b\xf4\xa4\x5d\x6a\x9b\xa2\xa2\x13\x61\x8e\xa9\xe7\x5d\x49\x6f\x2d\x66\xe9\xe8\x43\x12\x5e\x91\xb0\x05\x6d\x53\xfd\x4c\xa8\xe7\x05\x1d\x24\xab\xe9\x40\x45\xef\x28\x44\xc1\x8b\xb5\x28\x0e\x74\x20\x93\x4e\x93\x1f\x53\x3e\x15\x61\x2f\xdd\xb7\xaa\xa0\xee\x98\x0e\x07\xda\xe8\xe1\x50\xd1\x51\x14\x5e\x62\x23\x25\x62\xf2\x58\x51\x58\x12\x3e\x86\x31\xf5\x92\xb7\x9a\xc7\x48\x3a\x63\x94\xe4\x55\x49\x5b\xac\xab\xc8\xb8\x6e\x77\xdc\xc2\x5f\xd2\xa4\x42\xdb\x3e\x9d\x38\x7f\xcc\xcb\x1e\x09\x33\x12\x85\xf3\xf4\x54\x18\xfd\x60\x23\xc7\xf9\x43\xeb\xf6\x20\xf5\xfc\xec\xb8\x15\x65\x1e\xdd\xaf\xfe\xe4\x38\xf6\x8c\xee\xc5\x25\x20\x56\x3f\xd2\x93\x29\x42\xd5\xcf\x34\xd4\x88\xb9\xb8\xdf\x80\x51\x45\x9e\xb9\x04\xb2\xb8\x9f\xac\xba\x00\x8f\x8a\x34\x03\x69\x8b\x24\x9e\x1e\xd4\x7b\x17\x8a\x31\x65\x4c\x6c\xf5\x5b\xa2\xa1\xf0\x73\x50\x0e\x7d\x75\x16\x94\xc5\x15\x16\xc2\x06\x0a\x58\x6f\x20\x8c\x21\xde\x60\x06\x4a\xac\x54\xba\x2e\x5e\x55\x1e\xbb\x03\xf3\x16\x15\xce\x46\xbe\x25\xfa\xf7\xa7\x34\xe2\xcb\xc0\x33\xb3\x88\x56\xe7\x6b\x41\xda\xde\xeb\xac\x8a\x1a\x1f\x0e\xc1\xe0\xaa\x3f\x33\x6e\xcc\xc0\xea\x6c\x0e\x33\x75\x3a\x74\x61\x05\xc0\x54\xea\x9a\xbc\xeb\xff\x8f\x97\x0a\x0b\x29\xe8\x56\x61\x60\xe7\x02\x4a\x07\x41\x31\xff\xf8\x64\x0d\x1c\xc7\x18\xdf\x9e\x3e\x83\xd9\x64\x05\x29\xe0\x28\x75\xf5\x89\x63\x35\x0d\x24\x31\xa3\xa6\x2a\x06\x92\xef\x1d\x15\x3a\xa8\xea\x0f\x84\x4a\x43\x37\x4f\xc2\xa8\x15\xd0\x53\x96\xf0\xc6\x08\x6f\x57\xe7\xdd\x64\x5c\xde\x05\x90\x2a\x79\xb1\x0d\x95\x49\x65\x48\x01\x68\x75\x1e\xa2\x96\x2e\xb4\x98\xdd\xe4\xba\x5e\x7c\x5e\x44\x0b\xb5\x04\xb2\xa7\x5a\x05\x1c\x63\x84\x9d\xaf\xc6\xd2\x67\x5c\x4c\x54\x80\xe0\x15\xfc\x2c\xd4\x38\x40\x38\xfd\x08\x9c\x5f\xa8\x41\x4d\x46\x8b\x97\xaf\x98\x65\xca\x66\x79\x30\x9d\x06\x1c\xda\x42\x6f\x0c\x6d\x3b\x43\xdb\x3e\x9a\x50\x17\x82\xed\x29\x0f\x3e\xf1\x3b\x1f\x95\xc6\xda\x9a\x01\xf2\xf4\x8a\xcd\x2e\xbf\x48\xc7\xd5\x3f\x36\x44\xa3\xbc\x0e\xac\x8d\x83\xea\xaf\xae\x32\x41\x94\xa5\x49\xb6\x0f\x3e\x0a\x9f\xa0\x7b\x4f\xef\x94\x3f\x56\x79\xbf\xdb\x83\xf7\x68\x15\x47\x9c\xd7\x6e\xe9\xb4\xad\xe5\x48\xc3\xea\xd7\x08\x8d\x65\x0d\x6b\xa8\x29\xb0\x2e\xbf\x56\xbe\x2b\x68\x37\x29\x30\x60\x4f\xe5\x53\xf6\x70\x2c\x76\xf3\x0e\xfc\xbe\x21\x56\x80\x05\x25\x4a\xa8\x55\x11\x30\x4a\x23\xc5\xa2\xdf\x7c\x74\x83\xa9\xae\x15\xdd\x8d\xe6\x34\x13\xa0\x74\xf9\x6a\xc8\x27\x75\xdb\xd0\x40\x6a\x2b\x07\x40\x07\x9c\xba\x12\xd4\x75\x9e\xb7\x46\x58\x4d\x0f\x99\x33\x89\x52\xd9\x4b\xf1\xcb\x24\x0a\x2f\xb2\xf2\x6f\x0c\x28\xdb\x41\xe9\xc0\x4e\xeb\x26\xb6\xc0\x1a\xff\x05\xa6\x72\x11\xd0\x75\xce\xb3\x46\x34\xab\xe8\x37\x7a\x70\x47\xf7\x15\xed\xcf\x88\xf4\x44\x59\x0c\x79\x12\x26\x64\x1d\x59\xda\xc5\xaa\xc7\x59\xaf\x37\xc4\x9a\xe5\x88\x98\x96\xa9\xfc\x42\xba\xab\x84\xb6\x75\x87\x7a\xe3\x1b\x15\xa3\x58\x4e\xce\xad\x79\x69\x24\xc8\xc8\xf3\x68\xc8\x29\x2a\x7a\x51\x9e\x88\x71\xbe\xee\x43\x77\xa2\x90\xdf\x8e\x00\x16\x98\x89\xef\x66\x16\xdd\xed\xbc\x7e\xb3\x98\xb4\x01\x05\x19\x3e\xb0\xc7\xce\x4c\x06\x6e\xd6\x87\x06\x98\xe3\xc5\xb3\xae\xf2\x7d\x05\x86\x61\x15\xdd\x1f\xb4\xa3\x92\x03\xde\x2a\xba\x04\x8a\x67\xbf\xd7\xb0\xfb\x0f\x49\x85\x35\x70\xbb\x81\xe0\x5b\x73\x5c\x34\xd4\xd3\x56\x98\xfc\x1b\x20\xb1\xa3\xea\x5c\xcc\x43\x32\x5e\x94\x11\x27\xb8\x34\x53\x21\x44\x20\xb0\xf8\xce\x6c\x2a\x6d\xe0\x21\x5d\xd9\xc5\x8e\xc0\xc5\xbd\x17\x25\xb2\x62\x74\xfc\x0c\xf2\x89\xc0\x05\x49\xeb\x8c\xeb\xf3\x19\x38\xe5\x45\xc7\x70\xcc\xa6\xc2\x35\xa0\x59\x45\x2d\xc2\x22\xb7\x89\x7e\x9c\xc8\xa5\x05\xc0\x1a\x44\xaf\x13\xd0\x9e\x40\x86\x6e\xef\x09\xdf\x7e\x07\x71\x71\xfc\xa8\x89\xb8\x50\xe7\xc9\x26\x70\xac\x96\x3a\xc5\x31\x58\xd3\xe4\xc6\xc3\x3b\x33\x79\xf0\x1a\x01\xb5\x50\x2a\x78\xa0\x55\x60\xa5\x7d\x90\x86\x39\xd3\x7e\xcb\x9a\xe4\xc4\xd7\x63\xcf\xd1\xf1\x4a\x12\xb4\xd4\x39\xfe\xc8\xba\xb5\xab\x60\xb5\x7d\x07\xf9\x7d\xa5\x23\x3f\x76\x3d\x98\x7b\x97\x4a\xc1\x71\xba\xce\x17\x75\xad\x08\xc3\x19\x29\x49\x23\x64\x89\x90\x3c\x01\xb3\x5d\x31\xa6\x5d\xaf\x0b\xf8\xd3\xc4\xff\xb7\x38\x89\xe4\x04\xf9\x1c\x2c\xb1\x52\x83\x9b\x4f\x21\x0c\xaf\x65\x9c\x2b\x76\x67\xb4\xfd\x5c\x79\xf4\x55\xbb\x49\x1b\xee\x7f\xd6\x08\x7d\xa9\x82\x9e\x5d\x72\xbd\xe7\x73\x4b\x81\xe3\x21\x49\x65\xb6\x8e\x70\x4c\x54\x68\x18\x69\x86\x95\xd3\xc0\xd6\x24\x9f\x84\xac\xb4\x05\xb1\x35\x0b\xd0\x4b\x79\xde\x3e\xb0\xc7\x77\xb8\xd3\xc3\x81\x43\x4e\x38\x5d\x12\xfa\x51\x6d\x75\xb9\x23\xc0\xcd\x31\x51\x63\x6e\x95\x12\xbd\x61\x15\x78\x83\x22\xa0\x95\x53\xf8\xc3\x05\x75\xc9\x22\x3f\x85\x59\xf0\xde\x68\xcf\xb8\x51\x86\x25\x48\x99\x94\x86\x5f\x32\x5d\x2c\x7c\xc2\xab\x74\x8a\x82\x38\x68\x09\x9f\xc8\x56\xe2\x39\x4d\x81\x06\x22\x45\xee\x2f\x64\x75\x66\xe0\xac\x5e\xa3\xbe\xe2\xf9\x61\xe2\x1e\x3b\x0b\x19\x5d\x0d\x3e\x37\x77\x1e\x3f\x7c\x40\x2f\x4d\xb3\x72\x5a\xc0\x20\x14\xf1\x02\x54\xad\x7e\x8f\x39\x43\xdb\xa4\xd9\xa7\x43\x74\x9e\x2d\x99\x5e\x78\xf4\xe3\xd4\x01\x87\xb5\x84\xd0\x0f\xf8\x4c\xb4\x0f\x6f\x5f\xe3\x42\x96\x32\x8d\xe1\xe6\x5e\xa2\xee\x18\xba\xc2\x7b\x9d\x7d\x72\x16\x6b\x31\x85\x7a\xb6\xb8\x58\x66\xd3\x13\xc0\xf3\x3f\x26\x06\x13\x0f\x2e\x18\xfa\x12\x49\x08\x8b\x94\xd5\xdf\x81\x6d\x76\x0a\xf5\x98\x5c\x64\xd2\x4a\x65\x14\x60\x2e\x44\x91\x0b\xb3\x50\xe3\x55\x54\x67\x52\x14\x39\x5f\xd4\x40\x3f\x9b\xb0\x7d\x11\x91\x19\xcd\xea\x13\xf0\x73\x24\x4d\xea\xe2\x82\x65\x4c\x5c\x2f\xad\x85\xed\x93\x3b\x54\xe7\xaf\x04\xec\xf9\x01\xbd\x56\x7d\xb0\x05\xdb\xa9\x54\xd2\x1b\xd8\xb6\xcf\x26\x84\x7a\xb6\xef\x45\x8c\xaa\x0d\xd6\xe5\x9c\xb6\xfd\x88\x40\x51\x81\xd4\xc0\xd4\xdb\xdc\xfe\x82\xca\x05\x3a\x40\x7b\xe4\x52\x1c\x90\xeb\xd1\x81\x40\xbb\x91\xbd\xea\x08\x01\x3d\xe7\x6a\x01\xde\xcc\x6d\xa3\x45\xf2\x40\xaf\xea\xbc\xd5\x2a\x8b\x45\x42\x7f\xb9\x37\x08\x8d\xca\xeb\x85\xf0\x05\x28\xdf\xca\x1d\xbc\xc3\xbb\x90\x4f\x5d\x8d\xe5\xa1\x43\x21\x77\x39\xcb\x5c\xd8\x42\xae\x59\x52\x73\xb7\x8b\xc8\xf8\x3c\xb9\x32\xe5\xfa\x6d\x0d\x48\x97\xd2\x75\x19\xa4\x8c\x28\x38\x24\x05\xe3\x59\x73\x0b\x88\x0e\xd1\x09\x05\xf0\xab\x86\xb3\xa6\x79\x91\xcd\xb0\x2e\x08\xae\x82\x99\x1b\xdf\x06\x98\x53\x24\x45\x8b\xde\x27\xae\x23\x04\xf1\xfa\x0b\x71\xe4\xfe\xfd\x0a\x8e\x6c\x7b\x57\x71\x1c\xf3\xe8\xea\x30\xde\xfb\xee\xde\x7f\x98\x78\x04\x68\x72\x14\x00\x00")
 
 func dataLocalesJsonBytes() ([]byte, error) {
 	return bindataRead(
@@ -146,7 +171,87 @@ func dataLocalesJson() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "data/locales.json", size: 296, mode: os.FileMode(420), modTime: time.Unix(1502238721, 0)}
+	info := bindataFileInfo{name: "data/locales.json", size: 5234, mode: os.FileMode(420), modTime: time.Unix(1792282704, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _dataPricingJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8b\xe6\x52\x00\x82\x6a\xa5\xbc\xc4\xdc\x54\x25\x2b\x05\xa5\xe2\xd2\xa2\xf4\x54\x25\x1d\x05\xa5\xdc\xcc\x3c\xff\xe4\xe4\xd2\x82\xc4\xbc\xe4\x4a\xa0\x84\x81\x9e\x05\x48\xb0\x34\xa7\x24\xb3\x20\x27\x33\xb5\x08\x28\x64\xa8\x67\x64\x5a\xab\x83\xa6\x3f\x25\xb3\x38\x39\xbf\x34\xaf\x04\x9b\x11\x20\xa1\xc4\x0a\x54\x53\x8d\xd1\x4d\x35\xd0\xb3\xac\xe5\x8a\xe5\x02\x00\x70\x29\xc9\xe7\x98\x00\x00\x00")
+
+func dataPricingJsonBytes() ([]byte, error) {
+	return bindataRead(
+		_dataPricingJson,
+		"data/pricing.json",
+	)
+}
+
+func dataPricingJson() (*asset, error) {
+	bytes, err := dataPricingJsonBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "data/pricing.json", size: 152, mode: os.FileMode(420), modTime: time.Unix(1792282021, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _dataRatesJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xed\x9c\xdf\x6b\xdb\x66\x18\x85\xef\xf3\x57\x04\x5f\xa7\x46\xd6\x27\x59\xf2\xee\xca\x02\xa3\x14\x0a\xed\xb6\xab\xb1\x8b\xfc\x10\xad\x59\x66\x95\xc4\x29\xb4\xa3\xff\xfb\x66\x8f\xa5\x4d\x22\xcf\xee\xa9\x75\x5e\xc5\x3c\xb9\x28\xc1\x92\x53\x71\x78\x30\x79\xf3\x7e\xe7\xf9\xed\xe8\xf8\x9f\xaf\xbf\xd6\xff\xae\xbe\x46\xef\xda\x65\x73\xf5\xe2\x72\xf4\xc3\xf1\x68\x32\x3a\xf9\xf2\xfa\x45\x7b\xd9\xac\x5e\x7c\xf3\xfc\xc7\x97\x5f\xbf\x3e\x5f\x9c\x9e\x2d\xd7\x57\xf2\x6c\x52\x3e\xcb\x8a\x67\xd9\xbd\xf7\xb5\xb7\xcb\x47\x37\xa4\xaf\x6f\xb8\x6e\xdb\x3f\x7f\xf9\xf8\x7e\x75\xc7\x97\xc7\x58\x5f\x39\x6f\xdb\x3f\xce\xce\xaf\x9a\x37\xff\xbe\x7f\x92\xcd\x4e\xee\xdf\xf0\xdf\x33\xbd\x7c\xf5\xd3\xe8\xe1\xa5\xdb\xeb\xeb\x66\x71\xf1\x71\x75\xf9\xd7\x9f\x4f\x1f\x5e\x5e\xfd\xa7\xa7\xcd\xcd\xc5\xf5\xfc\xfd\x72\xde\x2e\xd6\x3f\x64\xbe\x78\x7b\x7c\x33\xff\xd4\x5c\x1e\x9f\x37\x97\x0f\xdf\xb0\x6c\x97\x67\x57\x1b\x1f\xe4\xee\xea\x8b\xc5\xc5\xd5\xed\xcd\xfc\xc3\xfa\xb6\x3c\x8d\x27\xd5\xdd\x8d\x9f\xd7\xdf\x7d\x3e\xe9\x21\xf0\xb4\x2d\xf0\x52\x0b\x3c\x65\xe3\x7a\x20\x91\x77\x3c\x4a\x77\xe8\x45\x35\xae\x1d\x99\x97\x5b\x32\x9f\x64\x40\xbe\xcf\xc0\xef\xe7\xd9\x15\x78\x0e\xe4\xfb\xce\x3c\xdf\x96\x79\x05\xe4\x7b\x0d\xbc\xda\x16\xf8\x0c\xc8\xf7\x9d\xf9\x6c\x4b\xe6\x79\x01\xe4\xfb\x0c\xfc\x7e\x9e\x5d\x81\x4f\x81\x7c\xdf\x99\x4f\xff\x37\xf3\xf2\xc1\x2f\xed\x40\x7e\x17\x78\x3e\xb0\x21\x28\x6d\x0a\xfc\xf5\xab\xef\xcf\xfb\xf5\x6d\xd3\x2c\x76\x45\x7c\xb7\xc0\xcb\x34\xce\x66\x8e\xc0\xfb\x1a\x82\xa6\xd3\x8d\x9f\x2a\xee\xc8\x3b\x1e\xa5\x3b\xf4\x3a\x8d\xab\x89\x23\xf4\xbe\xa6\x20\x28\x37\x4f\x41\x50\x1e\x30\x06\x41\xb9\x79\x0c\x82\xf2\x80\x39\x08\xca\xcd\x73\x10\x94\x07\x0c\x42\x07\x4f\x79\x62\x1b\xe4\x9d\x3c\x13\xdb\x20\xfb\x9f\x57\x12\xdb\xa0\x27\x01\x39\xdb\x20\x3f\xe4\x6c\x83\xdc\x90\xb3\x0d\xf2\x43\xce\x36\xc8\x0b\x39\xdb\x20\x3f\xe4\x6c\x83\x54\xc8\x67\xc3\x1a\x82\xf2\x94\x0f\x23\xf0\xc7\x0f\xd2\x19\x78\x5e\xd6\x8e\xb4\x7b\x9a\x80\xf2\xaa\x1e\x17\x03\xc9\xbb\xe3\x51\x3a\x13\x4f\xd9\x6c\x3c\x75\x64\xde\xd3\x04\x04\xe1\xce\xf1\x07\xc2\xfd\xe3\x0f\x84\x3b\x67\x1f\x08\xf7\xcf\x3e\x10\xee\x1c\x7c\x20\xdc\x3f\xf8\x1c\x38\xe1\x93\xa1\x1d\x82\xcb\x07\x02\xf8\xe3\x07\xd9\x30\xd7\x17\x96\xb8\xfb\xda\xfc\x14\xf5\x60\xfe\x94\xd2\xf1\x28\xdd\x91\x57\xf9\xd8\xc3\x78\x5f\xab\x1f\x18\xb7\x2e\x7e\x60\x3c\x60\xf3\x03\xe3\xd6\xbd\x0f\x8c\x07\x2c\x7e\x60\xdc\xba\xf6\x81\xf1\x80\xbd\xcf\x81\x33\x5e\x72\xf4\xcd\xdc\x2c\x2c\x39\xfb\xe6\xaf\x16\x96\x1c\x7e\x7b\x1a\x98\x73\xfa\x2d\x00\x73\x8e\xbf\xd9\x31\xe7\xfc\x5b\x00\xe6\x1c\x80\x33\x63\xce\x09\xb8\x00\xcc\x39\x02\x27\x63\x5e\x0f\xcd\x88\x90\x0f\x05\xf2\x7c\xb7\xe6\x55\x6d\x89\xbb\xaf\x51\xa8\x1c\xcc\x7a\xb9\xeb\x51\x36\xb4\x0b\xbf\x71\xbd\x2c\x87\xde\x9b\x0f\x01\xc6\x9d\x73\x10\x8c\x07\xcc\x41\x30\x6e\x1d\x82\x60\x3c\x60\x08\x82\x71\xeb\x04\x04\xe3\x01\x13\xd0\x61\x33\x9e\x0f\xcd\x8a\x9d\x67\x43\xd9\xbd\x65\xbb\x4d\xf8\x99\x25\xee\xde\xce\xc2\x0d\xe5\x03\xa5\xd8\xed\xe3\x64\xea\xa1\xbb\xb7\x53\x70\xd0\x6d\x3d\x05\x07\xdd\xd6\xf3\x6f\xd0\x6d\x3d\xff\x06\xdd\xd6\x93\x6f\xd0\x6d\x3d\xf9\x06\xdd\xd6\x33\x6f\x87\x4d\x77\x81\xe9\xc0\xd8\xb0\x92\xe3\x46\x75\xa0\x97\x08\xe5\xd0\x71\x1d\x38\x19\x47\x76\x10\xc0\x38\xb6\x03\x2f\xe3\xe8\x0e\x02\x18\xc7\x77\xe0\x64\x1c\xe1\x41\x00\xe3\x18\x0f\x34\xc6\x2b\x8c\x07\xc6\x86\x95\x1c\x37\xc6\x03\xbd\x45\x28\x87\x8e\xf1\xc0\xc9\x38\xc6\x83\x00\xc6\x31\x1e\x78\x19\xc7\x78\x10\xc0\x38\xc6\x03\x27\xe3\x18\x0f\x02\x18\xc7\x78\x20\x31\x9e\x32\x8c\x07\xde\x56\x95\x9c\x38\xc6\x83\xef\xd0\xe7\x67\x18\x0f\x9e\x06\xe6\x18\x0f\x02\x30\xc7\x78\x60\xc7\x1c\xe3\x41\x00\xe6\x18\x0f\xcc\x98\x63\x3c\x08\xc0\x1c\xe3\x81\x8c\x79\xc2\x78\x60\x6c\x58\xa5\x84\xf1\xc0\xde\x22\x4c\x09\xe3\xc1\x13\x60\x1c\xe3\x41\x00\xe3\x18\x0f\xbc\x8c\x63\x3c\x08\x60\x1c\xe3\x81\x93\x71\x8c\x07\x01\x8c\x63\x3c\xd0\x18\x9f\x62\x3c\x30\x36\xaf\xe4\xb8\x31\x1e\x28\xbd\x42\x39\x6e\x8c\x07\x4e\xba\x31\x1e\x58\xe9\xc6\x78\xe0\xa5\x1b\xe3\x81\x95\x6e\x8c\x07\x4e\xba\x31\x1e\x58\xe9\xc6\x78\xa0\xd1\x3d\xc3\x78\x60\x6c\x58\xc9\x71\x63\x3c\xd0\x5b\x84\x72\xe8\x18\x0f\x9c\x8c\x63\x3c\x08\x60\x1c\xe3\x81\x97\x71\x8c\x07\x01\x8c\x63\x3c\x70\x32\x8e\xf1\x20\x80\x71\x8c\x07\x12\xe3\x45\x8e\xf1\xc0\xd8\xb0\x92\xe3\xc6\x78\xa0\xb7\x08\xe5\xd0\x31\x1e\x38\x19\xc7\x78\x10\xc0\x38\xc6\x03\x2f\xe3\x18\x0f\x02\x18\xc7\x78\xe0\x64\x1c\xe3\x41\x00\xe3\x18\x0f\x34\xc6\x4b\x8c\x07\xde\x56\x95\x9c\x38\xc6\x03\xbd\x3c\x28\x87\x8e\xf1\xc0\x8c\x39\xc6\x83\x00\xcc\x31\x1e\xd8\x31\xc7\x78\x10\x80\x39\xc6\x03\x33\xe6\x18\x0f\x02\x30\xc7\x78\x20\x63\x5e\x63\x3c\x30\x36\xac\xe4\xb8\x31\x1e\xe8\x2d\x42\x39\x74\x8c\x07\x4e\xc6\x31\x1e\x04\x30\x8e\xf1\xc0\xcb\x38\xc6\x83\x00\xc6\x31\x1e\x38\x19\xc7\x78\x10\xc0\x38\xc6\x03\x89\xf1\x72\x82\xf1\xc0\xd8\xbc\x92\xe3\xc6\x78\xa0\xf4\x0a\xe5\xb8\x31\x1e\x38\xe9\xc6\x78\x60\xa5\x1b\xe3\x81\x97\x6e\x8c\x07\x56\xba\x31\x1e\x38\xe9\xc6\x78\x60\xa5\x1b\xe3\x81\x46\x77\x81\xf1\xc0\xd8\xb0\x92\xe3\xc6\x78\xa0\xb7\x08\xe5\xd0\x31\x1e\x38\x19\xc7\x78\x10\xc0\x38\xc6\x03\x2f\xe3\x18\x0f\x02\x18\xc7\x78\xe0\x64\x1c\xe3\x41\x00\xe3\x18\x0f\x34\xc6\x2b\x8c\x07\xc6\x86\x95\x1c\x37\xc6\x03\xbd\x45\x28\x87\x8e\xf1\xc0\xc9\x38\xc6\x83\x00\xc6\x31\x1e\x78\x19\xc7\x78\x10\xc0\x38\xc6\x03\x27\xe3\x18\x0f\x02\x18\xc7\x78\x20\x31\x3e\xcd\x30\x1e\x78\x5b\x55\x72\xe2\x18\x0f\xf4\xf2\xa0\x1c\x3a\xc6\x03\x33\xe6\x18\x0f\x02\x30\xc7\x78\x60\xc7\x1c\xe3\x41\x00\xe6\x18\x0f\xcc\x98\x63\x3c\x08\xc0\x1c\xe3\x81\x8c\x79\xc2\x78\x60\x6c\x58\xc9\x71\x63\x3c\xd0\x5b\x84\x72\xe8\x18\x0f\x9c\x8c\x63\x3c\x08\x60\x1c\xe3\x81\x97\x71\x8c\x07\x01\x8c\x63\x3c\x70\x32\x8e\xf1\x20\x80\x71\x8c\x07\x1a\xe3\x53\x8c\x07\xc6\xe6\x95\x1c\x37\xc6\x03\xa5\x57\x28\xc7\x8d\xf1\xc0\x49\x37\xc6\x03\x2b\xdd\x18\x0f\xbc\x74\x63\x3c\xb0\xd2\x8d\xf1\xc0\x49\x37\xc6\x03\x2b\xdd\x18\x0f\x34\xba\x67\x18\x0f\x8c\x0d\x2b\x39\x6e\x8c\x07\x7a\x8b\x50\x0e\x1d\xe3\x81\x93\x71\x8c\x07\x01\x8c\x63\x3c\xf0\x32\x8e\xf1\x20\x80\x71\x8c\x07\x4e\xc6\x31\x1e\x04\x30\x8e\xf1\x40\x62\xbc\xca\x31\x1e\x18\x1b\x56\x72\xdc\x18\x0f\xf4\x16\xa1\x1c\x3a\xc6\x03\x27\xe3\x18\x0f\x02\x18\xc7\x78\xe0\x65\x1c\xe3\x41\x00\xe3\x18\x0f\x9c\x8c\x63\x3c\x08\x60\x1c\xe3\x81\xc6\x78\x89\xf1\xc0\xdb\xaa\x92\x13\xc7\x78\xa0\x97\x07\xe5\xd0\x31\x1e\x98\x31\xc7\x78\x10\x80\x39\xc6\x03\x3b\xe6\x18\x0f\x02\x30\xc7\x78\x60\xc6\x1c\xe3\x41\x00\xe6\x18\x0f\x64\xcc\x6b\x8c\x07\xc6\x86\x95\x1c\x37\xc6\x03\xbd\x45\x28\x87\x8e\xf1\xc0\xc9\x38\xc6\x83\x00\xc6\x31\x1e\x78\x19\xc7\x78\x10\xc0\x38\xc6\x03\x27\xe3\x18\x0f\x02\x18\xc7\x78\xb0\x91\xf1\xa3\xdf\x8f\xfe\x06\x85\x98\x9a\xde\xe0\x5e\x01\x00")
+
+func dataRatesJsonBytes() ([]byte, error) {
+	return bindataRead(
+		_dataRatesJson,
+		"data/rates.json",
+	)
+}
+
+func dataRatesJson() (*asset, error) {
+	bytes, err := dataRatesJsonBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "data/rates.json", size: 89824, mode: os.FileMode(420), modTime: time.Unix(1792281865, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _dataRecommendationsJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb5\x9a\xcd\x4e\x15\x41\x10\x46\xf7\x3c\x05\x61\x8d\x37\x5d\x7f\x5d\x5d\xbe\x81\xcf\x60\x5c\x10\x25\xd1\x84\x88\x31\xec\x8c\xef\xee\xc0\x42\x2f\xb1\x3e\xa6\xc4\x9a\xbb\x60\x31\x77\x51\x87\xee\x8f\xd3\x55\xd3\xbc\xbf\xb8\xdc\x3e\x3f\x9e\x7e\x3e\x7e\xae\x3e\xdf\x3f\xdc\xde\xbd\xfb\x74\xf5\xf6\xf2\x8a\xae\xae\xff\x3c\xbf\xbb\x79\xd8\x9e\x89\x9f\x7c\x4d\x3f\x7f\x7e\xff\x75\x7b\xfe\x86\x98\x4f\x4a\xc4\x67\xdf\x7c\xbf\x79\xb8\xdd\xbe\xa2\x11\x67\x0f\xbf\x7d\xff\xf2\xf1\xe9\xa9\x8d\xa7\x87\x3f\xaf\x5f\x00\x60\x00\x60\x0a\x00\xc6\xb0\x04\x40\x52\x00\x2e\x00\x08\x00\x10\x08\xe0\x54\x5e\x81\x28\x00\x68\x0e\x10\x32\x73\x00\x09\x49\xea\x73\x5a\x7f\x16\xea\x1b\x5a\x00\x42\x11\x58\xd9\x02\x50\x0a\xa0\x05\x80\x89\x32\x28\x68\x07\x28\x8b\x80\x66\x00\x3c\x0a\x00\x9e\x02\x2c\x36\x03\x3b\xf0\x2c\x9c\xbf\x23\x68\xd9\x02\x78\xec\xd7\x5f\x79\x7d\x21\x54\x5f\x67\x6b\xfd\x00\xf5\x1d\xd5\x97\xf5\x77\x7d\x16\xce\xd6\xdf\xd6\x7e\x7d\x1a\x39\x80\x0a\x04\x68\xfd\xfd\x89\x40\xfd\x40\xf5\x99\x7b\x01\x72\x0b\x2e\x83\x09\xa4\x2c\x81\xac\xe9\x9f\xa0\x16\x00\x72\x0b\xae\x09\x23\x38\x7a\x23\x48\x0a\x00\x50\x06\x39\x56\x2f\x40\xae\xc1\xe5\x28\x83\xbc\xe2\xec\x53\x3e\x11\x58\x4e\xe4\x05\x9c\x09\x70\x42\xd3\xa2\xcf\xc0\x9a\xc3\x09\xf4\xb8\x1d\xd1\xbb\x28\xde\x2b\x4a\x02\xa6\x0c\xda\x47\x99\xf6\xf2\x76\xa5\xfa\xa2\x92\xbe\x80\x3f\xc3\xcf\xa8\x40\x37\xb1\xf9\xb1\x75\x85\x38\x57\x69\x0c\xa9\xb0\xf4\xa2\x10\x40\x89\x02\x8a\xd2\xcb\xbb\xc5\xe3\xb5\xdd\x06\xe7\xae\x0d\xb2\x02\x96\xf4\xe6\x99\x73\xed\x06\x9f\xe7\x19\xf9\x87\x7b\x0d\xcc\x0a\x58\xbc\xc0\x42\xbd\x0d\x01\xe7\x32\x0e\x91\x0a\x4b\xef\xb2\x4c\x80\x12\x05\x94\xd1\x6b\x62\xce\x4d\x1c\x7a\x1e\x5d\x30\x35\x50\xf4\x76\x0c\x9c\xab\x38\x8c\x0a\x2c\x6b\xcf\xc5\xaf\x5f\xa2\x00\x58\x5e\xc0\xf2\x5e\x17\x0b\x70\xf1\x94\x0a\x4b\x77\x47\x21\x40\xc7\x13\xa5\x97\x26\x1d\xb5\x4b\x02\x1c\xec\xa8\xdf\xa5\xe6\x89\x4b\x80\x78\x17\xea\x77\x29\x1f\xb9\x5e\xdd\x33\x08\xb0\xed\x42\xfd\x2e\x89\x1f\xb6\x1b\xc0\xb6\x21\x90\xa5\xb7\x3e\x50\x2c\xf4\x2a\xa5\xe3\xd7\xeb\x5b\x02\xc9\xbc\xba\x4e\x63\xc0\x38\x92\x1c\xb6\x19\x2b\x67\x21\x98\xcc\xe6\x49\x4c\x02\x00\xa0\x64\x8e\xe8\x3d\xfc\x75\xe4\x00\x2c\x10\xa0\x75\x01\x94\x40\x7d\x14\xc7\xd1\x3c\x70\x29\xe7\x00\x82\xe2\x38\xbc\xf7\x6c\x57\xc9\x01\x14\x65\x70\xcc\xde\x0c\xaa\x02\x00\x98\x41\x3b\xcc\x8e\x6a\x39\x8b\xc1\x38\x5a\xf7\xd1\xad\x13\x20\xc0\x44\x6a\x73\x22\x81\x20\x27\x4c\xa4\x1c\x26\x48\x05\x82\xf4\xfd\x77\x00\x83\x7b\x0f\x71\x05\xaa\x74\xdf\x47\xa1\xde\x5e\xd3\x80\x34\x57\x61\xee\x1f\x23\x8e\xda\x2c\x03\x2e\x5d\x51\xc1\xea\x3d\xe5\x0d\x68\x35\x76\x07\x7f\x3a\x45\xf4\xf6\x9f\x96\x1b\x96\x06\x15\x58\x8e\x9b\x9e\x4c\x01\xd6\xee\x3b\x80\x0d\xcb\x7b\xdb\x00\xcb\xbd\xbb\xb5\x5e\x15\x96\xde\x65\x99\x00\x25\x0a\x28\xb3\x57\xc5\x96\xab\x98\xd8\x0a\x2c\xd6\xdb\x27\x58\xae\x62\x92\xdd\x77\x00\x1b\x4b\xf3\x1d\x96\x05\x60\xf1\x02\x8b\xf4\xca\x78\xe6\x32\x26\x95\x02\x0b\x1f\x79\xad\x30\x09\x90\x45\x85\xac\x37\xc9\x33\xf7\x31\x99\x15\x58\xa8\xd7\xc7\x13\xf8\x18\xdc\x7f\x6d\x00\xe3\xb0\xeb\x84\x09\x24\x0c\xae\xc2\xe8\xb4\x9a\xaf\xc2\x26\x30\x2f\xb8\x0a\x7b\x04\xe8\xad\x0f\x74\xeb\xc8\x6b\x6b\x1d\x76\x5b\x30\x81\x6e\x97\x21\x96\xe6\x2b\xaf\x09\x1c\x1b\x28\x99\xab\x79\x16\x9b\x40\xac\x01\xe3\xb8\x33\x8b\xfd\x47\x4f\xe0\xb9\x58\x79\xc0\x64\xf6\x5e\x69\x39\x81\xfa\x30\x99\xcd\x83\x98\xe7\xce\x64\x82\x71\x94\xde\x23\xdf\x73\x51\x32\xc3\x38\x36\x5f\x53\xb9\x02\x00\x18\xc7\xe6\x49\xcb\x73\x3b\xb2\xc0\x0c\x52\xf7\x29\xee\x13\x20\xc0\x18\x8e\xc3\x5e\xe5\x7b\x2e\x48\x56\x94\x48\x6f\x1e\xa5\x3c\x17\x24\xdb\xde\xfb\x00\x7a\xfc\x17\xb3\xd6\x93\xdb\x03\xa0\xf8\x3e\x8a\x1f\xf6\x02\x6b\x01\x69\x3e\xbb\x86\x42\x54\xff\x4c\x72\xf1\xe1\xe2\x17\x07\x17\x96\x7d\x70\x2a\x00\x00")
+
+func dataRecommendationsJsonBytes() ([]byte, error) {
+	return bindataRead(
+		_dataRecommendationsJson,
+		"data/recommendations.json",
+	)
+}
+
+func dataRecommendationsJson() (*asset, error) {
+	bytes, err := dataRecommendationsJsonBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "data/recommendations.json", size: 10864, mode: os.FileMode(420), modTime: time.Unix(1792280311, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _dataUsersJson = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x9d\xbb\x0e\xa5\xb9\x72\x9d\x73\x3d\x85\x30\xb1\x02\xde\x2f\x4a\xf5\x18\x0a\x0c\x92\x45\x46\xb2\x64\x9c\x03\x41\x81\xe1\x77\xd7\xb7\xfe\x36\xe0\x88\x1b\xe6\x81\xa6\xd5\xd3\xdd\xd3\x9b\x9b\xac\x5a\xb5\x16\x59\x97\x7f\xfd\x87\x7f\xe4\x7f\xff\xfb\xfb\x51\xff\xfb\xeb\x3f\xff\xbe\xff\xf6\xef\xe3\x7f\xee\xbf\xfe\xf9\x1f\xff\xfa\x97\xff\xf8\xdb\xbf\xef\x7f\xfb\xb7\xff\xe1\xfe\xfa\xa7\xff\xf7\x07\xfe\xd7\xf8\xfb\xdf\xff\xeb\x3f\xfe\x66\xfa\x03\x2d\x59\x5f\x69\xb6\xd4\xb3\x2b\xb3\x58\x3b\xcd\xd5\x3c\xba\x73\x6e\xd7\xed\x46\xc8\x69\xee\xea\x5d\x71\x7b\xb4\xd6\xcf\xb0\xb8\x5a\x8b\x3d\xf7\xd6\x4e\x3a\xeb\xaf\xef\xaf\xfd\x3f\xff\xf4\xff\xb3\x08\x7f\x5b\x84\x05\x73\x61\x8f\x9a\x6c\x87\xc5\x67\x4c\x6f\xcd\x05\x9b\xbd\xf4\xe5\x9b\x25\xd7\x47\x2b\x25\x0e\xfe\xbd\xf6\x5a\xe7\xf4\xab\xb7\xb5\xcc\xba\xed\x14\x6b\x78\x59\x44\xb8\x2d\xa2\x97\x7c\x4a\x9f\x63\x9f\x59\x5c\x68\x65\xf1\x63\x09\x33\x39\x5b\x27\xb9\xea\xeb\x08\x21\xd4\xbd\x4f\xb6\xe9\xdc\xea\x93\x5f\xb2\xcc\x8a\x53\xca\x31\x7b\xff\xb2\x88\x78\x5b\x44\x2c\x7b\x9d\xee\x63\x5c\x27\xce\x59\x7b\x89\x96\xe3\x89\xee\xf0\x0f\x9f\x79\x62\x2d\xab\xce\xe1\x03\xeb\x18\x31\x79\xef\x53\x2e\x7c\x7a\x9b\x1c\xcf\x5e\xf9\x65\x11\xe9\xb6\x88\xe3\xc3\x88\x1c\x72\xe3\x04\xea\xa9\xb5\xd4\x55\xbc\xc5\x1e\xc2\x19\xae\xc6\x92\xcb\x66\x43\x8e\x73\xd6\x6c\xd5\x18\xeb\xce\x33\x9b\x63\x57\xf8\x23\xde\xc6\xcb\x22\xf2\x6d\x11\x23\x0f\xab\xbb\x58\x0e\x81\x9f\xf1\xe5\xf3\x71\xd9\x6c\x96\x39\xe3\xf4\xb9\xd7\xd1\x52\x5c\xab\x87\x53\xd6\xf1\x0d\x03\x66\x93\xd6\x6c\x6d\xf8\x7d\xd2\x3e\x2f\x8b\x28\xb7\x45\x84\x36\xac\x4f\xdf\x7b\xb4\x54\x8f\x9d\x34\xf8\x85\x5d\x57\xcf\xa5\x9c\x1c\xa2\x6b\xe9\x84\x9a\x1d\x5e\x52\xba\xdb\x31\xb8\x56\x7d\xaf\x69\xac\x10\xcc\x5a\x7b\x59\x44\xbd\x7a\x47\xf1\xd1\xad\x7a\x56\xad\x63\x04\xcc\x93\xbf\xde\x52\x3c\x6b\xcd\xda\xf0\x87\x3e\xd9\xfe\x9a\x31\x80\xe0\x96\x9b\x43\xc7\x82\x0b\x9f\xb4\x86\xe1\x3b\xf3\x65\x11\xed\x6a\x13\x67\xaf\x30\x5d\x6a\x6c\x49\x4f\xc7\x35\x0b\x0d\x37\xed\x71\x5a\xaf\x2e\x1c\xbe\xbc\x40\x81\x85\x2e\xec\x95\x9f\x98\xf7\x2d\xcc\xbd\x6d\x85\x98\xcb\x93\x77\xf4\xdb\x22\xd6\x8e\x03\xe0\x29\xad\x2e\x3e\x7f\xe7\x31\xca\xdc\x36\x2c\xb8\xed\x72\x67\x5f\x2a\xa8\x30\xdc\x30\x99\x0e\x7e\x31\x5d\xed\xbd\x59\x5e\x16\x42\x99\xed\x0d\xac\xae\x90\x19\x56\xaa\x6d\xcc\xbc\xcf\x49\x96\x86\xc9\x0e\x62\x4d\x3e\x39\x3f\xfa\x2a\x69\xad\x3d\xba\xf7\xab\x96\xd2\x7d\xe5\x67\x6d\x02\x95\xd3\x6d\x2c\x75\xad\xf0\xe4\xa3\xfe\x8e\x99\x7e\xc6\x8a\x17\x4e\x5b\x05\x64\x32\x5f\x76\xcf\xf3\x94\xd9\x01\xeb\x84\x75\x82\xeb\x61\xc6\x6d\xcd\x4f\x2b\xb1\x5a\xca\xe9\x9c\xd9\xe7\x74\x3d\xb5\x9d\x9e\x56\x71\x05\x4d\x7f\x5a\x4d\x65\x86\x93\x7b\xc1\x2f\x71\x44\xc3\x39\x4e\x19\xae\xcb\x37\x43\x4f\xb9\x62\x02\xab\xb0\x0f\x71\xc6\x76\x96\x8d\x01\x62\xe0\x38\xb5\x56\xf7\x64\x16\xfe\x8a\x9a\xad\x16\xdf\xdd\x1a\x31\x9f\xe9\x6b\x9a\xd1\xe7\xd3\xe2\x38\x75\x2f\x4f\x4c\xdb\x7e\xec\x94\xbc\xdb\x05\xd4\x0c\xa5\x6d\xd7\xcd\x0d\xb9\x54\x61\xdf\xe6\x7e\xf2\x10\x7f\x85\xcd\x4e\xf8\xe8\xd6\xc3\x8a\x8e\x4f\xe5\xc0\x73\xcb\x29\x02\xce\x27\xf5\x93\x37\xd8\x50\x72\x9d\x25\xe3\x3b\xc5\x9d\xde\xb3\xb5\x85\x2b\x13\xf7\x76\x99\x16\xea\xd3\x2a\xae\xb8\xe9\x6d\x5b\x0c\xb6\x09\x5c\x29\x8f\xbd\x27\x5f\xd0\x0f\x5b\xb3\xe0\xc1\x3e\x44\x4f\x04\x69\x59\x38\x8e\x03\x9f\x15\xbc\x63\x25\x71\x00\x6f\xf9\x84\xe5\x9f\xc0\xdb\x5f\x81\x93\x18\x5e\x06\x4e\xb9\x46\xa8\xdd\xf9\xb3\xdb\x9e\x61\x74\x1b\xdb\x38\x8c\xca\xee\xc3\x2c\x22\x87\xe6\xb1\xe0\xde\x47\x5d\x5b\x20\xea\x44\x3c\x2c\x9f\xfe\xb4\x8a\x2b\x72\x3a\x62\x44\x71\xa3\x1a\xf1\x32\xaf\xc4\x1e\xe7\x50\x63\x2a\x71\xe1\x04\xce\x6a\xc5\x6a\x6b\x3b\x07\x57\x8a\x43\x7c\x63\x0c\x5f\x72\x3e\x04\x3f\x78\x85\x7b\xe2\x15\xfe\x0a\x9d\xb1\xc2\x29\xaa\xd3\x19\x37\x07\x84\x15\x7e\xcc\xc1\x86\xaf\x01\xfe\x40\x24\xf7\x04\x11\x3c\xa6\xce\x46\x08\x1d\xab\xc7\xd4\x30\x56\xdf\xa2\x27\xd6\xed\x37\x1f\xe9\x77\xa2\x07\x61\x01\xbb\x27\x7e\x61\x65\x1e\xb0\x21\xa5\x16\x0f\x4e\x61\x2b\xe7\x3c\x43\x5f\x73\x83\x5e\x8a\xba\xa7\x8d\x4a\x34\xc7\x6d\x81\x92\x61\x9e\xaf\xf0\xc4\xb1\xae\xd8\xe9\xcb\x4c\x6b\x86\x6a\x01\xe2\xd0\xf9\x8e\x35\x01\x93\x99\xaf\x5a\x07\xc6\xe8\x7a\x6e\x0b\x17\x4a\x6c\x0f\x81\xbf\xb4\x9c\xd7\x09\x89\xe8\xbf\xc0\x91\x69\x4f\x9e\x1a\xae\xd8\x89\x63\xf2\x0d\x9d\x4b\x07\x1b\x1c\x6d\x35\xac\x74\x9d\xd2\x7a\x3f\x30\x8b\xb8\x71\xd0\x95\x5d\xf6\x09\xa7\xa9\xcd\xc5\x9c\x36\x7e\x0b\xc5\x1a\x8e\x18\xc8\xb7\x7b\x59\xc5\x15\x3b\xad\x3a\x63\x33\xcc\xf1\x31\x23\x12\x3e\x16\xb6\x50\x52\x18\xc9\x11\xc9\x07\x1f\xb7\xf6\x71\x50\xbc\x4a\x3c\x59\xe0\x47\x17\xf5\xa8\x21\xe5\xc0\x6f\x86\x27\xbb\x08\x57\xec\x5c\x65\xba\x71\xda\x06\x97\x7c\x0c\x84\x8a\xbc\x5b\x86\x65\x1d\xbf\x9c\x0f\x60\x06\xf6\xe2\x9b\xe7\x58\x46\x3e\x0a\xb3\x98\x2b\x27\xc3\x1f\x58\x11\xe6\x63\x4f\xab\x48\xf7\x13\x49\xcd\x01\x87\xa0\x35\x2e\x99\x0b\x70\x95\xf9\xce\xa9\xe8\x57\x63\x2f\xcb\xda\xcc\x31\x82\x18\xdb\xc5\xd9\x9d\x4e\x22\x02\x28\xb0\xc1\x99\x4f\x7d\x8a\x66\xe1\x8a\x9d\x19\x28\x86\xf3\x3b\x6f\x10\x60\x1f\xad\x06\x3c\x86\x0d\x60\x01\x10\x9d\xec\x71\xd1\x64\x19\x06\xd2\x8b\x73\xf8\xa7\x3b\xd2\x42\x60\x7d\xc2\x96\x7b\x7a\x3b\x91\x2b\x76\xe6\x0c\xb3\x6a\x9e\xd3\x87\xef\x42\xac\x08\xdd\xd8\x66\x98\x44\x0a\xe1\x08\xd4\xb7\x49\x85\xc5\xbe\x5c\x82\xd6\xe4\xe5\x0e\x7a\x21\x56\xc0\xdb\x9f\xf3\x46\x3a\xc3\x15\x3b\x77\x07\x26\x36\xfa\xaa\xc7\x52\x36\xe8\xe4\x37\x98\xe4\x77\x17\xcf\x81\x55\x72\x38\x0b\x02\x54\x03\x60\x4e\xec\x70\x48\xa3\x51\x91\x50\xf8\x56\x94\x9c\x7c\x5a\xc5\x15\x3b\x5d\x8c\x69\xf1\xf7\x4d\x18\x2f\x24\x17\x00\xcd\x2d\xf8\xe2\x16\x61\x0e\xc6\xd3\xf6\xc8\xda\x0c\x9b\x81\x05\xb8\x83\x51\x68\x6d\x67\xef\x04\x1b\xdc\xe1\x49\x05\x84\x2b\x76\x3a\xec\x30\x8f\x02\xb9\xcf\x3d\x7c\x51\xed\x18\xec\x02\x7a\x9b\xd0\x46\x56\x0b\x3c\xc7\x06\xae\x7b\xb0\x11\xdf\xf0\x8f\xc6\x79\xa1\xa1\x84\xf1\x08\xb9\x27\x69\x78\xc5\x4e\x60\xc9\x43\x23\xb2\xa2\x65\xf2\x7b\x8f\x23\x56\x01\xb9\xe0\x60\x06\x6a\xd5\x09\x40\x91\xa8\x0d\xe2\x05\xae\x82\x71\xbb\x01\x1f\xd8\xed\xcc\x9c\xd7\xdb\x2a\xfc\x3d\x9a\xd5\x6c\x99\xa8\x8a\x24\x3d\x88\xd3\x99\x32\xd6\x5a\x39\x8a\xb6\x7d\x58\xc1\x0e\x91\xd5\xce\x22\xc0\x9e\x1e\x20\x3c\x58\x27\x9b\xa0\x85\x59\xf5\x6f\x6a\x24\x5e\xb1\xb3\x78\x78\x0d\x3c\x33\x2d\x67\x0e\x9a\x05\xe7\xdc\x7c\x32\x1a\xdd\x7c\x9f\x87\x43\x99\xce\xef\xa5\xdf\x46\x1a\xfa\x64\x7d\x43\x92\x33\x2c\x14\x5d\x90\xfa\x93\x12\x88\x57\xec\x64\x8f\x01\xcd\x22\x21\x34\x57\x01\x95\xbd\x07\xa1\x0c\xce\x81\x3c\x41\x9d\xac\x58\xe2\x70\x50\x8b\x0d\x19\xef\xe7\x98\x87\x80\x0c\xb1\xf3\x05\x3d\x4f\x6f\x7b\x71\xc5\xce\x81\xda\x05\x1a\xcc\x88\x63\x89\x8f\xe1\xe3\xb3\x83\x08\x2b\x82\xe8\xce\x04\x05\xef\x08\x68\x33\xf8\xe0\xa0\x19\xc7\x22\x9a\x75\xfb\xb3\x08\xbc\x82\xdb\xa7\x55\x5c\xb1\x73\x27\xc3\x2c\x64\x04\xe6\x37\x18\x4a\xb8\x70\x19\xe6\x75\x16\xbf\x92\xf0\x4f\xc4\x52\x86\x71\x2f\x8b\x70\x00\xa4\xc2\xca\xec\xda\xec\x19\x97\x51\x08\x7a\x5a\xc5\x15\x3b\x21\x10\x28\x9f\x80\xc1\xb5\x33\x91\xcc\x81\x0d\xd0\x01\xa1\x36\x00\x4c\xe2\x1b\xd2\xed\xa4\xc3\xa1\x95\x1a\x87\x87\x17\xc2\xc5\xe2\x5a\x89\xa8\x0a\x05\x7d\x8a\x23\xf1\x8a\x9d\x9e\x13\x6f\x21\x8d\xe0\x41\x8d\x9a\xd0\x18\x1e\x29\x64\x36\x52\x42\xcb\x9f\xd5\xd1\x28\xc7\x0e\xa6\x39\x17\xa1\x1e\xfe\x65\x70\x8c\x58\x0b\x7c\x83\x60\xf3\x66\x9d\x57\xec\xcc\xb1\x78\x14\x4e\xc9\x01\xb1\xd1\xc6\x68\x0e\xb4\xf6\xde\x65\x58\x04\xf2\x2b\x80\x95\x84\xdd\x28\x59\x70\xca\x26\x8c\x8d\x34\x07\x4e\x84\x56\x4d\x21\xbe\xdd\x25\xc5\x7e\xbf\xd1\x0a\xd2\x87\xd6\x08\x5d\x76\x12\x9c\x76\x00\x0f\x71\x94\xe3\x4b\x69\xd0\xff\xcd\x4f\x1b\x56\x59\x91\x6d\x68\x84\x82\x78\x89\x73\x78\x1c\xc5\xa1\x57\xe3\xd3\x8d\xd6\x15\x3b\x61\xdb\x1d\x8b\x23\x80\xc2\x1d\x16\x08\x0e\xc5\xf2\x50\xf1\xc5\x0f\xc9\x13\x50\xc4\xbf\x09\xe6\x6d\x2d\xfe\xdf\x06\xc2\xbb\x3f\xc0\xfb\x76\x15\x09\x61\x4f\x3e\x92\xae\xd8\x09\xdf\xb4\x01\x53\xc8\xe6\x72\x44\x7f\x80\x1b\x80\xe9\xc0\x2f\x51\xe4\x68\x54\x30\x84\xa5\xcc\xd3\x91\x44\x33\x63\xca\x5d\x77\xb3\xf0\x31\x62\xea\x5e\xf3\x29\xa6\xa6\x2b\x76\x46\xb8\x9c\xf1\xbd\x02\xa4\x26\xaf\x88\x32\x03\x40\x06\xb1\x15\x1a\x81\xcd\xa4\xb2\x90\x64\x25\x9d\xe1\x75\xcf\x19\x37\xbb\xc6\xaf\x11\x66\xd2\x8e\x04\x9f\x27\xd4\x4a\x57\xec\x44\x02\xdb\x72\x7b\x3a\xb8\x75\x74\xbd\x61\x78\x59\x1c\x1b\xd8\xc6\x2a\xe4\x8f\xb3\xba\xd0\x73\x98\xad\xa3\xd8\x0f\xe1\x8d\xf0\x1b\x16\x40\xdb\xdb\x6c\x6f\x37\x9d\x57\xec\xac\xd1\xf2\x4e\xa8\x1d\x1b\xa0\x22\x67\x03\xc7\xaf\x23\x46\x07\x9a\x4d\xc4\xcf\x3c\x63\x9d\xc6\x5a\x89\xf9\xad\xba\xe8\x36\x52\x39\x07\xa2\xcd\x2a\xb6\xd7\x93\x12\x48\xf9\xc7\x2d\x0a\x46\xc6\x86\x47\x16\x02\xf3\x3e\x6c\x07\x1b\xcf\x26\x8c\xe4\x8b\x40\x8c\xf8\x82\x45\xc4\x03\x2d\x66\xa7\x5c\xcd\xad\x2f\xb8\x88\x0f\xbd\xee\xf8\xc4\x3b\xd3\x15\x3b\x65\x15\x3e\xe3\xa2\xba\xe1\x35\xbe\xa5\x59\x2b\xe6\xc2\x32\xe8\xcd\xfa\x6e\x39\x09\xf5\x04\x9a\xc1\x46\xc5\xde\x5d\xf0\xa9\x62\x1e\x6d\xd6\xe0\x66\x7f\x52\xcb\xe9\x8e\x9d\x25\x44\x33\x02\x38\xe4\xa2\x34\x30\xb9\x94\x8e\x04\xcc\x96\x76\x0d\x79\x9f\x55\x47\xaa\xb9\xfa\x24\xd5\x0c\xa4\xd6\x5e\xd0\xea\xd6\x59\x5f\x49\x08\xa5\xa7\x55\xb4\xfb\x1d\x1f\x1e\x7a\x24\xba\xaa\x34\x72\x4a\x32\xbe\x3a\xbe\xd7\x88\x86\x62\x9f\xbb\x56\x81\x25\x81\x7d\x04\x60\x04\xba\x97\x0d\x1e\x64\x9c\x5f\x9b\x8f\xab\xb8\x63\x27\x1b\x4b\xf8\x00\x2a\x82\xe5\x6e\x84\x95\x3e\xf2\x3c\x48\x1e\x68\x39\xb1\x82\xd8\x85\x41\x56\x4e\xad\xf7\x8e\x22\xdc\x30\x1f\x74\x4a\x6d\x18\x74\x58\xf9\x29\x8e\xe4\x2b\x76\x0e\x00\xa2\x47\xc3\xf0\x71\xd3\x85\x08\x2b\xe5\x18\xc1\xad\xe3\x10\xba\x6b\x9e\x11\x9e\x07\x25\x96\x65\x76\x78\x26\x30\xbb\x22\x2b\x84\x0a\xcf\xe5\xc6\x93\x5d\xe4\x2b\x76\x76\x41\xa5\xd7\x35\x67\x9b\x78\x43\xd2\x83\x11\x28\xc9\x19\x80\x99\xd3\x75\x0f\x52\x1a\xeb\xa9\xde\x8f\x98\x3a\x54\xf4\xc0\x88\x08\x24\x25\x4a\xd1\x3f\xf9\x48\xbe\xf3\x4e\xe9\xe2\x96\x12\x44\x02\x6d\xb8\x06\x86\x88\x22\xd1\x3d\x12\x30\x5a\xc1\x8f\x13\x13\x7c\xc2\xa1\x9e\x3a\x96\x10\xc6\xce\xae\xd6\xde\xa1\x3f\x87\x5d\x7b\xd2\x23\xf9\x8a\x9d\xa0\xd5\x4c\x85\x73\xf1\x2b\x84\xb1\x20\x7a\x79\xf5\x06\x2c\xb9\x12\x75\x69\xe1\x32\x50\xda\x6b\x44\xb9\xe2\xc9\x88\xda\x36\xc3\x2c\xf5\xe8\xcd\x64\x8c\xf0\xa4\x10\xf3\x1d\x3b\xb1\xf8\x0e\x9b\xcb\x28\x43\x38\x38\x34\xd4\x2f\x74\x68\x09\xd3\x3b\x18\x4e\xf4\xab\xb6\x82\x3f\x6c\x87\xbd\xa2\x03\x52\x46\x47\x01\x62\x15\xfd\x8a\x98\x7a\x8a\xec\xf9\xfe\x4e\x34\x86\x6e\xc0\x73\xc0\x21\xe6\x9e\x2b\x60\x1e\x70\xef\xa2\x5b\xa5\xd4\xe1\xc2\xb3\x35\x10\xf5\xf0\xf9\xe8\x34\x42\x1f\xbf\x73\xd2\x06\xd2\x4e\x15\x35\x7a\x5a\xc5\xfd\xa1\x28\x02\x95\xec\xb5\xaf\xb1\x40\xbd\x8b\x6e\xd7\xfa\x86\x4f\x20\x96\x09\xe9\x79\x20\x88\x60\xbe\x19\x91\xcc\x4f\xbd\xf5\x8e\x6a\x3d\xad\x73\x40\x79\xd5\xf9\x76\x22\x57\xec\xd4\x3d\xc5\x5e\x01\x68\xec\xc7\xb5\x85\x64\x5f\x7a\x1f\xd8\xe8\x3f\x8c\xd4\x30\x5a\x28\x77\x12\xcb\xf3\xb8\x0e\x78\x81\xc5\x10\x6a\x40\x2d\x07\xf3\xca\x6f\x27\x72\xc5\xce\xb4\x82\xb6\xde\xb2\x5f\x2e\x11\x4d\x67\x9d\x79\xc4\x35\x61\xb8\x69\x44\x78\xb8\x03\x5c\x0f\x52\x69\xef\xdd\xe2\x0c\x2b\xc5\x86\xbb\x0c\xb7\x59\x28\x7b\xf5\xb4\x8a\x2b\x76\x02\x10\x20\x76\x2e\x7d\xa4\x61\xdb\xf6\x1c\x03\xb1\x8e\x0a\x25\xc6\x67\xbc\x94\xf0\x0a\xa9\x68\xa3\x9f\xb8\x7c\x24\xde\x22\x11\x92\x5f\x1c\x12\x7b\x43\x34\x79\x7a\x3f\x74\x77\x9d\x8a\xe6\x80\xe6\xf6\x5d\xbb\x89\xf8\xad\x8d\x32\x5d\x48\x8f\xda\xa1\x97\x95\x88\x8b\xef\x4c\xc0\x55\xf7\xc1\xc5\x1b\x4a\x39\x3a\x80\x8e\x3f\x32\xa0\x46\x4f\xab\xb8\x62\xa7\xae\x00\x58\x88\x25\xdd\xad\x8f\xb5\x08\x9d\xa3\x75\x3f\xac\x00\xa5\xed\x0c\x42\x86\xe4\xdb\x86\x0e\x1f\xd8\x7f\xce\x23\x60\xa4\x43\x7e\x8c\x61\xbf\xdd\x77\x96\x70\xbf\x45\x69\x35\x10\x2d\x09\x56\x30\x89\x8a\xf1\x05\x87\x83\xc8\x27\x13\x86\x39\xb2\x3f\x7b\x23\xdd\x32\x28\x75\x70\x8e\x7d\xf8\xdd\x41\xb4\x43\x9e\x18\x18\xfe\xb4\x8a\x2b\x76\x1e\xe8\x4c\x95\x12\xda\x23\x58\xd3\x9d\x55\xc1\x61\xc6\x0a\xc4\xf6\x90\xe6\x62\x89\xdd\xef\x01\x9f\x41\xb1\x24\x58\x40\x43\x26\xc0\x08\xd1\x95\xab\x3c\xf2\xce\x72\xc5\x4e\xe4\x10\x00\x91\x4b\x25\x4a\xf9\xdd\xd1\xbf\x0d\x12\x78\x8a\xef\x6b\x8c\x2c\x0e\x8a\x83\xea\xd9\xa4\x13\x70\x47\x5d\x79\xcb\x72\xac\x0e\x76\xec\x55\xb3\x97\x2b\x76\x0a\x97\xf2\x2c\x25\xf9\xd0\x60\x35\x84\x8a\x13\x85\x1b\x65\x40\xaf\x50\xcc\x59\xb0\xd9\x7c\xc4\x76\x82\xef\x06\xe3\xb3\xde\x88\x65\x01\x0f\x77\xfb\xd1\x47\xae\xd8\xb9\x17\x3c\x8b\xf8\x91\xf5\x7f\x55\x37\x9c\x08\x80\x98\x66\xc8\xb8\x6a\x80\x9d\x2f\x62\x3f\x26\xa1\xcb\x15\x88\xc8\x9c\xad\x94\x55\xbc\x2b\x5e\xe0\xf2\x96\x82\x52\xae\xd8\x99\x43\x19\x90\xce\xbe\x1c\x84\xe6\x0c\xa7\x14\x0b\xc8\xdd\x84\x80\x49\x97\x78\x40\x6c\xc1\xf9\x42\x62\x29\x48\x03\x94\x8b\xb1\xea\x14\x67\xb3\xb8\x4f\x7a\x7a\xbd\x2b\xed\xce\x72\xca\x98\x23\xa4\x43\x1c\x25\x62\xaf\x5e\x7b\x76\x92\x68\x40\x69\xaf\x03\xdd\x9a\xf9\xcd\x06\x46\x54\x58\x06\xc1\x14\xeb\xd1\xdb\x89\xed\x61\x88\x94\x27\x96\x53\xee\xbc\x33\x6f\x20\x91\x48\x6e\xb1\xc9\xf8\x10\x27\xba\x4c\x1b\x09\x19\x3f\x0d\x9e\x37\x40\x2a\xd7\xa5\x14\x2a\x12\x6d\xb2\x8a\x7a\x42\xd1\x95\x78\xb1\x36\x9e\x4e\xa4\xde\x35\x7b\x94\x1f\xa0\x95\x89\x50\x1e\x2e\xe7\x8b\xd7\x85\xc1\x38\x01\x8e\xa5\x37\x54\x4c\xf4\x9c\x01\x0d\x2d\x15\x69\xca\x72\x88\xaa\xab\x15\xd6\x9d\x42\x8e\x4f\xd1\xac\xde\xef\x3b\x1b\x27\x82\x12\x71\xe1\x10\xac\xf9\x10\xf8\x06\x31\x03\x56\x4e\x94\x8b\xec\x46\x38\x88\x30\x10\xc4\xd2\xb2\xdd\x4e\x0f\xd0\x0a\xdf\xc3\x36\x96\x59\xed\x09\xb5\xea\x9d\x77\xd6\xa2\x7b\xb3\x85\x28\xf1\x71\x29\x1b\x0a\x17\x4c\xc7\x21\x0f\x37\x7c\x03\x9d\x5a\x88\x6a\xa2\x60\xec\x41\xd6\xd5\x7c\x6a\x90\x41\xce\x07\x67\xae\xed\xe9\x95\xa6\xde\xdf\xd9\xe7\x39\x81\xf0\x91\x3b\x91\x01\x96\x1d\x09\x6b\x36\x7a\xb0\xb2\x10\x83\x0e\x21\x74\x76\x84\x6d\xed\xed\x02\x14\xeb\x20\x55\x62\x1f\x04\x35\xf0\x16\x11\xf7\x66\x17\x57\xec\x8c\x73\x6c\x8c\x20\xcf\xba\x96\x2c\xb3\x76\xa2\xa7\xfd\x79\xdd\x27\x70\x44\x44\x0a\xa4\xbb\xc4\x3d\x39\x9e\xd8\x92\x9f\x25\x9e\x9a\xf9\x2f\xf2\xca\x39\x3d\xdd\x34\xd6\x2b\x76\x86\xc9\x37\x6f\x20\x93\xde\xb9\xa1\x9f\x7c\x73\xb8\x6e\xd8\x27\xa3\x11\x3a\xda\x2c\x22\xd8\x4e\xcb\x18\x4e\x60\x4d\xe0\x86\xb2\x2e\x74\xef\xe3\x89\x65\xf1\x49\x15\xd5\x2b\x76\xca\xf5\x61\x2b\x48\x63\x8e\xff\x6c\x87\x18\xf6\x49\xa4\x66\xcf\x20\xfd\x3c\x37\xbc\x6f\x78\x42\x57\xf5\x2d\xe3\xba\xc9\x67\x8c\xd2\xf7\xd1\x32\x1a\xe5\xed\x44\xea\x9d\x5f\x20\xd0\x71\xc0\x00\x0e\x95\xc0\x6e\x07\x87\x48\x1c\x8d\x88\x61\x2e\x2d\x14\x18\x9a\x14\x3f\xee\x0e\xcf\xf1\x69\xf1\xff\x3c\x02\x05\xc7\x06\x49\xf3\x79\x8a\x66\xf5\x7e\xdf\x99\x27\x61\xca\x29\x1d\x8f\xf0\x41\x9c\xc7\x03\x50\xcd\x15\x4d\x58\xea\x64\x15\xc7\x37\xdd\xb0\x96\x58\x37\x3c\x08\x6a\x88\x40\x03\x2a\x06\x22\xa5\x20\x49\x9e\x56\x71\xc7\xce\x63\xae\xc0\x25\x9b\x7e\x48\x35\x3a\xbd\xd3\x34\xdd\x58\x21\x92\xe7\x1c\xed\x04\x22\xab\x1e\xb9\x95\x3e\xb9\x20\x39\x98\x8e\x1f\x2d\x64\xab\x73\xbe\xdd\x28\xb5\x2b\x76\xea\xed\x45\xbb\x7f\x50\x42\xc7\x9d\x36\xf3\x81\xfc\x21\xe0\x3d\xb0\x61\xe6\x7d\xd2\x3d\x73\x8a\x47\xa9\x32\xfc\x01\x83\x78\xc2\x88\x4e\xad\xd5\x07\x1c\xe6\x69\x15\x57\xec\xdc\x65\x14\x73\x73\x0d\x54\x32\xee\x48\x64\x27\x58\x2d\xa7\x7b\xae\xe8\x0b\x56\xeb\x56\x38\x9e\x45\x22\xe5\x77\x22\xac\xfa\x01\xd8\x06\xe7\xfc\x8c\xcd\xd5\x27\xd4\x6a\x57\xec\x3c\xc5\x15\xb8\xdc\xce\x09\xba\x90\x03\x42\x0c\x14\xd8\xba\xbd\x20\xe0\x83\xaa\x96\x2d\x2e\xe4\x72\xf6\xad\xc5\x9a\x30\x10\xc0\x24\x2c\x70\x0d\x81\x84\x3b\x3d\xad\xe2\x8a\x9d\xa3\x20\x97\x37\xdb\xbb\x12\x60\x00\xa3\x08\xcb\xc6\xee\x40\xc4\xf4\x5e\x69\x94\x25\x72\x4a\xf0\x2e\x84\x13\xd0\x91\x80\xd8\xd6\x75\xb3\x44\xfc\x5f\x27\x3f\x29\xc4\x76\x4f\xed\x44\x89\x09\x1c\xe1\xbf\x72\x96\x81\x9b\x06\x22\x39\x51\x5d\x37\x7f\x88\xd4\xa9\x67\x3d\xb7\x11\xa7\x6e\x95\x33\x76\x0b\x11\x59\x3f\x93\x1d\x78\xd1\x63\x2e\x61\xbe\xe7\x6b\x49\x08\xa0\x8b\xe0\x76\x7e\x0d\x65\xb6\x2a\x4a\x20\x07\x7b\x18\xa8\x61\x9f\xf3\x5e\xa5\x6c\x69\xf7\x18\x71\x9e\x81\xff\x24\x5d\xd2\xae\x76\xd2\x7a\x3b\x91\xfb\x3b\xfb\x28\xe0\xf4\x11\x6d\x40\x03\xf9\x55\xb0\x46\x34\xe9\x82\xe6\x27\x2b\x90\xf3\xa6\x73\x30\x17\xcf\xae\x33\xea\x61\xb5\xe1\xcf\x2b\xf4\xf8\x65\x1e\x3c\xb1\xdf\x76\xc5\xce\x3a\x3b\x02\xb4\x00\x86\x30\x9b\x69\x95\x8f\x46\x2e\x87\xb2\x22\x1f\x0a\x80\x13\xed\xc7\xe9\x9c\x03\xfc\xcb\x2b\xc1\x15\x2e\x9a\x57\xe2\xc4\x9a\x87\xf9\x3c\xdd\x5f\xb4\x2b\x76\x8e\x89\x27\xb4\x96\x01\x22\x8c\xb0\xd7\xa9\x5b\x56\x6c\x91\x4d\x20\xa2\x65\xdd\x78\x2b\xbf\xb8\x06\xf1\xe2\x3c\x94\x8a\x0a\x15\x5e\x07\xec\x0c\x75\xbc\x29\xc4\x76\xc5\xce\xb9\x77\x8a\x30\x29\x0c\x21\xd5\x13\x51\x18\x6d\x10\x2b\x21\xa1\x51\x6f\x25\x41\xc9\x74\x95\xe3\x5a\xa8\xf5\x15\x5a\xdf\x8b\xbd\x63\x8b\xe4\x3c\x03\xa8\x7f\x4a\x32\xbd\x62\x27\x7f\x6d\x49\x87\xf8\x71\x40\xe7\xa3\x8c\x4e\x0f\xaf\x71\x70\x9a\x4f\x11\x1e\x31\xff\x35\x47\x72\xee\xcc\x92\xe0\xc8\x6c\x57\x50\xc6\xc8\x42\x37\x1d\xff\xe4\x23\xfd\xce\x3b\x37\xfa\x0f\x0f\x4d\x07\x76\x8b\x05\x42\xab\x62\x26\xa2\xec\x1c\x25\x96\x4e\x50\x4e\x88\x5c\x16\xe0\xc6\x85\xfc\x74\x00\x6b\x51\x58\xf7\x43\x39\xba\x4f\xab\xb8\x62\xe7\x3c\x70\x28\x4e\xb9\xe9\xd2\xa4\x1f\xeb\x73\xac\x92\x4f\x44\x42\xaf\x0e\xc9\x84\xfa\x79\x0f\x0d\x03\x4d\x3b\x30\xba\xad\x2e\x5f\xf9\xd3\x0b\x85\xc2\x8e\x3c\xf9\x48\xbf\xdf\x77\x46\x82\x44\xe1\xdc\x37\x44\x62\x17\x77\x96\xae\x97\x38\x77\x18\xee\x2a\x86\x4c\x43\x84\x39\x54\x11\x3c\x38\xec\x68\x4b\xe4\xc6\xad\x98\x9a\xf9\xc8\x7f\xf7\xb4\x8a\x2b\x76\xa6\xa4\xb4\x28\x87\x5d\xc6\x9d\x26\x91\xc4\xa3\x7a\x50\x47\x83\x78\x0e\xcf\x75\x3e\x07\x65\x45\x20\x98\x31\x85\x5c\x95\x8b\x6b\x68\x84\x55\x3b\xba\xa8\xda\x93\x42\xec\x77\xcd\x5e\x1c\x7c\xd7\x13\x33\xc1\x82\x98\x5c\x8b\xba\x7f\x56\x02\x7a\x2e\xa8\x40\x02\x46\x67\x23\x4a\x1a\xca\x60\x5a\xf3\x5b\x5f\x47\x19\x0e\xbd\x62\x20\x57\x9e\x56\x71\xc5\xce\x14\x94\x7b\x1e\x82\x01\x06\x8d\x6f\x0f\xd7\xd2\xa3\x3e\xc1\x13\xa2\x3b\x3b\x71\xcc\x40\xee\xde\x8b\x63\xcf\x0a\xfb\x52\x03\xec\xb7\x06\x1f\x27\xeb\xaf\x6f\x3e\x72\xcf\x8c\x3f\x47\x39\x95\xc8\x32\xa5\xd9\x6c\x7f\x94\x84\xef\x5c\x8c\x2e\x97\x8a\x79\x5a\x8d\x60\xd5\x42\x83\xcc\xf4\x65\x53\xa1\x0b\x57\x0a\xa7\xa3\xcf\xdc\x5e\x4f\x31\xb5\xff\x78\x67\x47\x08\x3a\x8f\x6f\x40\xff\x71\xd8\x3c\x06\x96\x82\xbf\x7a\xbd\x65\x02\xa4\x09\x15\x30\x7a\x57\xba\x5c\x75\x10\xa0\x82\xa0\x5d\x70\x0c\x96\x8f\x4c\x7c\x5b\xc5\x15\x3b\x3d\x27\x3c\xf7\x42\x07\x02\x53\x5e\x59\x96\xd5\x12\xff\x73\x28\x24\x68\x25\x36\xb3\x56\x05\xca\x1d\x91\x64\x73\x08\x7b\x7b\x3d\x20\xd6\x75\xe6\x4c\x7d\xb8\xb7\xdc\x78\x77\xaf\x13\x38\x88\xe4\xc5\x0a\x42\xc1\xf6\xf4\x71\x73\x03\xd2\x03\x5a\x69\xf0\xee\xe4\xea\x82\x89\x9a\x6d\xc2\x68\x2e\x42\x50\xec\x05\xbe\x81\x7d\x6e\xf7\x96\xe0\xc9\xfe\xde\x6f\xc2\x61\x0d\x49\x61\xa1\x5b\x4c\x2b\x7b\x25\x58\x43\xbf\x30\x3f\x50\x3d\x6e\x94\xb3\xc1\x87\x81\x14\xbd\x1f\x9a\xdf\xae\xb0\x29\xbd\x28\x95\x08\x80\x7f\xcb\x82\x76\x3f\xae\x3c\x7b\x07\x95\xb2\xc1\xa0\xf6\x08\xab\x12\xcc\x51\x8b\x5b\xf5\x23\x84\xd7\x09\xc1\x0a\x5d\xc1\x03\x6a\x81\xbf\x8c\x0e\x80\x88\x7c\x1c\xec\x04\x22\xfe\x96\x80\xec\x7e\xe8\xf6\x89\xe1\x13\xd6\xc7\x77\xf3\xdc\x36\x1c\x10\x8b\xf0\x44\x53\x68\x31\xbc\x78\x1d\xcc\x34\x47\x41\x8b\x9e\x23\xbc\xb0\x62\xf0\x8b\x1d\x9e\xb3\xf6\xa3\x6d\x5c\x01\x74\xba\x52\x88\x11\x10\xef\xa3\xdb\x4e\x60\xbb\x1b\x08\x81\x44\xf4\x9d\x78\xd6\x60\x61\xc3\x8d\x3e\x76\x2d\x3e\xa9\xb2\xc1\x82\xad\x73\x7c\xca\x48\x79\x42\xdd\xdb\x32\xae\x08\x5a\xd6\xb0\x38\x57\x2b\x6e\x0c\x5c\x63\x4f\x68\x8c\x04\xa0\x4a\x5c\xe2\x52\xb2\x01\xa4\xf7\x28\x37\xa9\xa1\x9b\x37\x82\xad\x67\xa2\x5d\xda\x40\xbf\x11\x73\xde\x96\x51\xee\x87\x92\x06\x82\xc8\xf1\x39\x67\x0f\x64\x72\xd3\xeb\xb2\x34\xc1\xf0\xcd\xeb\x76\x9e\x93\x82\xf0\x26\x30\xb3\x0f\x55\xff\xd4\x84\x7a\xc9\x79\x29\xf1\xd6\xde\xd2\xc2\x5d\xbd\x17\x7b\x95\x80\x2e\x26\x8c\x67\x01\x81\xde\xf5\x6b\x8a\x2d\xc2\x64\x0a\x5b\xe4\x65\xa4\x3e\x21\xde\xac\x76\x56\x36\xf5\x1c\x5d\x74\xc7\xe0\x51\x47\x84\xc2\xb7\x65\xdc\x2f\x3e\x81\x0d\x48\x9f\x9b\x19\xf8\xd0\x73\xfb\xf4\xbd\xd8\x52\x32\x1d\xdf\x1a\xc7\x44\x37\x76\x42\x89\x23\xf2\xd6\xf3\xdd\x31\x25\xeb\x36\xc0\xb5\x82\xa8\x7a\x5c\xc6\x3d\xd3\x93\xff\x2d\x87\xd6\x00\x97\x22\x60\xc5\xe7\x8c\xfc\xe5\x2f\x46\xe5\x69\x47\xd5\x1c\x56\x11\x64\x82\x2a\x5a\x24\xe4\xad\x22\x8b\xf4\x65\x85\xb0\x70\x7b\xab\xed\xb9\x3f\x1b\x81\xd6\x7e\xa8\xa0\x4c\xf9\x9d\x25\xaa\x84\x47\xa9\x2f\x6e\x76\xbe\x33\x0a\x60\xe7\x2a\xd1\x80\xbc\xe6\x0c\x8a\x25\x36\x6a\x6d\x8e\x31\xa4\x29\x05\xf1\xb6\x8c\x2b\x8a\xc2\x1d\x26\x7f\x7f\x83\xfa\xbb\x00\xf5\x19\x84\x7d\x50\x7b\xed\x21\xb6\x29\x32\x36\x9c\xe0\x7e\x7a\xbd\x84\x9f\x36\x4c\xcf\xd0\x46\xa8\x43\xc0\xa3\x51\xde\x96\x71\x47\x51\x62\xe5\xcc\xcb\xba\xd3\xfb\x66\x0c\xce\x8d\x46\x28\x81\x7e\x34\x22\xba\x4a\x13\xf9\xe2\x65\xf1\xf9\x2d\x6c\xb1\xd2\xc9\x9f\x6f\x40\x4c\x37\x34\xdd\x79\xb3\x8d\x7b\x95\x11\xb1\x12\xd4\xd4\x1d\x74\xe6\x73\xe2\x77\x73\x84\x26\x75\x47\xb9\x95\xa8\xb3\x20\x3d\xab\x3a\x9f\x3c\x55\x81\xf5\x47\x3e\x67\xaf\x94\x01\x22\xcd\x7a\x03\xf3\x7b\x99\x11\x91\x6a\x85\x90\x4b\x06\x1a\xbe\x2c\xf1\xd8\xa1\x61\xce\x79\x5c\xd8\x17\x14\x51\x56\x79\x45\x6c\x01\x43\x50\x59\x41\xec\x05\xa0\x87\xba\xc3\x9a\xed\x31\x31\xdb\xdf\xeb\x8c\x9a\x8a\x42\x20\xa0\x47\x0f\x77\xaa\xbb\x2a\x50\xd2\xd2\x3d\x01\x55\x55\x3c\xe6\x0d\xd8\xe8\x19\x01\x85\x21\x73\x16\x25\xb2\xe2\x0d\xd8\x42\xda\x31\xa5\x47\xdb\xb8\xa2\x68\xc4\xfc\x5d\x6f\x25\x86\x18\xb2\x0b\x69\xd4\xbd\x4d\xa5\x68\x28\x17\xbe\xf0\x1a\x61\xd7\x9a\xf7\x21\xb0\xb7\x35\x5a\x47\x43\x56\x6f\x6d\x43\xd6\x92\xbc\xf7\x6d\x19\xf7\x97\xf7\x9a\xf5\x4c\x02\xcf\x1c\x80\x81\xcb\x06\xe5\xf5\x19\xb7\x6c\x03\x1c\x47\x1c\x1e\x98\xba\xe7\x6c\x24\xa8\x88\x22\x78\xc7\x22\x20\xe7\xbe\x1c\xe2\xed\xb5\x26\xf0\x5e\x6a\x04\x6c\x2e\xa5\x7e\x83\xe2\x2d\x71\x1a\xa8\x92\x76\x54\xed\xb4\x10\x03\x4b\xb6\xb8\xf8\xf2\xad\xf8\x15\x86\xc3\x50\xcf\xa9\x70\xe1\x9a\x51\x58\xf1\xd9\x36\xee\x75\x9a\x5e\x01\xaa\x4a\x0e\x06\x3d\x50\x9d\xb6\xa2\xc2\x88\xd4\x93\x6f\x01\x10\x4f\xf8\xd9\x89\xca\xa4\x6f\x2a\x2a\x46\x97\x40\x89\x88\x2c\xc1\x85\xb3\xdf\x76\xe3\x5e\x6c\x34\xcf\x86\x6c\xa7\xc4\x5f\x3a\x07\x66\xd7\xdd\xc4\x4c\x92\x9b\x6d\xa8\x16\x92\x7f\x26\x62\xfa\x8c\xac\x9a\x6b\x08\x7a\x6e\x20\x6c\x65\x7f\xfc\x9e\xfc\xf3\x46\x7b\x7e\x54\x1b\x05\xd7\xcf\x29\x4e\x95\xab\xd9\xc1\xf5\xaa\x9f\x23\x17\x0f\x94\x59\xc5\x72\x3d\xf8\x8d\x53\x43\x36\xe6\x49\x69\xd7\x3d\xec\xc0\x7e\x54\xdb\xcb\x31\xf6\x37\xf8\xba\x97\x1b\x2d\x45\x2b\x84\xca\x9e\x3d\x40\xfc\x75\xdd\x54\x41\x4b\x5d\xcf\xb7\x2e\x3b\xf0\x6d\xba\x04\xd1\x91\x71\x1c\x34\xdc\x12\x7c\x15\x8c\x35\x78\x28\xfc\x9b\x6d\xdc\xeb\x8d\x4e\x8e\x04\xf9\x1e\xf2\xe4\xcb\xeb\xe2\x71\x78\x22\x4c\x38\x38\x50\x09\xd0\x2f\xcf\x4a\x12\x94\x78\x09\x4f\x27\x4a\x29\xf1\x9d\x22\x22\x1f\x36\x84\xe8\x7c\xab\xe1\xbd\x17\x1c\xc1\xf9\x9c\xae\x81\x33\xec\x1d\x97\x0d\xe8\x31\xf7\xd9\xac\xdb\x07\x4e\x18\x08\x2c\xc4\x3e\x9b\x06\xcf\xa8\x90\x43\xd7\xe1\x6a\x0d\x06\x86\x64\x2b\xc7\x1e\x4d\xf4\x8a\xa2\x67\x43\x25\x30\xd3\x8c\x96\x47\x2b\x8f\x9a\xf5\xc2\x16\xbc\xdb\xd8\xe2\x09\xc8\x57\x28\xd1\xee\x8e\x58\x52\xed\xc0\x76\x64\xaa\x5d\x17\x95\x7d\x95\xf2\x56\x59\xe2\xef\x25\x47\xd0\xca\x24\x8e\x59\xb6\xeb\xe0\x65\x74\xea\xf0\x90\x95\xee\x80\x51\xac\xe9\x9d\xb8\xb0\x23\xb8\xba\xd8\x23\xe0\x69\xcd\x13\x5e\x72\xdc\xa3\x19\x80\xf2\xb8\x1b\xf7\xbb\x50\x1c\xb2\xa6\xc4\x37\x97\x54\xc8\xd8\x03\x44\x34\xd6\x68\x07\x85\xb0\xb6\x4c\x07\xee\xc1\x02\x16\x21\x10\x8f\x8a\x4d\x97\xd6\x91\xc5\xc7\x35\x10\xe0\x6f\xcb\xb8\xa3\x68\xf4\x43\x49\x0f\xb0\x1d\xce\x62\xd4\x90\x5c\x82\xec\x40\x3b\x1c\xae\xd0\x77\x09\x75\x05\xab\x71\xc4\x12\x5c\x1c\x0d\x71\x05\x39\x03\x4d\x5c\x37\x3b\x6f\x29\x65\xfe\x47\xd5\x91\x0a\x31\xdd\x00\x2f\xa2\x2e\x5f\x6b\x8e\x0d\x22\x0e\xa4\xa9\xec\xc9\xd0\x4f\x96\xec\x28\xf3\x15\xf7\x0d\x6c\x4b\xca\x7e\xab\x83\x85\x4b\xec\xc5\xa8\x6f\xb8\x71\x2f\x3b\xd2\x45\x4a\x25\x72\x7c\x37\x7d\xce\x2f\xdd\x9d\x04\x56\x15\x3b\xe4\x0f\x27\x9d\xd8\xcd\xaa\x1c\x4c\xc0\x89\x74\x5d\xde\x3c\xda\xb6\x44\x87\x1b\x7b\xcb\x6f\x65\xcd\xf7\xba\xa3\x0e\x38\xee\x1d\x88\xeb\xae\x44\x95\x96\x17\xd5\x59\x4d\x0e\x22\xe8\x92\x3a\x45\x87\xb8\x05\xda\x06\x7b\x60\xaa\x8c\x1f\x90\x2e\xdd\xb4\xe8\x7e\x2c\xa5\xc7\x4a\xf3\x70\xbf\x8a\x54\x5d\xec\x82\x63\x81\x0a\x25\x6c\x54\x5b\x5a\x5f\xa9\x26\xfb\xd0\x96\x05\x2f\x51\x94\xd1\xb1\xde\xf6\xea\x6e\x03\xed\xd8\x8a\x52\x87\x1d\x34\xf0\x4d\x20\xdc\x2b\x8f\x70\xa2\xb2\x41\x89\x8c\x60\xf4\xb3\xba\xe6\xda\xe0\x33\xd2\xd6\xb3\xb7\xa4\x7c\x1e\x01\x71\x74\xcc\xb3\x55\x25\xa1\x67\x89\x70\x13\xd5\xb2\xd8\xc0\xdd\x1f\x77\x23\xdd\x53\x77\x08\x6e\x51\xfe\x91\x94\x95\x4d\x2c\x26\x64\x04\xc0\x33\xee\xad\x27\x9c\x5e\x39\x2d\x70\x27\x35\xe8\x46\x46\xba\xed\x7c\x74\xf3\x15\x2c\x37\xdd\x2c\xbf\x2d\xe3\x7e\x27\xba\x9b\xe8\xa8\x67\xaf\xb3\xeb\x6a\x0d\x93\xdb\xd2\xb5\x5c\x1d\x3e\x4c\x42\x58\x02\x31\x10\x72\x00\x37\x7c\x11\x06\x8f\xef\x20\xba\x55\xb7\x69\xf0\x92\x37\x14\xbd\x17\x1f\x75\x7d\x71\x3e\xd5\x99\xb9\x79\xea\x0c\xae\x22\x17\x86\x1e\xa2\xd5\x99\x40\xff\xca\x47\x97\x2c\x5f\x0d\x53\x65\xee\x3b\x75\x70\x3f\x96\xba\xcf\x7e\x7b\x06\xf7\xf7\xea\xa3\xa3\x74\x5c\xe5\x01\xaf\xd0\x67\xca\x7a\xf9\xf7\xe3\x38\x07\xa2\xf1\xaf\x5e\x2f\xdf\xca\x14\x38\x31\x47\x9f\xb3\xe5\xed\x4e\x70\x93\x05\xe1\x3f\xf1\xb1\xba\xd9\xdf\xcb\x8f\x06\xf1\x6c\x2a\x09\x7c\x65\x60\x63\xb4\x32\x4a\x51\xa2\xb8\xaf\x23\x35\xe7\x4f\x8c\x4e\xf7\x81\xd5\x36\x58\xd5\xe1\x5d\x65\x29\x97\x7b\x1e\x49\xb7\x65\x8f\xf0\x75\xbf\x17\x35\x98\x14\xa2\x54\x4f\x9d\x13\x7e\xbc\x3f\xee\x39\x41\x52\x95\xd6\x06\xbf\xf8\xb4\x8a\x3e\xc9\x15\xf6\x1e\x97\x9a\x55\x20\x16\x9c\x6e\xf3\xd3\x1c\x6f\x99\xb9\xfe\x5e\x80\x84\x46\x01\xc5\x13\x3e\xd0\x03\x87\xbd\xf5\x98\xb6\x50\xb1\xc8\x53\xdc\x41\xfd\x17\x5a\xd9\x22\x61\x28\x13\x68\x61\x85\x27\xe2\xba\xba\xb5\x76\x6a\xb7\xf2\x76\x21\x79\xaf\x40\xea\xcd\x65\x57\x8f\x3f\xca\x00\x48\x0b\x3a\x13\x59\x87\x2d\x37\x54\xae\xaa\x67\x59\xbf\x80\x32\xd0\xbd\x24\x3e\x37\x4b\xe4\xc1\x9e\x13\xbf\x87\x94\x7e\xeb\xaa\xe3\xef\x25\x48\x90\x50\x44\x29\x4e\xe1\x61\x10\xa3\x4e\xbe\xba\xef\xa9\x81\x62\xfc\xea\x56\xbb\xa7\xda\x8f\x2a\x38\x67\x13\xc5\x28\xa1\xdb\x97\x7d\x86\xb3\x82\xf1\xed\x2d\xd0\xdf\x6b\x90\x32\x8a\xce\x0d\x88\xae\xde\x6f\x6a\x57\x10\x4f\x7a\x8d\x1f\x02\x93\xb0\x5a\x1c\x7a\x3e\x42\x63\xa3\x1d\x46\xdc\xb5\xa7\x78\xd2\xd0\x25\xa1\x11\xe9\xd3\x1b\x17\xbd\x17\x21\x29\x0b\x76\xab\x63\x8b\x18\x04\x41\x43\x15\xbe\x49\x77\x4c\x4a\x5c\x48\x19\x1d\xdd\x90\x01\x6a\x90\x35\x06\x80\xa2\x44\x16\xd8\x86\x44\x8d\x3b\xe0\xcc\x1b\x6e\xdc\xab\x90\x80\x6e\x53\xc6\x03\x40\x4e\x94\xa8\x61\xa3\x1c\xf5\x00\xac\x42\xb8\x1a\x0e\x3e\x39\x9b\x1e\xe1\x08\xef\x68\x3a\x40\xae\x6e\xfc\x0e\x6a\x10\xd0\x52\xd5\x3f\x76\x51\xb9\xa7\x83\x12\xca\xb3\x72\x9a\xf6\x20\x80\x13\xc1\x24\xc8\x8c\x10\x5e\xd1\xb0\x61\x70\x56\xc8\x00\xbd\xca\x23\x95\xc0\x2c\x5d\x11\xeb\x7a\x96\x18\x5c\xd8\xab\xfc\x78\x28\x57\x14\x5d\x55\x65\x4e\xc4\x0d\xf8\x3e\x7f\x2f\x01\xec\x4b\x46\x00\x22\x02\xb4\xa7\x95\x76\xdc\xf2\x6a\xed\x02\x2f\x1e\x07\xe1\x66\x0b\x8c\xaf\x20\xaa\xff\x5a\x6a\xbc\x2d\xe3\x8e\xa2\x35\x04\xbe\xf4\x06\xb1\xa0\x16\x56\x2d\x7a\x3d\x6e\xa5\x3f\xc9\x44\x89\xcf\xd5\x6b\x23\xe7\x40\x68\x23\xbe\x8e\x3c\x4b\x2d\x53\xcf\x3e\xdd\xef\xdd\x1f\x97\x71\x45\x51\x75\x88\xc0\x1c\x9c\x57\x73\x9f\xd4\xd5\x90\xc3\x9a\xba\x96\x14\xd0\x04\x0a\xa0\x54\x33\x48\xf2\x51\xf6\x5b\xe0\x57\x30\xd1\x45\x90\x73\x06\x84\x25\xbf\xdf\x96\x71\x2f\x45\xaa\x4a\xed\x54\x5d\xff\x9e\xca\xd7\xef\xa7\x26\x98\x60\x36\x3e\xce\xe9\xfa\xc9\xe2\x6c\x7d\x58\x2b\x2e\x65\x65\x53\x11\xf9\x8a\x07\xe7\x53\x4b\xa3\xc4\xb7\x24\x1a\x7f\xaf\x45\x52\xf2\x0a\xc1\xa3\xb2\xfb\x03\x6f\x45\xbc\x59\xc5\x2b\x0b\xea\x05\x3c\xd1\x6b\x02\xfe\x0a\x50\x8c\xe0\x0b\x6b\x84\x80\x00\x18\x4a\xf6\x77\xb3\x10\x64\x1f\x77\xe3\xde\x40\x24\x1d\xac\xb3\x8a\xf2\xcb\x77\xa7\x33\x42\xb9\x24\x82\xee\xa3\xd5\xd8\x0f\x77\x50\xc5\x7b\x42\xde\xeb\x9a\x45\x29\x56\xb9\x43\x05\x07\xc6\x9d\x1f\x9f\xfc\xee\xd5\x48\x0e\x29\x45\xe4\x70\xe6\x30\xd5\x62\x61\x8d\x89\x36\x45\x60\x77\x04\x25\xbf\xd7\x01\x56\x4e\x45\x29\x34\x08\xe9\x4c\x78\xad\x80\xdd\x5a\x33\x4b\x43\xf5\x37\xf1\x78\x2f\x47\x9a\x47\xa9\x53\x1e\x31\x4d\x68\xcb\xaa\x62\xe5\x6b\xe6\xac\x9c\x4b\x42\x6f\xb3\xbe\xd4\xf5\x45\xbf\x34\xc0\xae\x5c\x5b\x48\xa9\x7b\x80\x4b\x62\x09\x0e\xf0\xb6\x8c\x2b\x8a\x12\x4d\xb1\xfb\xb8\x96\x8b\xa5\xe9\xa9\xc0\xf7\x02\x25\xc6\x6f\x2c\x44\xfc\x82\x13\x69\x22\xcb\xaa\x94\x53\xc2\xe8\xe8\x05\x07\x61\x29\xc1\x9f\xd6\x1f\x1f\x40\xef\x05\x49\xd3\x11\x39\xb0\x4c\xb4\xa0\x57\x9f\xa3\x18\x96\x43\xa7\x45\x42\x3e\x7e\xdb\x80\xcc\xa9\x3c\x23\xbd\xbc\x29\x86\xf8\x3c\xad\xe9\x85\xa9\x49\x65\xa1\x66\xde\x96\x71\xef\xc0\x14\x10\xb1\xed\xf4\x14\x70\x03\x34\x6b\x52\x6b\xb8\x15\x52\xdb\x2e\xda\x2e\x7d\x21\xde\x66\xcc\x6a\x50\x56\xc7\xf7\xfe\x88\xbf\x88\x1b\xa8\x7f\x1a\x8e\xfd\xb6\x8c\x7b\x49\x52\xd1\xad\xe7\x82\x3a\xac\xed\x07\x70\xad\x7c\xd8\x35\x4a\x56\xe1\x7d\xee\xea\xbb\x34\x3f\x49\xdb\x5a\x85\x7f\x2e\x64\x5d\x59\x04\xa1\xc1\x51\x96\xdc\x1e\x71\xe3\x47\x2d\xbc\x83\x01\xe3\x10\xe2\x5e\xb0\xf3\xae\x14\xfb\xaf\xec\xfa\xeb\x62\x12\xd5\xc5\x01\xa9\x64\x60\x77\x83\xeb\x40\x85\x20\x61\xaa\x35\x54\x47\x91\xf1\x78\x4b\x7c\x2f\x4a\xf2\x47\xd9\xe2\xf0\x87\xba\xa3\x2e\xe4\x91\xd5\x7c\x79\x14\x24\x1b\x24\x2b\x21\xb6\xc4\x64\x04\x5b\x88\xa4\xa1\x9f\xba\xac\xb7\x29\xb1\xfe\x40\xa0\xdf\x52\xce\xfc\xbd\x2a\x09\x77\x28\xb6\x52\x54\xcf\x10\x15\x30\x6e\xbc\x57\xbd\xb0\xa6\xf1\xd9\x5f\x58\xc1\x5a\x30\x5f\x75\x5f\x0c\x7d\xb3\x45\x01\x14\x1f\x52\x0c\x50\x80\xc7\x7e\x69\xf7\xb2\xa4\xe5\x95\x56\x95\x94\x53\x46\x48\x3f\xbd\x26\xb5\x86\xc8\xf5\xc4\xee\xc1\x74\xf6\x25\xc0\xc7\x9c\xe9\xe5\x53\x57\xda\x4b\xc9\x46\xde\x25\x75\x1d\x84\x14\x3f\x2e\xe3\xce\x45\x13\x1b\x9d\x5a\x26\xd4\x63\x70\xe0\x95\x7a\x7e\x0e\x4b\xa0\x3b\x52\x5f\xad\x3f\x89\xf6\xaa\x9c\x84\x0a\xa7\x85\x4c\x82\x0d\x23\x26\x40\x19\xb6\xa2\xd9\xe3\xa1\xa4\xbb\x5c\x8a\x10\x2d\xa0\x91\x6f\x1a\xd1\xd2\x40\xd4\x3a\x6a\x07\x8a\xb9\x4c\x8e\xab\xcc\x65\x4a\x90\xdb\x51\x17\x40\x19\x2d\x07\x07\x5d\x82\xd6\xad\xdb\xe5\xb7\xd0\xf6\xa3\x32\x29\x1c\x2c\x80\x00\xbb\x02\x11\x94\xd0\x91\x74\xdd\x18\xa7\x5e\x0c\x90\x8b\x0d\x8f\xed\x0d\x1a\x3a\x22\x4a\x1e\xca\x65\x4b\x49\x9c\x51\x15\x22\x71\xce\xfc\x86\xa2\xf7\xd2\xa4\x35\x77\xee\x36\x4a\xf0\x6c\x47\x59\xf0\x34\x3d\xb0\xb6\x83\xc0\x1f\xa1\x0a\x57\xd7\x2a\x07\x02\x6c\xc0\x27\x7a\x3b\xd9\x59\x71\x68\x53\xf0\xe6\x72\xde\x9e\xfc\xee\xb5\x49\x3b\x8d\xd1\xd4\x52\xa8\x9f\xef\x99\x6b\x64\xdd\xcf\xab\xdf\x4d\xef\x2e\x05\xff\x95\x95\x0a\x6a\x73\x19\xc0\x8a\x7c\x47\xad\x42\x89\xbb\x38\x73\x7b\xec\x63\x77\x2f\x4e\xb2\x36\xa6\x2f\x7c\x38\xc4\x3f\x74\x30\x42\x65\x36\x90\x90\x13\xd8\x79\xcc\x15\x6a\x91\xd5\x43\x04\x48\x9f\x27\x79\xbd\xcb\x1f\xf0\xcc\xd5\xac\x12\xfa\xc7\x7b\xd1\x7b\x75\x52\x50\xc9\x85\x9e\x54\x61\xc3\xba\xe2\x4b\x06\xcb\x88\xba\xe4\x23\x7a\x34\x1c\x17\xf8\xf2\x38\x53\x47\xd4\xaa\x18\x97\xdf\xec\xc5\x57\x78\xb2\x12\x38\xde\x5a\x91\xfa\x7b\x79\xd2\x11\xd7\xe9\x6a\xdb\xa2\x27\x2b\x42\xfe\x81\xa3\x43\x26\x76\x52\x3a\x66\x89\x09\xf5\xb8\x77\x56\xd9\x56\x01\x48\x27\x6a\x5e\x14\xc4\x10\xe0\xfc\xc1\xfe\xc6\xbe\xee\xf5\x49\xe0\xd0\x56\x6e\x9b\x0a\x90\x36\x7c\xd0\xf6\xde\x44\x76\x48\xb4\xa1\xda\xb7\xf2\xda\x8b\xcd\x8a\xe7\x04\x2c\x16\xbb\xf1\x52\xaf\xeb\x54\x50\x17\x69\xfc\xe6\xb0\xf7\x02\x25\x53\xc5\xc1\x4c\x6d\x1d\xb5\x3c\xe5\xab\xaa\x69\x18\xd8\x89\x94\x07\xad\xd0\x23\x6b\x6c\x29\x3a\xd7\xd5\xab\xb8\x0f\x16\xb6\x71\x2b\x14\xe6\x08\xe0\xfe\x9b\x89\xde\x2b\x94\xa0\x39\x41\x57\xe6\xc5\x0d\xa7\x54\x65\x82\x3c\x11\xcd\x30\x50\xdd\xe9\xe3\xe9\x88\x58\xf3\x33\xc7\x82\x05\x35\xdb\xec\xc9\x97\x46\x01\xf8\x06\x95\xed\xbc\x2d\xe3\x5e\xa2\x54\xd9\xd9\x3a\x75\x55\xd0\x94\x3d\x8e\x7c\x53\x77\xcb\x2a\xb2\xd5\x1c\x14\xb9\x55\x75\xba\x5c\x2e\xe9\xa1\xb1\xec\xd3\x57\xc6\x49\x20\x87\x08\x98\xe1\x1e\xdb\x5f\xde\x6b\xe3\x95\xa0\xc9\x52\xac\xba\x05\x36\x87\x66\xa5\x85\x38\x0d\xd2\x41\x30\xe9\x88\x84\x18\x08\x2c\xc8\xa3\x1a\x55\xb6\x53\x56\x58\x6b\xe4\x60\x44\xb6\x91\xec\xed\x71\xfc\x5e\xa4\x84\xda\xd0\x6d\xd6\xc2\x5f\x52\xc3\x41\xc6\x84\xe7\xa2\xd0\xcc\x15\x75\x04\xf3\xca\x44\x3c\x9d\xb5\xb8\xaf\x5c\x2c\x0d\xab\x7a\x71\xdc\x0e\x56\x1a\xfb\x5b\x9a\xa6\xbf\x57\x29\x1d\xf3\xd9\x40\x71\x95\xdc\x2a\x49\x96\xcf\x3c\x4e\x55\x27\x86\x8c\x9d\xca\xb4\xd9\xe3\x78\x00\xcc\xb9\xbc\x43\x85\x27\x13\xec\xd4\xce\xb5\xa8\xf9\xdd\xa3\xa2\xbf\x97\x29\x55\xd0\xca\xd6\xc4\x67\x9b\xa2\x19\xbe\xca\xe6\xe4\x64\xaa\x49\x6e\x6a\xc0\x68\x9c\x59\x55\x06\x3a\xe7\x11\x95\xe3\x2d\xd6\x6e\x6a\x63\x95\x45\xd6\xde\x96\x71\x47\xd1\x58\x94\x1e\xb3\xf1\xd5\xa0\xf4\xfe\x9a\x27\xfb\x6e\x6c\x7b\x46\xa8\x11\x66\x36\x4a\x41\x95\x1f\x67\x64\xb7\x8f\xd5\x71\x94\xd2\xa0\x4e\x80\xe2\xcf\x6f\x87\x72\x2f\x54\x3a\x4a\x8f\x4c\x27\xac\x32\xa2\x5e\xb2\xa2\x9e\x0d\x62\x01\x36\x43\x53\xef\xb0\x53\x9c\x55\x42\x1a\xc4\xd9\x77\x75\x86\x02\x30\x60\xe9\xab\x0a\xca\xd7\xa3\xa2\xbf\x57\x2a\x85\xf5\xa1\x37\x98\xbd\x91\xa7\x5f\x06\x37\x42\xc4\xab\x80\xb0\x72\x12\xb8\x0b\x1a\x17\x3f\x45\x0e\xa0\x62\xce\xd6\x4d\xf9\xf2\x81\x65\xcf\x4a\x74\x79\x23\x81\xf7\x52\xa5\xbd\x5d\x2e\x48\x34\xb7\x39\x6a\xb5\xfe\x5c\x0e\xfa\x67\x41\xe9\x9a\xe8\x91\xbc\xa7\x9b\x93\x7d\xc1\x7f\x33\xcb\x55\x0e\xda\x30\x59\xab\x82\x3f\x11\xe0\x6d\x19\xf7\x7c\xfb\x55\x16\x9c\x03\xb8\x20\x8c\xc3\x6d\x42\x1c\x7a\x5b\xc2\x15\xea\x81\xf6\x55\x53\xa3\x69\x1c\x15\xef\xed\xfc\x26\xda\x1a\x83\x69\x2a\xce\x56\xa1\xcc\x23\x6e\xdc\x8b\x95\xfa\x97\x8b\x99\x08\xe7\x6a\x55\xc6\xe7\x15\x94\x0b\x40\x32\xf8\xda\xea\xf3\x16\xb3\x8a\xe6\x54\x45\xbf\x4d\x09\x61\x65\xf7\x83\x72\xdb\x6b\xf9\xad\x2e\x91\x6f\xcb\xb8\xa3\x28\x6e\x18\x21\x11\x69\x4c\x04\x42\xf2\xa1\x1c\x20\x0c\xf0\x22\xaa\x2a\x1b\xb1\x9b\xe3\x0f\xac\x2c\xdc\x20\xee\xee\x48\x2c\x9e\x4a\xc2\x21\x30\x7b\x3f\xdf\xb8\xe8\xbd\x5c\xa9\x45\xb4\x3a\x60\x0f\x4e\x4e\x70\x22\xf8\x00\x52\x4f\xed\x51\x83\x92\x82\x66\x4d\xc9\xce\xbd\x9b\x1a\xfb\xb9\x5e\x5d\x84\x08\x40\xdc\x23\x11\x26\x59\x7b\x6c\x66\x7c\xbf\x17\x55\xe1\xa4\x39\x01\x45\x54\x23\x69\xc0\xbc\xba\xc0\xcf\x21\xeb\x3d\x38\x7e\x5a\xb3\x9e\x1b\xeb\xaa\x90\x00\x8c\xa8\xe9\x39\xd6\xd8\x8f\xe8\xd4\xf0\xe1\x6d\x19\xf7\xc6\xa0\x6a\x35\x59\x8a\xee\x3d\x8f\xe7\xac\x0f\x3f\x3a\x9c\x13\x83\xd1\x3c\x02\xe4\x13\xea\xa2\x02\xaf\xd1\x05\x78\xd7\x52\x09\x6c\xf9\x93\xe5\xda\x95\x54\xfa\xb6\x8c\x7b\x57\x65\xbe\x17\x1c\x70\x9f\xb3\x55\x82\xac\xe0\x8d\x6f\x9a\x9a\x86\x85\xa6\x2e\x48\x0e\x2d\x0b\x3b\x64\xc7\xf2\xca\xaa\x94\x31\x70\xdd\x11\x4e\x7c\x52\x01\xdc\x5b\x6f\x67\x77\x6f\x44\xe9\x8f\xb7\x81\x51\x78\xf5\xe3\x04\xd0\xbd\x9a\xbf\x6c\xf0\x0c\x8b\x85\x8a\xab\xf2\x37\x23\xda\x86\xba\xe4\xf0\x87\x1c\x1b\xb7\x95\x1e\x6f\xa7\x96\xc7\x0c\xc9\x7b\xcd\x92\xae\xe8\x53\xde\x47\x7d\x1c\xc2\xd6\xcb\xa3\x52\x13\xd1\xb1\x6a\x59\x60\x88\x11\xaf\x56\xce\x7e\x82\xf2\x86\x4a\xf8\xb2\x47\x59\xa0\x1f\x0e\xaa\x84\xb4\x79\x5b\x46\xf8\x91\x3d\x0b\xdf\xdc\xfc\xa3\x24\x2f\x55\xfc\x96\x29\x3a\x98\x63\xcb\x6d\xab\x30\xbf\x41\x11\x37\x87\xb2\x7d\x82\x6b\xd4\xb4\x95\xe8\x5e\x7b\x74\x6e\x56\xf7\xc6\xcc\x7f\x54\x2d\xa9\xe5\x4a\x4f\x9b\xb8\x51\xe0\xc0\x9f\x12\xe3\xfb\x23\x5f\x3a\xa7\xbf\xdd\xee\x2b\x84\xa2\xdc\xe2\xdc\xba\x6a\x55\x0a\x3f\x81\x2f\x02\xfe\x39\xae\xfd\xe6\xb0\xf7\xb2\x25\x1f\x94\xb9\xee\xd5\x0b\x87\xcf\x42\x01\xc0\x71\x62\xa8\x9a\x25\x62\x2a\x18\x57\x8b\xb1\xee\x63\xd5\x3d\x6d\x4c\x1b\x66\x92\x46\x80\x35\xef\xe0\x75\xc7\xf1\xb8\x1b\xf7\x1e\x77\x13\xd9\x08\xaf\xab\x6a\xf7\x9e\x80\x07\xaf\x5b\x26\x21\xe4\xd0\xfb\x9a\x29\xe9\x1c\xbe\xac\xe9\x2b\xd6\x8b\x35\x77\x74\x49\x48\xd4\x83\xa5\x5b\x78\x7c\xf2\xbb\x17\x2e\x21\x50\x82\xb0\x8b\x8f\x43\xa4\xeb\x42\x29\x3b\x42\x69\x82\x29\xb7\x84\x87\x21\x07\xaa\x2e\x09\x77\x5e\x1e\x1b\x32\xec\x66\x9a\x9a\xa3\xb3\x7a\xe1\xc8\xdb\x32\xea\x1d\xcc\x25\x06\x08\xf2\xa8\xa3\xaa\x76\x6a\x70\xe4\x52\x9d\x4a\xda\x50\x4c\x4d\xdd\x90\xf0\x51\xcb\xa9\x76\xf5\x0c\xd0\xf4\x8f\x66\x9c\x10\x6b\x0a\x65\xc6\xb7\x08\x7b\x2f\x5d\x52\x0f\x39\x8e\x1f\x43\xa8\x21\xaa\xef\xa5\x8f\xea\x3b\x64\x1e\xf2\xa5\xab\xd0\x38\xd4\x4e\xa1\xf3\xe9\xc3\xb1\x43\x2a\x19\xd1\x8c\x1e\xb5\x0f\x2a\xc4\xdf\xc7\x65\xdc\x7b\x35\x35\xa5\x53\x6f\x38\xb1\x43\xc7\x1f\xf4\xaa\x32\x8a\x21\x3d\x87\x75\x44\xc4\x1b\x61\xa6\x0b\x52\x63\x6a\x49\xcd\x37\xaa\xa9\xaf\x93\xf4\x3e\xd4\xe4\xad\x97\x42\xb8\xd7\x2e\xd9\x37\xad\xa0\x19\xeb\x40\xd1\xcf\xb1\xfd\x71\x6d\xaa\xe9\x8a\xf9\x55\x3c\xd0\xa9\x3e\xd0\x90\x42\x50\x64\x49\x69\x8a\xb9\x13\xf9\xbd\x43\x56\xb8\xb7\xf6\x9c\xe1\x5e\xbb\x94\xd0\xc6\xc7\xef\xe9\xd5\x39\xa2\x22\x1b\x89\xb4\xaa\x30\x65\xc3\x5b\x01\x2f\xd9\x79\x87\x92\x6f\x1e\xf6\x1e\x0b\xb4\xac\xc0\x12\xe1\x88\x5d\x15\x43\xd8\xcb\xdb\x32\xee\xa5\x9f\x05\x11\x28\x8e\x7d\xa6\x61\xfd\x5f\x5d\x29\xdc\x4b\x69\x93\xdd\xab\x50\x07\x5e\xba\x5d\x01\x48\x97\xd4\xdb\x54\xe3\xca\xe4\x26\x8c\x5d\xf5\x45\xe7\x71\x19\xf7\xfe\xf4\x20\x61\x56\x45\x5b\x87\x08\xf2\x35\xe5\x2b\x73\x29\xd5\x1d\x8f\xdc\xd8\x26\xe2\xa4\xd7\x90\x41\x71\xe5\xe5\xd9\x00\xc9\x9a\x7a\x70\x37\xa4\x34\xe6\xfd\xb6\x8c\x7b\xc3\xa6\x03\x52\x6e\x0d\xf1\x50\x6b\x0b\x08\x32\xae\x40\x54\x21\xd8\x21\xe0\x7a\x5a\x05\x3a\xa8\x92\x98\xa5\xca\x15\xfd\x72\x50\x7f\xd0\x76\x94\xe7\x24\x67\x7a\x5b\xc6\x3d\x5f\xb4\xa7\x7a\xaa\x11\xc8\x90\x49\x9a\x5f\x30\xe2\x19\xd5\x11\xf5\x43\x25\x72\x0c\xdc\xd8\xd9\x20\x06\x0f\x75\x46\x82\x0d\xea\x45\x12\x68\xd9\x21\x66\xbd\x57\xbf\x2d\xe3\xde\x76\x84\x68\x45\xdc\xc8\x33\x9f\x33\x0a\xe2\x69\x6f\xef\x15\x66\x5d\xac\x5f\x97\x54\x0d\x0c\x98\xdd\x6d\x54\x03\x8b\x2a\x43\xf5\xc2\x96\x94\xea\x23\xc2\xf1\x38\x4c\xe2\x47\xcf\x26\xb6\x57\xa4\x43\x2e\x8b\x45\x16\x44\x6d\xe8\x9c\x42\x23\xb0\x20\x8d\x38\x1a\xe1\x9b\xd7\x94\xa0\x1e\x09\x23\xd0\x01\x3d\x4d\xc2\xc6\x62\x9f\x6f\x19\x0b\xe1\x5e\xbb\x04\xb1\x61\x07\x9a\x81\x56\x55\xdd\x55\xd4\x6f\x06\xac\xe0\xb3\x2c\xee\x0e\x6a\x79\xb6\xc1\xc2\x40\xbf\x35\xa7\xc6\x2c\x08\x58\xef\x12\x56\xcc\x2f\x06\xf7\xd6\xa4\xfe\x5e\xbb\xb4\x71\x92\x0a\xd3\x46\x9a\x65\x8d\x67\x4a\x33\xa8\x33\xd1\xd9\xea\x1a\xa0\x02\x25\xb5\x5d\x04\x23\x38\x0f\x9b\x98\x32\x47\x74\x94\x48\x5a\xe6\x69\x7b\xce\x37\x4f\xb9\xd7\x2e\x65\x97\x5c\x02\x96\xbf\xf3\x0f\x45\x6d\x03\x88\xec\x7b\x6c\xd5\xf8\xa5\x3e\x3b\x6e\x51\xbb\x3a\x50\xe8\xf6\x4b\x2d\xb4\x44\x56\x21\x26\x80\x58\x1b\x8f\x0e\x7b\xaf\x5d\x3a\xaa\xd1\xe9\xea\x72\x61\x88\x44\x15\xe6\x43\x07\x11\x6f\x80\x77\xcb\x16\x01\xb7\xde\x35\xb1\x6a\x4c\x75\x0e\x55\x1f\xd3\xa8\xc6\xf5\x8d\x13\x81\xd2\xbf\x15\x94\x85\x7b\xed\x52\xf2\x6a\x7a\x69\x53\x2a\x41\xfd\x4b\x31\x47\x68\x8e\x0c\x16\x69\x54\xac\xd4\xca\x56\x9e\xee\x54\x81\x61\x89\x50\x47\xec\x57\x86\xaf\x52\xf2\x95\x8f\xf7\xb6\x8c\x2b\x8a\x86\x8e\x33\x00\x46\x0e\x4c\x0c\xb0\xed\xe4\xcd\xab\x39\x13\x98\x31\xd7\x80\xf4\xa1\xd5\x3a\x3b\x30\x96\x11\x6b\x87\xd3\x4b\xc2\x84\x1a\x37\xfe\x89\x39\xef\xb7\x65\xdc\xdb\x8f\xc0\xad\x04\x61\x6a\xfc\x18\x55\x1f\xad\x5e\xd3\xa0\x25\x0a\xaa\x2c\x44\x8c\xca\x0f\x10\x67\xab\x46\x3c\x03\xc1\x9f\x93\xda\xd7\x58\x9c\xca\x0d\x04\xf0\xde\x96\x91\x7f\x4c\x6a\x72\x38\xa8\x5a\x7e\x2b\xb2\x82\x09\xa3\xab\x77\x16\x3c\x1c\xbb\xdc\x90\x5f\x65\x51\x38\x45\xb7\x00\xd2\x1e\x69\x87\x06\x15\x9c\x1c\xd1\x98\xeb\xcd\x61\xef\xb5\x4b\x65\x29\xb8\x06\x5d\x08\x6a\xbe\x84\x86\x0b\xc2\x32\x9a\x9f\xd0\x74\xc0\x52\x77\x8e\x6a\xe9\x0a\x9e\x6a\xe2\x09\x51\xf7\x84\xaf\xa7\x3f\x6e\x0e\x75\x2e\x8f\xc3\x70\xae\x28\x5a\x72\x03\x1f\x9c\x3a\xd4\x8f\x0c\x4e\xba\x99\xf5\x8c\xe6\x7c\x6d\x39\x1a\xfa\x1a\x10\x9d\xc6\x07\xaa\x5b\xb9\x5e\x10\x00\x93\x3c\xa6\x12\xf1\x10\xb8\x6f\x6f\xf4\xe1\x5e\xbb\xa4\xba\x4b\x4e\x41\xb5\x59\x60\x3a\xa7\x51\xdd\x39\xa2\x9a\xf8\xc9\x77\xf9\x55\x3e\x24\xc3\x51\x87\x6e\x5d\xd8\x28\xdd\x16\xdb\xf2\xb1\x00\x7b\xf1\xd1\x53\xfa\xbd\x06\x81\x48\xa1\xf7\xb6\x90\x8b\x57\x67\x7c\xa7\x92\x0b\x3e\x06\x09\x17\xfe\x54\x56\x39\x65\x9a\xa9\xcb\x84\x72\xb0\x6d\xc6\x5c\x4b\x5e\x61\x56\xb5\xaa\x2b\x6f\xb3\x81\xdc\xfd\xe5\xb1\x12\xd2\x27\xc8\x89\x14\xd0\x15\x34\xc8\x79\x8c\xc0\x5a\x41\x51\xf5\x23\x69\x11\xf4\x76\x65\xc1\xf8\xa2\xa8\xfa\xd2\x54\x3c\xa7\x77\x2e\x8d\x8a\x79\x5c\xc6\x15\x45\xd5\xab\x57\xf9\xdb\x11\x31\x22\x8a\xa7\xd6\xe8\xbd\x14\x15\xa7\x28\xcd\x5c\x3d\x0d\xce\xe8\xa0\x2a\x4c\x75\x75\x71\x8f\xbe\x95\x7e\x3d\x42\xd4\xa8\x9c\x37\x4a\x7c\xaf\x5d\x52\x8b\xeb\xb6\xd5\x85\xb1\xb9\x64\x30\x62\x95\x36\x86\xa9\x64\xc5\x59\xbf\x36\xc8\x9a\x9d\xd4\xf8\xdd\xba\x0f\xca\x4d\xaa\x72\xc1\x45\x21\x42\x76\x4e\x7c\x33\xd1\x7b\xed\x52\x2b\xb0\xa8\xad\xec\xb2\x9e\x9d\x6a\xb7\x36\x12\x91\x4d\xdf\xbb\x22\x19\x61\xe2\x41\xcd\x1a\x47\x0f\x08\x6a\x3d\x9b\x63\x47\x65\xab\x78\xbb\x98\xe6\x1e\xbc\xa1\xe8\x8f\x61\x49\x05\xa9\xaa\xe6\x30\x0b\xb3\x6c\xb3\xf4\xaf\xb5\x86\xe6\x55\x05\xa5\x8f\xf2\x4b\xd5\xe9\x85\xb8\x0c\xdd\x84\x70\x7e\x69\x69\x34\x62\xd5\x3c\x14\x75\xd4\x7f\x5b\x46\xbe\x9b\xe8\x88\x84\xac\xfd\xb5\x4f\xdd\xa3\x55\xf4\x98\xff\x5e\x7c\x7b\xef\x36\xa7\x5a\xfd\x96\x1a\x31\xd2\xa3\x56\x78\x6c\x92\x47\x6b\x3b\x7c\x77\x06\xb5\x85\x78\x5b\x46\xb9\x8f\x76\x58\xad\xaf\xa1\xe7\x2c\x5f\xbe\xee\x01\xdd\x8c\xcd\x68\xb9\xe6\x60\x6a\xc1\xd6\xd5\x5f\x4b\x53\x55\x6d\x41\x45\x60\xa2\xca\xc9\x5f\xaa\x77\x9a\xeb\x11\xbe\xee\xb5\x4b\xc5\xea\x74\x65\x18\x4a\xb6\xb9\x35\x4f\x50\x53\x35\x20\x43\x43\x5f\x62\x9b\x2a\xd5\x00\xbc\x55\xc6\xae\x12\xb3\xec\xbf\x0e\x10\x5d\xf7\xe8\x13\xee\xf3\x38\xc1\xea\x5e\xbb\xb4\xd5\x51\xc2\xfb\xd0\x35\x8b\xb4\xcd\xa4\xd1\x38\x08\xca\x03\x9e\xea\xf5\x22\x0f\x5d\x03\xad\x5d\x4e\xc1\x2d\xbe\xe6\xf9\xba\x6c\x1a\x2b\x94\xb3\x9a\x7f\x1c\xb0\x76\xaf\x5d\xda\x2a\x74\xd4\xd0\x15\x65\x6c\x4b\xa0\xfa\x09\xd7\x3c\x63\x4f\x74\xe4\xae\x39\x27\xd8\x27\x6a\xa9\x57\x5d\x9e\xae\x5a\x02\xa4\xc8\x3b\xd4\x6b\x42\x59\xbd\xdd\x12\x87\x7b\xed\x92\x11\xc7\xaa\x53\x07\xec\xa1\x6e\x40\x25\x69\xa6\x55\xcd\xab\xaa\xd0\x11\xf3\x8c\x98\xac\xae\x18\x54\x1c\xfd\x25\x1a\xe9\x59\xc3\xd4\xe3\xcb\x0a\x96\xf2\x66\x1b\x3f\x66\x26\xa9\xa5\x40\x30\x95\x15\xa8\xd8\x00\x37\x81\x12\x1a\x10\x6e\xa5\x64\xa2\x79\x56\xf5\x43\x8b\xfc\xbb\x3a\xfa\xf7\x62\xa9\x57\x33\x0c\x7b\xc8\x6d\xfb\xe3\x6e\xfc\xb8\x17\xd5\xe4\x66\xe5\xf1\x00\xa2\xb3\xb4\x55\xb0\x14\xbd\x66\x85\xa6\x2e\x68\x1b\x48\x1b\x9d\x13\x00\x60\x81\x96\xd0\x0c\xae\x18\x54\xbc\x01\x25\x83\x2b\xbd\xb1\xaf\x7b\xed\x92\x59\x9f\x6a\xc1\x1c\x33\xe6\x39\xbe\x07\x79\x22\x16\x0c\x1d\x03\x55\xa7\x50\xc2\x1e\x2c\xf0\xc4\xb9\xa7\x54\x15\x46\xab\x1c\x56\x28\x48\x4b\xaa\xab\x7e\xf3\x94\x7b\xed\xd2\x1a\x61\x0e\x85\x77\x74\x51\xd3\x6c\x26\x4d\x18\x8e\x1a\xc9\xe7\x61\x44\x30\x91\x3a\x1b\xc4\xaf\xc2\xcd\xea\x68\xe6\x42\xf5\x10\x65\x18\x6c\x15\xd2\x8d\xc7\x65\xe4\x7b\xb7\x74\x25\x22\x40\x73\xf6\x82\xed\xf2\xd1\x7f\xda\x3f\xab\x90\xab\x74\x28\xd0\x52\x09\x7f\x86\x1b\x6f\xf0\x3c\xc7\x2d\xbd\x1f\xd5\xdb\xd3\x99\x7a\xcb\xbc\x81\x79\xfc\x31\xfc\xa3\xa9\x3b\x37\x51\x0c\x7f\x9c\x47\x69\x09\x88\x58\x0d\x0d\x6a\x3b\xcc\x38\x54\xee\xd9\xd8\x93\x3a\xa1\x5a\x40\x3c\x70\xb1\xfa\xe4\x3f\x51\xf1\x4c\x7c\x6b\xf4\x15\xee\xb5\x4b\x84\xac\x5e\x2b\x06\xd9\x60\x5f\x0a\x2b\x9c\x77\xc8\x98\x22\x24\x3c\x94\x58\xd4\x0c\x05\x2d\xe5\x81\xf8\xe6\xd4\x4d\x6a\xe2\x38\x13\x1d\x65\x0b\x5a\x16\xde\x68\xcf\xbd\x76\x09\x86\xb7\x39\x7a\x3e\x35\xc4\xae\x5e\x17\x29\xfa\xc3\xce\x6f\x98\x85\x1b\xbe\xab\x14\x63\x0e\x7e\x9a\x1b\xae\xdc\x4e\xde\x3b\x11\x4a\x0a\x16\x95\x76\x7c\x4b\x77\x0f\xf7\xda\xa5\x3c\x3a\xc1\x54\xe3\x0b\xc2\x52\xc7\x95\xae\x89\x62\xd3\xb6\xba\xc9\xe2\x1f\xca\x33\x8b\x21\x95\xae\x17\x17\x54\x9c\xae\xec\x67\x28\x21\x45\x7e\x3c\xee\xad\x5a\x27\xdc\x6b\x97\x50\xa7\x1a\xac\xcd\xa9\x0c\x3f\x88\xc4\x30\xf1\xaa\xf9\x4c\x83\x65\x21\x1a\x6d\x4b\xbf\x54\xd5\x83\xfa\x36\x60\x1c\xc4\x11\x95\x67\x7c\x23\xb0\x21\xc7\x8f\xcb\xf8\xd1\x4d\x14\x25\xe8\x8e\xcb\x9a\x47\x12\xaa\x8a\xe9\xdb\xda\x59\x33\xad\x34\xf7\x4d\xcf\x7d\xaa\xe9\x52\xdd\x5b\xd5\x00\xde\x11\x50\x73\x66\x68\xa7\xb9\xd5\x18\xec\x6d\x19\xf7\x7c\xd1\x4d\x78\x5d\x49\x9f\xab\xa6\xe9\x71\x38\x88\xb7\x1a\x13\x72\xf0\x21\x43\xd2\x31\x56\xf4\xcb\x01\xc7\x34\x97\x64\x94\x6f\x22\x71\x9c\xec\x5f\x41\xe1\x3c\xee\xc6\xbd\x9f\xa8\x1a\xad\x10\x2f\xa1\xd9\x2a\xb2\x04\xbf\x86\xdf\x58\xa2\xda\x28\x64\xec\x93\xf5\xcc\x06\x4c\x78\xd4\x3e\x7a\x11\xfa\x05\x21\x64\x59\x45\x9d\xcb\x41\x93\xb7\x65\xdc\x6b\x97\x50\xa5\x6a\xe5\xb9\xd5\x57\xca\xa9\xe2\x74\x78\x44\xb5\x3a\x48\x8a\xf0\x04\xc7\x59\x01\xf2\xc1\x9f\xaa\xa4\x1e\xfb\xc6\x51\x47\x4d\x3f\x73\xea\x66\xf9\x38\x3e\xf4\x7e\x2f\xea\x9a\x86\xc0\xeb\x0c\x10\x8c\x13\xe5\x06\x86\x65\x22\xe9\x71\x75\x6a\x3e\x09\xe6\xbd\x2d\x79\xa7\xa7\xd7\x09\x1f\xfd\x66\x61\xab\x30\x93\x40\xf7\xd8\x4f\x3f\xfc\xa8\x5d\x32\xf5\x98\xd3\x73\x8d\x1e\x17\xd7\xde\x1a\xbf\x42\x4c\x87\x69\x59\xec\x5f\x6f\x74\x7f\xd4\x8c\x4c\xa9\x35\xe9\xc4\x30\xd4\x4e\x3b\xa8\x51\xdb\x9e\xfb\xed\x3d\x25\xa4\x1f\x3d\x9d\x34\x78\x25\xc3\x74\x25\x19\xf9\xbf\xc5\xba\x1a\x20\xe5\x1b\x51\x16\x20\x4d\x09\xc9\x3f\x35\x9d\x6f\xa8\x89\x36\x56\x31\xaa\x36\xa8\x84\x55\xea\xe3\x43\xc6\xbd\x76\xc9\xa9\x52\x69\x86\x9c\x75\xd7\x34\xbd\x9a\xc1\xa1\xd6\x01\xd4\xef\x5a\xb0\x6a\x42\x0e\x6c\x2f\x54\xa5\x3c\xc1\x07\x4e\xc9\x58\x8a\xc3\xae\x5b\x4c\xf0\xa3\x37\x14\xbd\xd7\x2e\xad\x12\xd1\xc9\x10\xbc\x00\x2b\xdd\x1a\xd9\xa9\x1c\x00\xd7\x66\x6f\x28\x15\xe5\x28\x76\x51\x74\xa4\xa3\x84\x74\x2a\xcd\x0e\xdb\x03\x71\xd5\x50\xb6\x68\x8f\xb3\x65\xef\x59\xf7\x95\xfd\x8f\xd5\xf7\x93\xd4\xf6\x04\x43\x0c\x4a\x0f\xd4\xf4\x00\x20\x64\x73\x24\x09\xc5\x88\x51\x7a\xc3\x7a\x92\xda\x82\xc1\x07\x54\x8f\x71\x0e\xbf\xf5\xb8\x8c\xfb\xdc\xf8\xe0\x77\x61\xdb\xa3\x50\x13\xbf\x5c\x9a\xaf\xf1\x75\x7e\x50\x8f\xad\x72\x26\xfb\x0f\xb4\x11\x69\x71\xe4\xa9\xfe\xee\x19\xd9\xef\x38\x13\x4d\xfb\x79\x9b\x1d\x14\xee\xb5\x4b\xca\x89\x85\x88\x42\xb1\x3c\x8a\x00\xd6\x3d\xdc\x2c\x38\x88\xba\xf9\x8f\xa2\xd1\x0a\x84\x3a\xbe\xfd\xdc\x7d\xea\xee\x05\x4e\x0c\xe2\x23\xc0\xd5\x76\xb5\xbc\x35\xa8\x0e\xf7\xda\xa5\xa9\x99\x12\xde\x1d\x58\x0d\x3a\x0d\x27\x31\x82\x3c\x3e\x51\x9b\xda\x22\x02\x5a\x0b\x45\xa7\x16\xb0\x0e\xac\x55\x4a\x54\xd4\x7b\x6d\xd7\xb0\x83\x06\xcc\xbc\xc9\xa5\x7b\xed\x92\xda\x0e\xc2\xff\x16\x5b\x82\x1a\x0c\xdf\xd7\xf5\x63\xe6\x98\xf4\x1a\x3d\xb6\x1a\x25\xf6\xa1\xe9\x1f\x59\xf9\xa3\x9a\xc3\x36\xe5\xdf\x4d\xef\x4e\xe7\xad\xd9\x6b\xb8\xd7\x2e\x39\xd5\x77\x78\x60\x53\xf3\x85\x4f\xb3\x11\x88\x5c\xae\xaa\x2c\xd7\x55\xfe\xbb\xe3\x8d\xa0\x06\xb8\xa9\x04\xe2\x38\x00\x63\xd7\x01\x21\xc1\x90\x72\x03\xe0\xdf\x96\x71\x1f\x1e\x1f\x9c\x2f\x1a\xcd\x02\xff\x2b\x2a\xcf\xd8\x9a\xbb\x3c\x2d\x0d\xb7\x75\x9b\xee\xa6\x06\x4b\x25\x62\x9d\x66\xcf\x8a\x9b\xeb\x15\x45\xd3\xad\x06\xde\xbb\xdf\x62\xca\xbd\x76\x09\x8a\xa7\x27\x36\x53\x03\x38\x36\x04\xb1\x6c\x59\x69\xd3\x3d\x41\x7e\x4e\xf4\x04\x7f\xbd\x4f\xab\x1b\x57\x47\xd8\x2b\x08\x46\xe5\xe4\xab\xb5\xbd\x5a\xed\xbc\x2d\xe3\xfe\xba\x84\x05\xe6\xac\x19\x4a\x5d\x7d\x91\x7b\x9f\xd1\x07\xd7\xfb\x52\x57\x2b\xdd\x7b\xf8\xec\x07\xf4\xe2\x58\x5f\x2a\x98\x0d\xd3\xa3\xaf\x5c\x26\xac\xcc\xdc\xe6\x1b\xdf\xb8\xd7\x2e\x95\xbc\x7b\x9e\x06\xbf\x53\xa2\xa6\x53\x16\xa2\x77\x41\xd3\xf8\xe0\x17\xa5\x94\x99\x61\xee\x70\x77\x97\xd4\xab\xd0\xf9\x5e\xd3\x82\xa8\x86\xed\x51\x0f\xaf\x77\xe6\xe5\xc7\x20\xcf\x18\xfc\x50\xe0\x8e\x7c\xcd\x15\xc3\x3c\xc0\x82\x8a\xa7\xa2\x46\xde\xe6\xb3\xa3\x27\xcc\x0d\x00\x9f\xd8\x46\x4c\xd9\x9a\x65\x14\x34\x12\x6e\xb2\x1d\x8f\xcb\xb8\xe7\x8b\x36\x4c\x00\x9d\xc6\xdf\xed\x6c\x72\x10\x18\x09\x14\x70\xe8\xe2\xfc\xf0\x85\xdb\x41\xc1\xc4\xe3\xd3\xc1\x3c\xda\xa8\xae\x14\xa5\x82\xb7\x15\xa0\xcb\xe1\x6d\x96\x51\x28\x3f\xc6\x82\x10\x1e\x1a\x7b\xbd\x10\xc9\x9a\x66\xe6\xaa\xc7\x4b\x4a\x51\xa2\x08\x52\xd6\x73\x64\x1a\xca\x50\xcf\x3a\x7c\x7f\xa7\xae\x71\x59\x3f\xc7\xaf\xc2\x63\x13\xb6\x50\x7e\xf4\xb6\x87\xd2\x21\xd1\x9b\xe6\x36\x96\x95\x55\x16\x42\x34\x9d\x6a\x35\x55\x86\xae\x19\xea\xa8\xec\x48\x4c\x16\xbe\x84\xab\xd5\x07\xf4\x9d\xa0\x37\x61\x64\xe5\x71\x37\xae\x28\xaa\x6c\xd0\xbc\xe2\x2c\x61\xa3\xda\xd5\x70\x03\xca\x9b\xbf\xa6\x57\xbd\x2d\x36\x4a\x6d\xac\xf1\x52\x1b\x85\xa3\x53\xef\x89\x3e\xa2\x6a\x79\xa2\x12\x4f\xd2\xdb\x0b\xc2\xbd\x76\x29\xc5\xea\x5c\xdf\x6e\x75\x10\x52\x99\x81\x98\xb3\xa1\x13\xaa\xea\x20\xdb\x06\x4e\x35\xa9\x02\x04\x57\xb6\x53\x00\xf1\x63\xdc\xca\xab\xce\x4d\x29\x38\xfd\x2d\xa6\xdc\x6b\x97\x54\x0f\x7b\x8a\x18\xf0\x51\x52\x8f\x25\x25\xcb\x98\xf8\x98\x6b\xd2\x50\xa0\x94\x32\xac\x3c\xc0\x9a\x51\x75\x3b\x47\x8d\x5f\x20\x0a\xce\x72\x34\x7c\xf7\x6d\x19\xf7\xd9\x20\x05\x41\xf6\x0d\x97\x52\xa5\x81\xee\x05\xb1\xca\x91\xe1\x59\xf0\x1f\xf8\x27\x6c\x70\xaa\x36\xb3\x23\x14\xea\x3c\x6b\xd4\x58\x54\x94\x38\xf5\x52\x3f\x1e\xef\xbe\xee\xb5\x4b\xaa\x7b\x61\xcb\xbd\x66\x66\x16\xdd\x88\x9b\x43\x24\xef\x5a\x77\x6f\xfa\xce\x9a\xdd\x5d\x70\x09\xdd\x9a\x83\xe1\x47\x15\xc1\x47\x1d\x20\xba\x53\x9a\xd6\xe3\x6e\xdc\x15\x3d\xd4\x62\x06\xd5\xe4\x28\x6f\x24\x71\x30\x70\xc1\xae\x92\x50\xcb\xa1\x66\x8d\x2e\x87\x7f\x6e\xe5\x4a\x68\xa2\xa8\x26\x67\xf0\x47\x3b\x67\x58\xfa\xb4\xf3\x66\x1b\xf7\xda\x25\x25\x9e\x09\x1d\x87\x5e\x56\x8b\x9a\xea\xe7\xa1\xf2\xd3\xe3\xd0\x6c\x4e\x0d\xd5\xcd\xa2\x5f\x4b\xe5\x23\x4e\xbd\x83\x83\x53\xef\xc8\x31\xad\x48\xcb\xbe\xed\xc6\xbd\x76\xa9\xa8\x75\xfd\xd6\x7c\x5b\xac\x91\xe0\xba\x41\xa8\x99\xab\x07\x22\xb2\x06\x01\x57\xcd\xa6\x8b\x12\xd2\x4b\x45\xb9\x49\x94\xdd\x65\x17\x1a\xfa\x0d\x4e\xf6\x46\x7b\x7e\xd4\x2e\xa9\xb7\x94\xfa\xcf\xcc\xbc\x54\x1b\x7d\xe0\x10\x88\x90\xc3\xbe\xc3\x93\x6b\x09\x21\xb0\x27\xdb\x90\x2a\x7e\xc7\x60\x05\xd5\xd6\xf8\x8f\x9c\xc6\x9e\xa2\x76\xdf\x96\x71\xef\xe9\x04\xf9\x4d\x53\x10\x3d\xca\x56\xd5\xe7\x4c\x9a\xec\x54\xc7\x56\x23\x8a\xba\x34\x19\x42\x4f\x2b\x49\x2a\xa1\xe7\xa8\x77\x3e\xf5\x5c\xa8\xe3\x68\xcc\xe7\xa3\x6d\xdc\xb9\xa8\x2f\xea\xb6\x0a\x8f\x59\xab\x8a\x90\x2b\xf1\x89\x8f\xd6\x2c\x71\xcd\xaa\xb3\xaa\x56\xf7\x2e\xab\x45\xc9\x6e\xe0\x99\x69\x9a\x8d\xa6\xce\xff\x79\x66\x7a\x5b\x46\xbe\x4f\xf3\x29\x01\x42\x33\x96\xf2\x38\xb6\x1b\x73\x3b\x20\xea\xc4\x2f\xf9\x5f\x55\x85\xb0\x0f\xdd\x9a\x76\x08\xc7\x02\xcb\xe3\x8c\x9a\x03\x1c\x63\x43\xd1\xd6\xb7\x3e\x81\xe1\x5e\xbb\x34\xb3\x4a\xb1\x93\x2b\x4e\x93\x63\xd0\xf1\xa1\x6b\x66\x8a\xa6\xb0\x15\xef\x9d\x7a\xf4\xc6\x91\xf6\x40\x3a\xe7\xd4\xd4\xaa\xa7\x21\xf9\xa5\xab\xf4\xd6\xb4\x1e\x97\x71\x45\xd1\xae\x74\x22\x35\x63\x86\x89\xd6\xa3\xcc\x32\x82\xfc\xd0\x04\x79\xa5\xa8\xaa\x91\x94\x53\xd6\xca\xec\xcb\x4f\xa7\x21\xb4\x01\x26\xaf\x6c\x17\x8d\x45\x06\xf6\xde\x96\x71\xef\x8c\x97\xd3\x8a\x20\xe7\x1c\x1a\x82\xe1\x0d\x15\x52\x57\xfb\xae\xea\x63\x8d\x83\x60\x46\xcc\xd5\x4b\xf9\x50\xfe\x40\xd1\xc3\x30\xea\xce\xab\xa1\x00\xff\xf6\xf8\x2a\x7d\xaf\x5d\x1a\x6a\x07\x53\xd0\x22\x9a\x23\xa4\x47\xad\x26\x86\x7c\xba\x8d\x53\x13\xbe\x32\x47\x50\x17\xed\x0c\x64\x68\x24\x5c\xd2\x84\xe6\xc1\xb2\xa4\x2d\xb1\xdc\x37\x12\x78\xaf\x5d\xea\xaa\x76\x0d\x84\x0e\x93\x32\x80\x75\x36\x5d\x0a\xc3\x70\x34\x54\x88\x18\x0a\x27\x0b\xa6\x44\xbd\xa5\xba\x11\x0e\x6c\xd5\x1e\x9a\x52\x2b\x0e\x90\x62\x6f\x28\x7a\xaf\x5d\x02\x2b\x8f\x1a\x9b\x11\xa7\xf8\xe4\xa5\x99\x7d\xd3\xef\x04\xb5\xd0\xbd\x38\x51\x57\x83\x3b\x34\x52\xf0\x2c\xb5\x7a\xcd\x66\xaa\x13\x50\x65\x8a\xdf\xe5\xbc\x55\x80\x86\x7b\xed\x52\x4b\x0a\x1e\x1e\xf3\x80\x48\x64\x62\x6b\x08\x49\x46\xa0\x47\xd7\x0a\x45\xdd\x9a\x2d\xd9\x4c\x34\x6c\x23\xac\x9d\xc1\x57\x97\xe7\xbf\x4a\x86\xb8\x7c\xbc\x33\xbf\xd7\x2e\x15\x01\xb4\x86\xc4\x23\x4c\xf9\xac\xa0\xd7\x78\x04\x23\x07\x62\x86\x55\xb0\x8e\xec\x7d\x92\x99\x2e\x0c\x96\x28\x33\x74\x2d\x06\x9e\x9c\xac\x51\xf4\x6f\xa9\x89\xf7\xda\xa5\xa1\x74\x96\xd1\xdc\x38\xb0\xfe\x92\x40\x49\x37\x21\x3c\x55\x33\xa8\xa2\xe6\x4d\x35\x31\x9c\x58\xe1\xec\x4a\x75\xe7\x80\x34\xa5\xba\x8c\x86\xba\xcd\xee\x6d\x38\x72\xb8\xd7\x2e\x01\xe4\xf1\x6b\xfd\xac\x1e\x5b\x9a\xbc\x55\x5b\x63\x65\xea\x4c\xfd\x65\x0c\x64\x50\x3d\xab\xf1\x87\x7a\x5d\x61\x9b\xe5\xeb\xf5\x5d\xb0\x50\xbd\xb4\xa4\xb7\x4b\xa7\x7b\xed\xd2\x72\x81\xc0\x2a\x8c\x50\x89\x4c\x22\xb8\xe7\xe8\x8a\x9a\xfb\xb3\xfb\x08\xec\x63\x19\x87\x86\x04\xb2\x48\xce\xa2\xa8\xe6\x1e\x85\x99\x62\x50\x5a\x9e\x3d\x1e\xca\xfd\x75\x29\x12\xb2\x21\xfe\xee\x68\x18\x03\x2a\x11\xf3\x04\x44\x36\x94\xd0\x74\x05\x97\x89\x30\xca\x04\x0c\x43\x45\x78\x09\x41\x9b\xbe\xab\x86\xed\xd4\xbb\xef\x6d\xc2\x7d\xf8\x51\xbb\xb4\x4b\xcd\x07\xd2\xa7\xc6\xfb\xb0\xd0\xb2\x7c\x55\x73\xb3\x06\xfd\xe8\xfa\x05\x10\x24\xa8\xf5\x88\x2e\x05\xd5\x3f\x46\x03\xb9\xd0\xf6\xc3\x8b\x42\x97\x47\xdb\xb8\xa3\x28\xea\x30\xc4\x88\x15\x86\xd0\x16\x47\xb0\xd9\x1c\x75\x18\x00\x22\x52\x59\x6e\xb2\x01\x25\xa9\x97\x75\xf5\x2e\x49\xdc\x2e\x35\x60\xd0\xdb\x24\x6a\xee\xad\x30\x24\xdc\x6b\x97\xca\xc4\x40\x37\xb2\x18\x01\xa2\x09\x1c\x84\xb8\x01\x2b\x06\x9a\xb6\x1a\x19\xa6\x96\x8c\xe3\x1a\x16\xf8\x3b\x52\x51\xe6\x4f\x6e\x10\xe5\xa4\x2e\x6c\xa9\x9f\x37\x12\x78\xaf\x5d\x82\x5e\x28\x3b\x33\xab\xb4\x2f\x1e\xbc\xa6\xcd\x52\x2b\x34\xb0\x9e\xb3\x72\x8c\xaa\xff\x4c\x35\x7d\xd3\x9a\x35\xbe\x70\x42\x34\xba\x5a\x26\x8e\xf5\xcd\x51\x7e\x5b\xc6\x15\x45\x11\xfb\x11\x5a\x11\xd1\x04\xa1\x82\x05\x90\x2e\x0d\xd8\x49\x07\x49\xd4\x40\x56\x37\x0d\x24\x47\x45\x4d\x16\xa8\xf6\x6c\xca\xd8\x2b\x40\x69\x52\xfe\x77\x7b\xdc\x8d\x7b\x37\x92\xa2\xc9\x42\x53\x23\xca\x27\xa0\x09\x30\x68\x9e\xbb\x41\x7b\x67\xd6\xac\x6a\xd7\x2b\x7e\x89\x2c\xe1\xb7\x8b\x6e\x4f\xf9\x99\xc6\xbb\xe3\x31\x5d\x83\xd6\xdf\x96\x71\x7f\xa3\xdf\x03\xdd\x76\x54\xb8\x6f\xb3\x27\x65\x8d\xa6\xc0\x27\x78\xa7\x9c\xe2\x52\xaa\xb4\xca\x37\xf2\xfd\xbb\xab\x4d\x1a\xa3\x0c\x01\xf2\x53\xb3\x1e\x1f\x87\xf2\x86\x7b\xed\x92\x6e\xe6\xd1\xea\x5f\xe5\x10\x7f\xad\xba\x8f\xa8\x77\x02\x60\x3d\x88\xcb\xba\x21\x76\x1a\x33\xd4\x62\x9e\x00\xbe\xde\xcd\xd5\xd2\x29\x4c\x34\x5c\x25\xc0\x3c\x1e\x4a\xf9\xd1\xb7\x07\x68\x0e\xa2\x52\xb9\x8f\x86\x26\x6b\x2e\x75\x22\x3f\x28\x55\x0a\xbf\x99\x20\x7b\x10\x64\xaf\xfe\x34\xd0\xd2\x73\x34\xb4\x1a\x45\x81\x80\xf0\xe7\xad\xd1\x56\xb8\xd7\x2e\x7d\xad\x71\x08\xa0\x0b\xc0\x38\x63\x2c\x0d\xf2\x41\x35\x8b\xee\xa2\xca\xba\xf8\x4f\xc2\x7e\x06\x47\x86\xdc\x75\xc7\x5c\x72\xbd\xe0\xdc\x6a\x47\x60\x8f\x09\x57\x3f\x6a\x97\xf6\x82\xf9\x28\x6f\x44\xaf\x9b\x1a\xe8\xa9\x37\x9b\x3e\x94\xab\x80\x80\xc7\x04\xf0\x9d\xad\x1b\xc0\x5d\xd6\x8e\x1a\x17\xec\x74\xcd\x6f\xc7\xaf\xbd\xe3\x1b\x09\xec\x3f\x2a\x40\x01\x4e\x1f\xa0\xc5\xea\xfa\x95\xcb\x82\x76\x15\x5f\xdc\xf8\x1e\xec\x95\xac\x99\x75\x49\xda\x17\xd2\x32\xf8\xba\x01\xfd\xe5\x13\xfc\xf9\x0c\x75\x05\x7e\x02\xf3\x78\xaf\x5d\xc2\x20\xf8\xd0\x68\x4a\xbc\xe4\x54\xd0\xcd\x83\xaf\x8f\x68\x77\x55\x4f\x2b\xbb\x46\x11\xf7\xd3\xeb\xfa\x86\xc0\x42\x16\x71\x62\xab\x65\xa3\xa9\x61\x22\xe3\x6d\x19\xf7\xfe\xa2\xde\x39\xf5\xb0\x38\x51\xbd\x78\xf0\x50\x69\x69\x40\xa2\x27\xa8\xb0\xae\x26\xf1\x95\xbd\xfa\x1a\x31\xa1\x4a\x7c\x44\x42\x96\x9c\x62\xb3\xda\x4c\x9a\xff\x6d\x19\xe1\xde\xd8\x13\x8b\xdf\x08\xc7\xdc\x9d\x2a\xa8\x46\xf6\x7b\xd9\xf8\x12\x13\xfb\x86\x13\x8d\x2f\x49\x51\x9d\x91\x53\x21\x1a\xc3\x86\xc3\x6c\x4d\x7d\x6c\x3c\x16\x93\xde\x96\xf1\x23\xd3\x89\x98\x46\x24\xe7\xbb\xe1\x92\x0d\xf3\x20\xc0\x54\xf3\x2d\x6b\x42\x9f\xf1\x63\x70\x0e\xd1\x5f\xc4\xdd\x47\xf5\xa2\x00\x60\xb8\xaf\xe2\xc5\x3b\xd8\xdb\x32\xee\xb5\x4b\x01\x9d\x4a\x44\x01\x12\xbd\x2d\x90\x60\x8e\x6c\x5e\x4d\xbd\x1c\x7b\xe0\x7d\xf5\x1a\x5f\xe8\xd4\x66\xca\x79\xec\x27\xab\xc3\x54\x56\xa3\xe4\x8c\x41\xbd\xcd\x5d\x8a\xf7\xda\xa5\xa8\xe6\x1a\x9a\x93\x16\xa1\x5a\x80\x7e\x08\xae\xab\x4c\xdb\x10\x67\x9c\x48\x86\x10\x25\xb8\xd0\x64\x51\x92\x6a\x69\x13\x70\xf6\xa9\xd0\x9f\x54\x5d\x78\xbb\x17\x8d\xf7\xda\x25\x80\xbc\x47\x5b\x1a\x2e\x04\x8c\xe3\xa8\x2a\x37\x38\xe7\x24\xf5\x77\x9a\x2a\x60\x9b\x35\xc1\x00\xf2\xdc\x5f\xa5\xe1\xe6\xa8\x20\xa2\x70\x83\xb4\x39\xbc\xf0\xb6\x8c\x3b\x8a\x76\x25\xf3\x37\x35\x78\xfb\xf0\x9b\x83\x50\x0d\xbf\x72\x85\x0f\xaa\xa5\x34\x51\x2e\xcd\x8d\x38\x7a\x86\x52\x6d\x2c\xa7\x55\xbc\x06\xfc\x09\x60\xcb\xdb\x32\xee\x3d\x9d\x32\xb0\x18\xd6\x6a\xd8\xa1\xf7\xea\x69\xa1\x86\xfa\x0e\x65\x0f\xdf\xe9\x2b\xea\x39\x3e\xc5\x6a\x6a\x29\x39\x50\x4b\x50\x13\xf5\x70\x4c\xed\x58\x85\x0d\x3d\xe2\xc6\xbd\x1b\x09\xa0\xdc\x39\x6e\x64\x40\x55\x47\x68\x4f\xa0\xed\x61\x61\x03\xaa\xac\x57\x46\xaf\x86\x13\x9f\xd1\x00\xb4\x61\x4a\x32\x51\x33\x2c\x94\x2c\x78\x8e\x8e\x7a\xba\xdf\x88\xf7\xda\x25\x55\x37\x84\x83\x5e\xc4\x2c\x4b\x1e\xea\xbf\x46\x5c\x19\x7d\x1f\x3d\xd3\x77\x65\x14\x19\xba\x1a\xb1\x4f\xf0\x1b\x28\x5d\x8c\x22\x86\x35\x47\x51\x2b\x8a\x91\xdf\x96\x71\xaf\x5d\x2a\x3b\x23\x60\xd5\x34\x1b\x5b\xcd\x2a\xde\x9a\x1c\x04\x02\x31\xe9\x9e\xfe\x38\x94\xb5\x46\xcd\x48\x4b\xa5\xe5\x16\x82\x5f\xe5\x7f\x41\x77\xe7\xd9\xca\x9b\x6d\xdc\x6b\x97\xf2\xd7\xe0\xbf\x6b\xa8\xa1\xd2\xba\xc5\x32\x63\x89\x8b\xd0\x6e\xa6\x6e\xaa\x10\xe2\xce\xca\xbe\xe9\x3a\xec\x91\xe7\xc3\xf3\x51\xb3\x40\x11\xa2\xc7\x11\xe7\xf1\x5e\xbb\x64\x27\x75\x74\x72\x5f\x7a\x1c\x57\x2c\x2b\xde\x05\x5b\x86\xc2\x0f\x62\xed\x7d\x29\x89\x60\xa9\x49\xdf\x50\x6b\x34\x08\xd0\xe0\x88\x42\x1c\x44\xfd\xf9\x76\x59\x1d\xef\xb5\x4b\xea\x18\xbe\x85\x97\x2d\x4c\x35\x39\x28\x88\x84\x82\x46\x83\x0b\x43\xcd\x21\x16\x58\x8a\x3a\xa0\xa8\x3b\x20\x11\x16\xa2\xde\x21\xc7\x0e\x4c\x0b\x03\x22\xd6\xdf\x96\x71\xe7\xa2\x01\x03\xc8\x01\x81\x2e\x35\xbb\xf2\x52\xd2\x8e\x75\xd5\x66\x24\x25\x84\xa9\x09\x84\x8a\xa9\x88\x73\xf0\x20\x45\x3b\x8c\x3a\xb1\x83\x6a\xf9\x98\xde\x6a\x10\xe2\xbd\x76\x29\xc1\x7b\x08\x21\xb0\xbf\xad\xa2\x4a\x15\xfd\x9a\x0b\xac\xc8\xf2\xfc\xe6\x2b\x75\xd8\xf8\x52\x9e\xaf\x3a\x7d\x9d\x34\x46\xd8\x33\xb7\x45\x94\x4f\xaa\x52\x7d\x5b\xc6\x15\x45\xc7\x37\xce\x70\x71\xd2\x2e\xec\xcd\xae\x67\x73\x9a\xa7\xbc\xff\x74\xa2\x45\xcf\x8e\xb6\x4f\x50\x38\x83\x84\x54\x8d\x28\x30\x75\x95\xa9\xca\x3e\xdf\x6e\xbd\x2d\xe3\xfe\x46\x5f\x13\x54\xab\x01\x0a\x9b\xb0\xce\xd7\x1e\xea\x36\xaa\x51\x15\xbb\x2f\x96\xf5\xb5\x20\xe9\x41\xe1\x7d\xb2\x3f\x1a\x20\xc1\xfe\x68\x04\xa4\xfa\x81\xae\x47\xdc\xb8\xf7\x17\x45\x19\xc4\xec\xd5\x47\x6c\xa9\x67\x81\x5f\x99\x6d\x20\xdc\x97\x6f\xd0\xa4\x43\x23\x2c\xf0\x34\x6b\x12\x26\xca\x1f\x32\x38\xe2\x9e\xb1\x00\x72\x28\xfc\xc7\x65\xdc\x6b\x97\xa2\x8a\x2c\xd5\xb5\xc9\x52\xcb\xca\xb5\x52\x63\x8d\x58\x21\x38\x50\xde\xaa\x09\x7e\x71\x2a\xa5\xd8\x9b\xfa\x8b\xee\x7c\x34\xe5\x39\xe5\xaa\x66\xac\xca\x0b\x7f\x5b\xc6\xfd\x5e\xb4\x15\x88\x56\x2e\x9e\x1f\x90\xca\x1b\x07\xd0\x45\x8a\x52\xfd\x61\x20\x66\xa1\x4c\x62\x2a\xa1\x8e\x7f\x5a\x85\x69\xcc\xb9\xca\xc1\x77\xd4\x1f\x18\x3a\xf2\xb6\x8c\x70\x1f\xcc\x1a\xd5\x46\x7c\xf4\xe5\x97\xaa\x61\x27\xcb\x5a\xbb\x76\x0d\xd7\xf3\xaa\xbf\x45\x90\x34\xcc\x22\xe0\x34\x9a\xd9\x56\xba\xda\x48\x2f\xd4\x3d\x58\x92\xde\xca\x64\xe2\xbd\x76\x09\x9c\x06\x3c\x37\x7a\x40\x2f\xf2\x04\xac\x16\xfa\xae\x2e\x6b\xf8\x7c\x46\xaa\x38\x29\x68\x97\xc5\x3b\x94\xba\xe1\xbb\x54\x26\x84\x51\x93\x90\xb2\x7f\x53\x6d\xf1\x5e\xbb\xa4\x1c\xd9\xfd\x5d\x0d\x23\x04\x72\x74\xea\x9b\x13\x0e\xb1\x5f\x99\xfe\x1d\x7c\x80\x67\xf0\xd3\x38\x08\x34\x28\x4b\xec\x54\x5d\xc1\xd4\xcc\xa7\x78\x55\x51\xbc\x2d\xe3\xfe\xba\xe4\x1a\x74\x9c\x80\xb1\x50\xcc\x07\x2d\xd2\xf9\xc2\x19\xf1\xae\x78\xea\x44\x8b\xd3\x0a\x35\x97\x98\xf2\x04\x3e\x82\x83\xbd\x6b\x16\x92\x2a\x28\xd0\x29\x8f\xbb\x71\xe7\xa2\x44\xf1\x5a\x89\x14\x04\x15\xbf\xd5\xbc\x2a\x17\xab\x30\x4f\xa5\x7d\x03\xa9\xc2\x71\xbe\x7d\xd1\xe3\x8a\xa9\x9f\xd4\xfa\x32\x39\x23\x04\x9d\x65\xbc\x5d\xb3\xc4\xf0\xe3\x8d\x3e\xe0\x24\xc1\xab\x43\x12\xa1\x7e\xe6\x8c\x88\x3b\x0a\xf0\xac\xe2\xe8\xe9\x39\x47\x4e\x06\x95\x34\x34\x30\x41\x3d\x92\xbb\x13\xf5\xc9\x1a\xbf\x79\x1e\x97\x71\xe7\xa2\x1a\x23\xb0\xe6\x02\x25\x1c\xf4\xb3\xed\x98\x42\x35\x98\xb9\x8a\xa5\xca\x37\x57\x6f\x67\xe5\x05\xa8\x2d\xb2\xa1\xf0\xdd\xd4\x95\xb5\xe6\xc3\x43\x10\xde\xda\xaa\xc7\x7b\xed\x92\xd8\x43\xeb\x20\x51\x99\x43\x93\x83\x61\x48\x60\xa4\x6e\x7a\x50\x68\x2a\x65\x5b\x2e\xb4\xa6\xd1\x69\x86\x6c\xc1\x7b\x54\x76\x46\x0c\x86\xb6\xe7\x04\x9a\x3f\x2d\xe3\x5e\xbb\xc4\xdf\x0b\x32\x9d\x7e\x94\x61\x7d\x4a\x09\xda\x95\x3f\x73\x22\xd4\x00\x43\x55\x9f\x4e\x8d\x6f\x5b\xae\x1a\xf9\x23\x09\xa7\x11\x05\x5b\x4d\x3f\xf7\x78\xab\x95\x8e\xf7\xda\x25\x4d\xa2\x9b\xc4\xcd\x03\xb5\x32\x65\x01\x28\x9b\x99\x38\x86\x4f\x48\xcf\xa9\x47\x9e\xf2\xed\xe1\x17\xc1\xeb\x8a\x21\x24\x0d\x87\x08\xea\xaa\x54\xcb\x7c\xeb\x62\x14\xef\xb5\x4b\x93\x53\xf6\x43\xc3\x64\x34\x94\xc2\xf3\x39\x5e\xd3\xfc\xd6\xd7\xb8\x66\xec\x99\xd4\x5a\xa9\x2a\x0b\x3f\x40\x15\xeb\x56\xde\x82\x5b\x04\x17\xd6\x58\xea\xdb\x18\x97\x78\xaf\x5d\x9a\xf5\xd4\xad\xec\xd4\x56\x56\x77\x80\x83\xca\x33\x0c\xc7\x4d\x4e\xc9\xfe\x4d\x1d\x42\xf0\x9e\xb1\x51\x97\x45\x18\x22\x78\xd5\x60\x2a\x4d\xa9\xb4\xb7\x49\x43\xf1\x5e\xbb\xc4\x21\x2c\xaf\x1a\x2d\xb5\xaf\xd4\x14\x8e\x18\xcf\x57\x11\x42\x4c\x45\xaf\xc6\xb4\x85\xe8\xea\xc8\xdf\xb0\x88\x21\x1e\x62\xa6\xe4\x8e\xe4\x13\xcb\x78\xb4\x8d\x2b\x8a\x7e\x65\xbf\x31\x7c\xe3\xb8\x76\x3f\xea\x9b\x5d\x84\x9f\xa3\x82\x63\x49\xef\x58\x9a\xcf\x95\xf9\x17\x0d\x6a\x53\x9f\xef\xe6\x5a\x99\x1c\xc8\xe1\xf7\xc3\x1b\x25\xfe\x31\x77\x49\xd2\x2c\xcf\xaa\xa8\x5a\x63\x3d\xc7\x45\x00\x41\xb3\x06\xac\xfa\x53\x66\x75\xf5\x18\x46\xb3\x22\x18\x9b\x72\x59\x47\xcf\xf7\x47\xc3\x1e\x43\xc9\xaf\xb6\x71\x7f\xa3\x3f\x15\xdb\x73\x7c\xa6\x32\x77\x9b\x25\xfb\xde\xb9\xd4\xa7\xa8\xec\x38\x41\x35\xb6\x5e\xbd\x01\xbf\x79\x4c\x55\x59\x16\x70\xd6\xa2\x27\x97\x14\xcf\xab\xc3\xde\x2b\x40\xd5\xbb\x3b\x98\x52\x0d\xbf\xb9\x13\xd0\x74\xc2\x9b\x55\x4d\x14\x04\xc0\x6c\x28\x15\x2b\xe8\x0e\x74\x75\xb5\x50\x2a\x27\xb5\x54\x34\xe6\xb8\x7f\x38\xf6\xb6\x8c\x7b\x1d\x3d\xc1\x6b\x7a\xdd\xb5\x76\xe8\x5e\x54\x39\x6c\x72\xbb\x7a\x4d\xb4\x31\xf5\x55\xcd\x9a\x58\xe1\xe1\xa7\xa6\xce\xd1\xca\xf0\xa9\x47\xca\x65\xcc\xa9\xfe\x85\x4f\xcb\xb8\xd7\x2e\x95\xaf\xf1\x30\x9f\x3e\xbf\x49\x07\x04\x0e\x48\x32\x44\x42\x1d\xbd\xd4\xb0\xa5\xf9\x2e\xad\xd8\x88\x7d\x6a\x26\x80\x94\xc7\x57\x86\xc4\xad\x30\xee\xf1\x42\xf2\x5e\xbb\x84\xf3\x25\xa5\xd2\x03\x63\x45\x59\x4e\x2c\x40\x4d\xb4\x95\x67\xf7\xa7\xf4\x50\xf3\x25\x35\x0a\x5e\x89\x6f\xf8\xa8\x0a\xdb\xbb\x47\x57\xc5\x05\x8c\xbe\x15\x86\xc4\xf4\xa3\xbf\xa8\xa9\x6b\x28\xe4\x4a\x15\x7c\x1b\x91\x42\x48\xef\x1a\xce\x86\x66\x6e\x9a\x6f\x39\xa6\x12\xbc\x97\x66\x5f\x36\x87\x7e\xd2\xcc\xa3\x5a\xf4\x0c\xd8\xdd\x63\x68\xfb\x31\x77\x49\xe5\xbe\xec\x6f\x04\xa4\x94\x24\x39\xe0\x55\x28\xf9\x0a\x0d\x92\x52\x58\xa7\x7e\xf4\x70\x45\x3c\x74\xa1\xa1\xd9\x01\xd8\x89\x6b\xa8\xbd\x71\xe6\xeb\xa1\xdc\xeb\xe8\x9b\x3a\xf5\x18\x5b\x1c\x55\x11\xc3\x5e\x34\x98\x84\x52\xce\x01\x0f\x4d\x7b\x96\x1f\xab\x3b\x4d\x54\x37\x38\x7c\x43\x49\xf7\x67\xe0\x4f\x75\x28\x15\xfb\x6d\x19\x77\x14\xed\xcd\x39\x31\x2d\x24\xec\x38\xbb\x9a\xfa\x45\x21\x13\x96\xb1\x43\x98\x8d\x9a\x09\xf5\x82\x81\xba\x05\x5b\xed\xb1\x0b\x61\x22\xfa\x09\x04\xcb\x32\xfd\xa7\x65\x94\xfb\x85\xa4\xee\x45\xa3\x21\x8b\xd5\x20\xc7\x9f\x66\x5e\xc6\x00\x2d\x4d\xfc\xde\xf6\x30\x30\xcc\xb6\x7c\x33\x84\x91\x8d\xbb\x28\xa5\x45\x13\xbc\x1c\x01\x28\xbf\x91\xc0\x7b\xed\x12\xce\x00\x81\x71\x0d\x4d\x36\x07\x34\x66\xa9\x9d\x90\xea\xa3\x7a\x77\x9a\x03\x3a\xd5\xfa\xb7\x02\xf2\x50\xb2\x71\x3e\x88\xdb\x07\x22\xf6\x4d\x1d\x5a\x8f\x5c\xf4\x5e\xbb\x94\xd4\x1a\x29\x9a\x72\xa8\x9c\x57\x5e\xd1\x68\xc8\x27\x34\x62\x2c\xea\x5f\x3e\xbf\x99\x92\x20\x59\xd5\xac\x1b\xec\xc5\x5b\x89\x45\x17\x82\xb0\x52\xcc\xe8\x8d\xf6\xfc\x98\xbb\x54\xbf\x4e\xf2\xc0\x63\xd0\x34\xc3\x2f\x2d\xd6\xf8\xaa\xc4\xba\x29\x85\xb6\xbb\x7a\xd1\x01\xaf\xdf\x33\x8e\xca\xa6\x91\x06\xbb\x60\x3c\x50\x83\xfd\x28\xa5\xef\xb5\x4b\xe3\xcb\xa1\x69\xaa\x61\x5c\x43\x74\x03\xfd\xa8\xc6\xee\x41\xc3\xa8\x74\xb7\xa2\x66\xd5\xc7\x6b\x94\x72\x08\xa0\x45\x5d\xc5\x94\xd4\xd1\xa1\x82\xea\x03\xf5\xb6\x8c\xfb\xbd\x28\xd2\xa0\xab\x09\x4e\xcb\xb8\x48\x51\xce\x66\x39\x9a\x0c\x9f\x73\x87\x7c\xe8\xe1\x8b\x38\xb2\xb7\x9a\x58\xba\xd5\x88\x2d\x05\x95\xa2\x49\xb1\x0a\xb6\x8f\xb7\x3d\xf7\xda\xa5\x36\xbd\xcf\xd8\x41\x98\x6a\xcd\xa7\xd7\xad\xa2\x69\x0c\xd5\x30\x58\x31\x43\xc2\xaf\xab\x6a\x49\x91\xa0\x87\x01\x18\x27\xcc\x42\xd2\x0e\xf0\xb1\x5a\x7d\xeb\xbf\x11\xf3\xaf\x5e\xf7\x2a\x0c\x0e\x5b\xbd\x24\x41\xcb\x93\x77\x1d\xd9\x83\xd7\x43\xaf\x8d\x9a\xbe\xea\x5d\x45\xba\x23\xe0\x3b\x38\x3b\xe0\x5e\x2a\x5b\x6e\xbd\x9f\xd8\xc2\xdb\x4d\xe0\xbd\x76\xc9\xc1\xfb\xbe\x06\x73\x21\xce\xaf\x02\xb4\x8f\xe0\x20\x8d\x69\xaa\xcd\x41\x3e\xea\xf1\x0e\xc2\xaa\x7d\x34\xc7\xb3\x09\xba\x6b\x1e\x10\x54\x85\x80\x25\x3d\x52\xe2\x7b\xed\x92\xa0\x33\xaf\x74\x7a\xb5\xaf\x2c\x7c\x6d\x42\x48\x88\xea\xe6\x3e\xdc\x2a\x73\x7e\x45\xb1\xbb\x6d\x25\x3c\xa9\x3a\x41\x5d\x38\x50\x91\x21\x0f\xd5\x9c\xbf\x91\xc0\x7b\xed\x12\x88\x60\xe0\xd4\x26\x74\xa3\x52\x6a\x81\x8e\xcf\x8c\x94\x15\x13\x1b\xc6\x16\xf5\xa5\x13\xa8\x4b\xc3\x52\xf9\x7b\x0e\x72\x4a\x6f\x4e\xe8\x4d\x55\xf5\xd7\xb7\x65\xdc\xef\x45\x4d\xf2\x3d\xee\xa4\x81\x8e\xca\x6f\xae\xb0\x19\xec\x2f\x94\x41\x58\x1d\x2c\xae\xb3\x3d\xc0\x7a\x53\x2f\xfe\xe4\x90\xaf\x65\x2f\xc2\xce\xc2\x56\x67\x78\x7b\xd6\xb9\xd7\x2e\x21\x95\xa6\xda\x15\xb0\x17\xcb\x06\x98\xa0\xdb\xc6\xa3\x39\x3e\xa3\xe6\xd1\x33\x18\xb5\xa3\x02\x9e\x2b\x82\x13\x6b\x38\xd3\x6a\x66\x51\x99\xc5\xc1\x3f\x2e\xe3\x5e\xbb\x04\x9f\x54\xdb\x41\x4d\xdf\x88\xba\xcd\x31\x36\x66\x4d\xa7\xe1\x4f\xbe\xa0\x01\x34\x38\x58\x3d\x73\x50\x33\xfc\xab\xea\x23\xa5\x76\x21\xc6\xea\x00\x66\x6f\x31\xe5\x47\xed\x12\xe0\x54\x60\x16\xc1\xa3\xe1\xd5\x0d\xdb\x56\x9a\x9a\x60\x07\x2f\xf7\xba\xf7\xe9\x7a\x51\x48\xc4\x35\xf4\x12\x74\x54\x63\xad\x3d\xee\x6d\xa3\x17\xcc\xe3\xcd\x36\xee\xb5\x4b\x51\xf3\xc6\x35\xe4\xdb\x52\x75\x1d\xf8\xde\x6b\x49\x1f\x60\x19\xab\xca\x61\x72\xd6\x23\xa3\x83\x7b\x6e\x25\x46\xea\xf9\x5a\x39\x15\x88\xef\x1d\xe3\x5b\x3f\xf3\x78\xaf\x5d\x82\x0e\x27\x15\x0d\x9e\x74\x20\x97\x91\x1f\xd5\x15\x6f\xb9\x1d\x1b\xea\x29\x7a\xcd\x78\x54\xcf\xa7\x08\x5c\x41\x38\x58\xc8\xd7\xfa\x3c\xaa\x9c\x27\xdb\x5b\xa6\x53\xbc\xd7\x2e\xa5\x91\x0b\xea\x99\x58\xa6\x71\x69\x6a\x47\x4d\xfc\x56\x66\x59\xea\xea\xb4\xa1\xce\xc4\x73\x7d\xb9\xce\x9a\x56\x91\xe7\xae\x3b\x1b\xcb\xd0\xdb\x35\x1a\xff\xed\x71\xbc\xfc\x50\xf4\x6a\xa1\xea\x63\x4d\x1b\x18\x57\x02\x87\xae\xb8\xd6\x37\xe2\x0f\x32\x3e\x77\x5c\xa3\x13\x76\x5c\xe8\x03\x31\x55\xa6\x0a\x8c\xc4\x59\x35\x95\xdd\xf7\x37\x4f\xb9\xd7\x2e\x6d\x28\xbf\xd2\x96\x44\x31\x83\x0b\x6a\x24\xea\x1a\xba\x5d\x3d\x2e\x10\xf6\x8d\x38\x52\xe1\xab\xa3\xd5\x25\x71\xa2\xb7\x36\x98\x6d\xfb\x2a\x35\xc7\x63\xbb\xc2\x78\xaf\x5d\xea\x09\xef\xcf\xe8\x54\x82\xfc\xd7\x67\x75\x4f\xaf\x01\xd2\x86\x66\xac\xc5\x35\x6f\xa1\x8b\x18\x6a\x86\xca\x86\x97\x84\xa8\x26\x04\x6a\xde\xa3\x94\xde\xfd\xe8\xb0\xf7\x7b\xd1\xa3\xc4\x2a\x0d\xaa\x99\x67\xe0\xb7\x08\x58\x62\xee\xb6\xa6\x11\xf0\x05\x03\x4d\xb8\xeb\xe8\x3d\x4c\xcd\x9b\xd1\x03\xb6\x2e\x8b\xb3\xcc\x87\x03\x7b\x8c\x29\xf7\xda\xa5\x00\xab\xc4\xdc\x92\xd3\xd5\x2c\x0a\x3e\xc6\x7d\x9a\x1a\x05\x66\xf5\xed\xf3\xbb\xab\x3c\x9b\x18\xe3\x55\x5f\x0e\xf9\x01\x62\x95\x4e\xa2\xd1\x29\x25\xcf\xf3\x68\xa2\xf7\x7b\xd1\x92\x6c\xa0\xd1\xe1\x58\xca\x50\x86\x5e\x96\xac\x87\xb5\x22\x99\x06\xf9\xa9\x01\x7d\xdb\xf5\x58\x00\x70\x62\xae\xc7\x37\xa5\x5c\xc5\xa5\xf7\x16\xff\x96\xfc\x1f\xef\xb5\x4b\xb0\x5e\x03\x96\x08\xa9\x4b\xad\x55\xd5\x6b\x43\xed\x8f\xc5\xf9\x50\x26\x99\xef\x3d\xe0\xe7\x43\xdb\x12\x97\xd6\x84\x8e\xcb\xea\xff\x94\x5b\xb1\xf0\x36\xde\x3a\xde\x6b\x97\xd0\x84\x1a\xb0\xe9\xea\x51\x36\x2f\x1a\x79\x6a\x4a\x2b\x5f\xbb\x54\x18\x20\x10\xef\xf5\x10\xab\x9c\x11\x30\x1c\x0e\x0f\xc2\xa8\x15\xd9\x50\x54\x49\x18\xd3\xdb\x32\xc2\xbd\x06\x01\x43\xe3\x2f\x6e\x29\x6b\x3a\x59\x84\x7c\x38\xe2\xf9\x44\xb2\x25\x25\x80\xc1\x98\xd5\x8e\x24\x87\xf4\x95\xef\x12\x54\x46\x52\xf7\x0d\xf6\x88\x10\xf3\xd6\xf9\x3f\xde\x6b\x97\x34\x71\x9c\x80\x36\x14\xb9\xa7\x5b\x99\x20\x7e\x0e\x31\x25\x63\xa2\x2a\x1d\x6a\xf8\x91\x57\xa2\xd7\xec\x67\x26\xb5\xf6\x29\xa3\xa4\xc8\xb1\x15\x8e\xfa\xf1\xe5\xf1\x5e\xbb\xb4\xfa\x0e\x8d\x80\xa9\x7a\xa8\x5e\xe0\x1b\xb0\xd0\xa4\x8c\xea\xf3\xcd\xb3\xd6\x8c\xa1\xbd\xd5\xe4\xa8\x85\xae\x96\x13\xe8\xe9\x09\x47\x76\x3b\xab\x99\x4d\x79\xdc\x8d\x7b\x05\xe8\xf0\x47\x17\x80\xec\x48\x4b\xc4\x09\xa1\xe7\x69\x62\xc6\x1a\xa6\xc2\xe9\xa7\x11\xbf\x5a\x89\x0e\xe9\x69\x13\x64\xaf\x55\xad\x57\x31\xe5\xae\x01\xa1\x6f\xcb\xb8\xbf\xd1\x2f\x95\x54\x46\x10\x60\xe8\x1a\xb0\xab\x33\x35\x31\x55\x03\xea\x9a\x7c\xa4\xa9\x94\x28\x46\x25\x6f\x04\x5d\x8c\x76\x90\xd5\x8e\x77\xea\xf8\xa9\x7a\xa3\xb7\x65\xdc\x15\xfd\x0c\x0b\x82\xc5\xd6\x07\x08\xc6\x52\xc2\x97\x06\xda\x40\x34\x3c\xd2\x7e\x47\xaf\x82\x94\x32\x11\xfd\xeb\xa8\xf3\x2e\x02\x4f\x89\x79\xea\x19\x7d\xb0\x9f\x37\xbe\x71\xaf\x5d\x62\x67\x9d\x69\x2a\x89\x4d\xdf\x35\x33\x70\x15\x15\xab\x64\x0d\x85\x55\xc9\x81\x68\x72\x1a\x2e\x14\xcd\x0c\x03\x39\x1a\x6e\x64\xba\xae\x05\xd3\x21\xcf\x8f\x87\x72\xef\x46\xa2\xb9\xda\x46\x30\xd3\x44\x0a\x78\x0d\x2c\x50\xf7\x9d\x91\xa0\xa6\xe9\x4e\x29\x0e\x35\xd3\x86\x81\x0d\x24\xda\xd1\x7c\x97\x10\x96\x12\x8d\xad\x6b\x67\xde\x50\xf4\x5e\xbb\xf4\x4d\x27\xeb\x6e\x8a\xf7\xa1\xd3\x0e\x1f\x6b\x25\x2a\xee\x12\xbe\xf6\x44\xb4\x68\x56\x5d\x9e\xaa\x57\x29\xa3\x4e\x3f\x95\x36\x3a\x74\xdf\x73\xfa\xeb\xa5\xd3\xbd\x76\x09\x47\x38\xbb\x82\xca\x4b\x17\x8e\x10\x73\xb8\x4d\x60\x7b\xf6\xae\x22\x5b\x75\x3a\xd1\x65\x48\x87\xf7\x6a\xfd\x3f\x8e\x2a\x46\xcc\xb7\xf4\x15\x91\xbf\xf5\x58\x88\xf7\xda\xa5\x29\x17\xc5\x38\xf8\x2b\x55\x3d\x17\xd5\x83\x24\xe0\x2e\xc9\xfc\x42\x32\xa9\xa6\x0e\xd7\x39\xd1\xba\x1a\x80\x69\xe8\x72\x48\x6c\x20\xbc\x1c\x3f\xb2\xb7\x51\xa4\xf1\x5e\xbb\xf4\x67\x2c\x30\xc0\x08\xc3\x70\x6a\xe5\x8f\x72\x5e\x4b\x3a\x92\xf0\xda\xe1\x3e\x1b\x47\x51\x67\x27\x15\x65\x98\xe6\x38\x6b\x16\xd1\x04\x44\xb7\x53\x9d\xee\xdb\x32\xd2\xfd\x50\x86\x1a\xfd\x1f\x04\xfa\x50\x76\x1d\x96\x97\x91\x28\xa1\x29\x0b\x4f\xdd\xbe\x30\x80\xa4\x6e\xee\x55\x6d\x8d\x4a\xdb\xba\xe6\x49\x88\x4d\x34\xca\xc8\xf1\x8d\x8b\xde\x6b\x97\x54\x9f\x95\xa7\x1a\xd3\x2d\x75\x53\xad\xed\x68\x9a\x4e\x1d\xbe\x63\xb8\x71\x14\x98\xcf\xc8\xf8\xd0\xec\xc8\xb5\x3f\xf3\xc9\xa1\xf0\xe6\x08\xbc\x1b\xe1\xf6\xf6\x46\x7f\xaf\x5d\x22\xa8\x21\xd2\xf8\x1b\x27\x66\x79\x0a\xae\x50\x1a\x42\x2d\x3b\x42\x4a\x8d\x33\x6b\xe4\x74\x21\xd2\xa3\x24\x75\x83\xec\xfd\xde\xa6\x34\xc2\xbc\x35\x9d\xfd\x6d\xdc\x64\xbc\xd7\x2e\x11\xbb\x0b\xf8\x69\xa7\xc4\xa9\x07\xb5\xc6\xb2\x62\x5b\x7b\x16\x8d\xcd\x75\x5f\xd7\x66\xfc\x19\x60\x63\xb7\x9a\x57\xde\xd9\x37\xfd\xc9\xd4\x9d\x23\x3e\x5e\x2c\xdc\x6b\x97\x3c\x21\x5d\x4d\x22\xf7\xee\x28\x37\xcd\xda\x54\x39\xff\x2a\xab\x1e\xd6\x54\xe6\x89\x5e\xe5\x1a\xb3\x6e\xfe\x1f\x12\x45\x4d\x75\x74\x73\xa8\x69\x21\xa1\x3e\xde\x99\xdf\x6b\x97\x36\x31\xb4\xab\xfd\xee\xe9\xa7\x41\x27\x4c\x33\x6b\xf3\x57\xf4\x11\x11\x87\xb3\x78\x07\x62\xc2\x3c\x55\xb0\x8c\x2d\x04\x38\x19\xe6\x52\xd5\xc6\x6f\xcd\xb7\x0a\xd0\x78\xaf\x5d\x02\x96\x61\x98\x0e\xa4\x88\x61\xa9\x53\x36\x02\xb5\xac\x95\x0a\x4e\x43\x1c\x69\xca\xd5\xf8\x5e\x24\x35\x35\x78\xf4\xa6\x6b\x41\x6f\xea\x68\x54\xc3\xb2\xc7\x2c\xb8\x1f\xb5\x4b\xa1\x8e\xb4\x0f\x0e\x39\x31\x39\xf0\x4b\xf7\x60\x1e\x42\xa3\x2e\xa3\x4b\xb3\x52\x5b\x53\x6f\x12\x77\x0c\x8d\xad\xd4\x44\xcb\x65\x6b\x3e\xbd\x15\xd5\x52\xbd\x2d\xe3\x7e\x2f\xda\xaa\x1e\xde\x81\x7b\xa7\x4e\x6f\xf9\xe4\x5d\xba\xfa\xe6\xe0\xa5\xa5\x2a\x23\x54\x63\xd1\x09\xc2\x70\x0d\x75\xf1\x0e\x7a\x5e\xc1\x6c\x4f\x52\xa1\xdb\x5b\x05\x68\xbc\xd7\x2e\x35\x35\x1e\xce\x67\x49\xad\x00\xa2\x38\xa8\xba\x9e\xb5\x14\xdd\x6a\x4a\x17\xcd\xbe\x9e\xb8\x03\x42\x06\x1f\xd5\x48\xf6\x06\x5a\x10\x74\xfb\x37\xd4\xf8\x31\x89\xb7\xff\xea\x75\xdf\x35\x6d\x3e\xaa\x14\xa1\x44\x8d\x9c\xcf\x6d\x1c\xb6\xbb\x7a\x7e\xce\x71\x06\xe7\x43\xc6\x36\x5a\x9f\x44\x96\xb5\x97\xfa\x8e\xda\xe9\x5b\xfd\xc7\xdf\x22\xec\xbd\x76\x89\x88\x6d\x4e\x73\x3e\x51\x88\x7f\xfa\xbb\x63\x19\x75\x4f\xd8\xe0\xf0\x21\xe0\x48\xd3\x37\x4d\x43\xd0\xc3\xca\x9f\x77\x58\x78\xf9\x19\x13\x1a\x00\x1f\x79\x5c\xc6\x8f\x5e\xf7\x43\x77\x07\xaa\xd6\x36\x25\x25\x14\xac\x33\xdb\xc2\x40\x8e\x5b\x43\x09\xfe\x79\xfb\xa9\x71\xf4\x63\xe1\xb2\x3b\x68\x80\x3d\x76\x04\x75\x14\x55\x7d\x63\x5f\xf7\xda\x25\x8d\xab\x41\x3a\x13\xcc\x13\x76\xd0\x7d\xd7\x7b\x97\xcc\x55\x32\x29\x17\x87\x4c\x89\x9a\xa3\xf2\xd5\xd0\x94\xad\xa4\xf3\xe8\x3d\x2e\x83\xc6\x45\xba\xbd\x85\xb6\x7b\xed\x52\xfc\x7a\xaa\x6e\xbd\x67\xaa\x79\xfc\x57\x35\xa7\x5b\x14\xa5\xf0\x3a\x07\x0b\x04\x41\x4b\x87\x7e\x40\x87\xd4\x68\x60\x4e\xe5\x63\x15\xe1\x07\xc2\xe2\xd5\x36\xee\xf7\xa2\x73\x69\x2a\xec\xd2\xdb\x8e\x12\xef\xba\x5e\x79\x10\xf7\xd3\xfb\xb5\x95\xfe\xaf\x9e\xf2\x25\x07\x82\x30\x1f\xec\x61\x3a\x89\xf3\x8b\xf8\xaa\xc6\xb4\xbf\x35\x7d\x4d\xee\x47\x7f\x51\x62\x7b\xae\xca\xa0\xc5\x73\x8f\x6e\xac\x0b\xcc\xfb\xeb\x74\x71\x94\x4a\x32\x25\xa1\xd4\x74\x77\x9e\x89\xcb\x94\xac\x3a\x1d\x15\x41\x54\x96\x3e\x1f\x97\xe1\x7f\xf4\x58\xd0\xf4\xb5\xf9\x49\x64\x40\xbc\xa9\x56\xe9\xe8\x7a\x07\x40\x87\xa2\x42\x72\xa2\x53\x12\xc9\x9a\xd1\x37\xd9\x70\xa9\x21\xdb\x76\xb8\x10\xec\x35\xbe\x2d\xe3\xde\x19\x0f\xaf\x0c\x1e\x5d\x30\x54\xc6\x9e\xd5\x03\xc5\x39\xac\x91\x35\x94\xe1\x80\xb3\x79\x36\x92\xa1\x9a\xee\x8c\xb1\x66\x4e\x29\x84\x53\x21\x3d\x80\x7a\x7b\x6b\xa6\x94\xee\xb5\x4b\x28\x54\xc0\x02\x4a\x3e\x9d\xe6\x40\x4c\x40\x41\xd3\x0e\x34\x93\x02\x57\xe8\xee\x48\xb9\x27\x44\xab\x11\x01\x53\xd4\x2d\xfe\x9f\xf1\x9f\x29\x58\x4e\xc7\xbf\x2d\xe3\x47\xaf\x7b\x24\x8a\x5a\xed\x86\xa3\xbe\x09\x71\x2e\x35\xab\xcc\x28\x47\xd0\xa9\xb2\x23\x65\x80\xa7\x1a\x19\x91\x77\x15\xe3\x22\x02\x62\x42\xd9\x3b\xe8\x46\x7e\x9b\x39\x9e\xee\xb5\x4b\xc1\xf4\xe8\x58\x91\xa5\x53\x4d\x82\x9a\xfa\x86\x87\x88\x2c\x80\x86\x42\x42\xbc\x64\x91\x95\x1c\xcf\x54\x35\x13\xe0\xde\x42\x1b\x40\x58\x57\x99\x5d\x4e\xeb\x6d\x19\xf7\xfe\xa2\xca\xd8\xc0\x0e\xbf\x6e\xaa\x03\xde\xbf\x72\x11\xfd\x50\xef\x68\xa5\x41\x36\xb5\xd3\x3a\x4a\x24\xd1\xa4\x82\x91\xbe\x63\x92\x5e\xe8\x44\x99\xb8\x1e\x0f\xe5\xce\x45\x95\x6c\x55\x4f\x53\x03\xd7\xac\x4c\x12\x03\x31\x6d\x35\x1f\x91\x90\xdd\xd0\x92\xb3\x20\xdc\x1b\xbb\x56\xa3\xd5\x72\x94\x52\x82\xa2\xe9\x45\x6e\x7b\xea\xdb\x32\xee\x93\x94\xf5\x49\xba\xe4\x28\xbd\x64\x6c\x48\xc5\x75\xe8\x90\x3a\x95\x86\xe9\x54\xce\x87\xef\x2c\x65\x71\x5a\x09\x9e\x8d\x42\xf4\x1e\x57\x31\x12\x0d\xdb\x79\x85\xaf\x7b\xd6\xbd\x86\x6c\xc5\x94\xd5\xb3\x5b\x73\x47\x8f\x9a\x5c\x98\x9a\x12\x96\x9a\xd5\x83\x45\xd7\x5f\x4b\x43\x3c\x76\x5e\x7d\x06\x55\x58\x45\x0d\x8f\x60\xef\x8a\xbd\x5d\x56\x27\xff\x83\x8b\x46\xb7\xcb\xe9\x2d\x69\xea\x53\x5d\xd3\xe7\xe8\xdd\x51\x40\xcb\x84\xb9\x93\x35\x17\xb6\x27\x89\xa8\x04\x5e\x6d\x7e\x58\x59\x35\xca\x6a\xc5\x00\xc4\xbd\x2d\xc3\xdf\x07\xb3\x6e\xc4\x92\x3a\xa6\xfb\x33\x9a\xc6\x5e\xf6\xa6\xd7\xbe\x83\x92\xef\x67\xba\x98\xd4\x0c\x76\x9d\xc0\x77\xf7\x20\x39\x3f\x37\x5d\xce\x41\xd6\x35\xf2\xb1\xbd\x2d\xe3\x8e\xa2\x70\xec\xa9\x04\x5d\xcd\xbd\x4c\x6d\xc4\xaa\xb9\x1d\xbb\x68\x76\xad\x8b\x18\xe5\x51\xab\xad\x11\xd4\x4b\xd4\x90\xb3\x4a\xb3\x55\x85\xdb\xcc\x1a\x9b\xfa\x18\x53\xee\xb5\x4b\x50\xef\xa9\x2e\x20\x3d\xcc\x7a\x86\xe6\xc6\x11\x3f\xbc\x69\xc8\xa5\xb2\x31\x5b\xd5\x77\x86\x0b\x00\x98\xa8\x46\x55\x77\xbb\xad\xe2\xf2\x36\xa1\x40\xd0\xa3\xb7\x65\xdc\x5f\x97\x54\xe2\xb0\xd3\x72\xa8\xfe\x31\x7a\xed\x56\xc4\x2a\xa0\x34\x73\x2b\x41\x42\xf7\xe4\xe3\xeb\x2e\xce\x22\x01\xb9\xac\x8b\x72\x02\xcb\x51\x43\xa5\xb4\xe7\xdb\x32\xee\xaf\x4b\x55\x5a\x14\x11\xa2\x52\x46\xa7\xab\x47\x07\x62\xaa\x3d\x8a\x2a\x57\xac\xea\x2a\x4e\x75\xb9\xe0\xfa\xde\xaa\x3a\xab\x03\x04\x21\xc8\x0e\x60\x63\xfb\x47\x4f\xb9\xbf\xd1\xe7\x51\x74\xa5\xa2\x1e\x0f\x2a\xdd\x5e\x79\x4d\x40\x02\x02\x64\x84\x53\x35\xd6\x82\x89\x78\xf5\xea\x05\x25\x66\x1b\xa5\x05\xb8\x10\xc1\x58\x5d\x2f\x67\x7e\x5c\xc6\x15\x45\x9d\x46\x66\xa3\xdf\x57\x22\xa6\x5b\x71\xaa\x5a\x8a\x59\x7d\xef\xbd\x46\xba\x28\xe1\x0d\xf0\x6a\x09\xac\x0d\x23\x12\x67\x54\x94\x5a\xbd\x9a\x23\xc3\x03\xf6\xa3\xa7\xdc\xb9\xe8\x44\x7b\x8c\x42\x2c\xc1\x6b\x81\xa3\xad\x99\x3f\x2d\xaa\xe6\x91\x90\xbb\xd5\x19\x6d\xa8\x8b\x3a\x9b\x96\xa1\x63\x31\xab\xf9\x47\x1e\xeb\x9b\x21\x3c\xf3\x78\x5b\xc6\xfd\x75\xc9\x15\x15\x55\xc6\x73\xb2\x4a\x6f\xf5\xbe\xe3\x33\xae\xa0\x24\x0e\xf5\x0b\x0c\x18\x04\x31\x64\x42\x85\xd3\x98\x12\x31\x70\x01\x02\x5e\x3e\x10\xe5\xf5\xd6\x22\x26\xdd\x6b\x97\xcc\x2f\xcd\x27\x9b\x7a\x67\x6d\xca\xe7\x9e\xdf\xa0\xeb\xa9\x5c\x0d\x55\x62\x74\xbf\x9b\xcb\xaa\xcb\xcd\x13\xb6\x25\x7a\x4c\xe0\x1f\x2a\xac\x66\x8f\x47\x78\x5b\xc6\x15\x45\xf3\xb6\xa4\x01\x9b\x80\x65\xeb\x55\x35\x63\xc9\x72\x50\xdb\xc2\xe8\x8f\x29\xc2\x7d\xe3\x53\x02\xb2\xd2\xf4\xd2\x06\x80\xa1\xa1\x34\x0a\x13\x33\x8a\x6f\x45\x43\xe9\x5e\xbb\x04\x79\x18\x5a\xc8\xd1\x7c\x9f\x09\x13\xf4\x0d\xea\x5d\xbb\x57\xf9\x4c\xd6\xfd\x23\x84\xbc\x69\x4f\x4a\x81\x81\xa9\x36\x92\x90\x8b\x5b\x8f\xa2\xf2\x14\xf7\xb6\x8c\xfb\x0c\x50\x82\x5a\x9e\x38\xe9\x46\x51\xfb\xb1\x23\x7f\xf5\x66\x63\x9c\x1a\xbd\x2d\xb0\x4a\x75\x9f\xea\x9e\xac\xca\xc8\xc9\x4a\x8a\x2e\x45\x35\x7c\x3d\x44\x15\xd2\xbc\x2d\xe3\xde\x5f\x54\x5d\xa5\xfc\x6a\x2e\x20\x92\x92\x6a\x2e\x08\xe3\xba\xd1\x00\x32\x4e\x2b\x59\xb3\x36\x11\x6f\x90\x1d\x8c\x62\xc0\x26\x31\x68\x16\xbb\x87\xc6\x43\xf6\xf3\x06\xe6\xf7\xda\x25\xbe\xf5\xc0\x41\xb7\x66\x2c\xb0\xfd\x2e\xc3\xaa\xa0\x3b\x93\x5d\x5a\x87\x40\x4a\x58\x55\x65\x9d\x57\xad\x90\x7a\x51\xb0\x17\x3d\xad\x88\xb7\x3a\x0f\xde\xbd\x71\xd1\x7b\xed\xd2\xae\x23\xee\x85\xe4\x6f\xb0\x41\x77\x96\xba\x5a\xae\x91\x3b\x78\xe6\x50\x90\x4d\x13\x20\x0d\x83\xd5\x98\x67\x54\x92\xd5\x18\x87\xe7\x57\xa2\xa9\x9c\xe7\xad\x91\x78\xba\xd7\x2e\xad\xb1\x6a\xdb\x55\xb9\xbc\x4a\xad\xd2\x85\x6c\x4d\xb9\xa3\x50\xd4\x74\xb6\x6b\xb4\x73\x0b\x35\xa5\x7a\xbe\x1a\x1d\x56\x03\x71\x8e\x10\x21\xa4\x23\xae\xf5\xc6\x45\xef\xb5\x4b\xc7\x0d\xcd\x19\xe2\x40\x42\xb0\xa9\xb7\x1a\x36\x64\xa9\x65\x22\xaa\x4c\x99\xbc\x7c\xa8\x6a\x41\xb0\x57\x4d\x63\xef\x09\x08\x77\x6a\x90\xc2\xba\x21\x22\x8f\xb8\xf1\x03\x45\xb3\x47\x29\x2f\x80\xa3\x65\xd8\x85\x2b\xcd\x29\x03\xdf\x6f\x02\x17\x5b\x12\xfa\xc9\x29\x21\xd9\x2a\xa1\x4d\x77\xd5\xd5\x59\x40\x42\x6b\x70\xef\x00\xff\x9f\x96\xf1\xa3\x76\x69\x0c\x0c\xd3\xea\xd6\x35\x30\xac\x2b\xe1\xa1\xe9\x8c\xaa\x94\x37\x74\x45\x26\x90\xe8\xa8\xda\x90\x94\x4e\x07\x52\xbe\x87\xe6\x35\xf4\xf3\x25\x6d\xbe\x1d\xca\xbd\x76\x69\xa9\x30\xb8\x10\x34\x60\x54\xb5\xaa\xa1\xac\x3e\x2a\x03\x9e\x44\xd7\xd0\x60\x3b\xcb\x77\xa5\x3b\xe7\xac\x06\xa8\xf3\xcc\xb4\x35\x03\x68\x38\x5d\x6d\xbb\x37\xb9\xf4\xa3\x76\x09\xea\x3d\x82\x5e\xfe\x41\xac\xa3\xe6\xa1\x6c\x83\xef\x71\x04\x55\x3e\xda\xea\xc1\x3a\xb6\xbb\x51\x2d\x6a\xae\xde\x88\x3c\x47\x65\xf7\xea\xc5\x36\x1e\x1b\x7b\xa6\x7b\xed\x12\x7c\x23\x43\x6d\x56\xdc\x61\xac\x84\x40\xd1\x00\x67\x4f\xb8\x00\xaf\x1c\xc2\xa4\x77\xcd\xef\xf5\x99\x10\xa8\x0c\xd2\xa6\xa9\x19\x9e\xf8\x86\xb2\x1b\xe5\xbc\x75\x23\x49\xf7\xda\xa5\xd8\x97\x23\xa4\x75\x18\xa7\x1a\x38\xf9\x39\x0f\x0e\x01\x09\xb3\x00\x98\x2a\xa5\xd6\x0e\xa7\xa0\x9b\x27\xb5\x1a\x3b\x3d\x17\xe5\x3f\xb9\xa6\x9f\xad\xb7\xb2\xdc\x74\xaf\x5d\x42\x85\x10\x44\xf4\xbc\xaa\x1b\x2f\x97\x30\x8f\xde\x60\x84\x86\x6a\x47\x4a\x6a\x34\x01\x9f\xad\xee\x9e\x13\xf5\x82\xe5\xd6\xbd\xad\x63\x46\x13\xf7\x8b\x8f\x28\x7a\xaf\x5d\x4a\x63\xbb\x92\xdd\x76\x79\x6d\x0f\xbf\x53\xce\x86\x46\xb2\x5b\x4f\xe6\x0d\x25\x32\x82\x1a\x33\x77\xdd\xcd\x7d\x23\xaf\xfb\x42\x45\x16\xf5\x43\x70\xb1\xbe\x15\x86\xa4\x7b\xed\x92\xae\x6f\x92\xe6\xc0\xb5\x24\x5b\x54\x0f\x2b\x69\x55\x95\x4d\x69\x24\x54\xc3\x3c\xc3\x81\x18\x26\x70\xb5\x1d\xcd\xb8\x3b\x4e\x63\xeb\x0c\x69\x1f\xcc\x3d\x2e\xe3\x9e\x75\x3f\xbd\x86\x37\xaa\xce\x56\x7d\x21\x3b\xc0\xa4\x6e\xf6\xc8\x85\x58\xfc\xf1\x61\xc0\x81\x1a\xb1\xa3\xc1\x03\x0d\x44\xf3\x9a\xee\xe2\xb2\xe6\xeb\xaa\x88\xfc\xed\x0a\xee\x5e\xbb\x54\xe2\x37\xc6\x30\xeb\xae\x3a\xc1\x31\x54\xe9\xa0\xda\x98\x75\xd4\x90\x37\x25\xe2\xd9\xd7\x1e\x2e\x65\x25\x4d\x8c\x8e\x96\x1a\x50\xdb\xa6\x5e\x6d\xc4\xf9\x37\x13\xbd\xd7\x2e\xc1\x29\x60\x7a\x5b\xfd\xc3\x51\xa4\xe9\xe0\x1d\x1a\x83\x9b\x95\x77\x5f\xe7\x54\x6f\x90\xad\x7a\x7e\xa5\xd9\xa8\xb6\xa8\x0c\x82\x8a\x26\xde\x29\xc5\xe1\x3c\x5e\x2c\xdc\x6b\x97\xbc\x53\x33\x81\xad\xd6\x38\x9a\x37\x94\xe1\x7b\x90\xc2\x59\xfb\xee\xea\xb5\x8e\x7c\x3c\x5b\x5d\xb5\xd5\x11\xc3\x6a\xd7\x1c\x3b\x67\x9a\x71\x57\x5d\x71\xe3\xad\xd1\x56\xba\xd7\x2e\x0d\x8d\xb8\x5f\xca\x3c\xd4\xfd\x05\x71\x8b\xe8\x51\xbc\x6e\x69\x5d\x52\x27\x51\xe5\xf9\x23\x56\x95\x75\xbf\x3b\x71\x15\x11\xb1\x90\x14\xfa\x0d\xd5\xd4\xbf\x99\xe8\xbd\x76\x49\x13\xfb\xf8\xc6\x51\xb3\x23\x63\xc1\x47\x0c\x8e\xa9\x46\x23\xd9\x7a\x00\xd6\xd6\x86\x85\x4e\x96\xea\xe3\xae\x06\xbe\x59\x23\xfa\xc3\x1b\xb7\x3a\xc4\xe6\x37\x13\xbd\xd7\x2e\xa1\x45\x93\x12\x89\xd4\x58\x22\xf4\xee\x11\x68\x84\x5c\xd5\x16\x9e\x29\x67\x20\xba\x43\x01\x34\x94\x34\x6b\x78\x86\x83\x86\xb4\x88\x35\xbb\x4a\xb4\x29\xee\xd1\x44\x7f\x4c\x0c\xd9\x04\x07\xb3\x02\x1c\x29\x7b\xdc\x27\x42\xad\xb5\xa8\x41\xd6\xc2\xb2\x90\x09\xb7\x1e\xcc\xc8\x04\x7c\x07\x4f\x53\x1b\x2c\xb5\x8f\x5b\xfc\x47\xaf\x17\x0b\x3f\xe6\x2e\xad\xc6\x06\x2f\x71\xaf\x9a\x54\xdb\x20\x22\xac\xf1\xe3\xbd\x07\x8d\x89\x3b\x48\xfe\xbd\xbb\xba\xd0\x9b\x66\x6b\x43\x0d\x95\x2b\xa7\xa7\xaf\xaa\x9b\xed\xb7\x65\xd4\x7b\x25\x17\xf8\x0c\x8a\x4e\x85\xcf\x25\x2d\xc0\xc7\xb9\x80\x65\x20\x1c\x3b\xa7\x0f\x33\x54\xba\x35\x52\x09\x93\xcc\xba\x13\x9c\x2a\xa0\xd2\x5d\x9d\xe6\x6e\xbf\x2d\xa3\xdd\xef\x37\x40\x66\xb7\x41\x03\x75\x7a\x6b\x44\xfb\xb9\x36\x71\x4d\x19\x36\x4d\x23\x7a\x55\x28\xae\x97\xd9\x3c\x53\xf3\xaa\xe9\xf2\xa7\x2f\xb6\xae\xb2\x4b\x2e\xbf\xa9\xb6\x1f\xb5\x4b\xdd\x0d\x65\xad\x86\x3a\x34\x40\xb2\x2a\xb3\x66\x28\x23\x62\xa8\x18\xd8\xcb\x4c\x8c\x8f\x5f\xcd\xe0\x45\xa8\xa9\x54\xe3\x50\x5f\x8e\xf8\x0d\x07\x7f\xdc\x8d\x7b\xed\xd2\x56\x16\xb5\x1a\x89\x0e\xcd\x11\x8c\x3b\x35\x3d\xe4\x69\x54\x05\x91\x4c\xcd\x36\xcb\xd7\x4c\x49\x25\x54\x12\xd4\x47\x7d\xff\x8f\x8a\x87\x07\x7f\x69\x7e\xd4\xb0\xf7\xda\xa5\xad\x82\x9c\xf2\x0d\xa7\xd0\x64\x1b\x8f\x5f\xfa\x5d\x0a\x08\x31\x34\x91\x6b\x76\x88\x50\xc1\x5b\xcb\x2c\x2d\x70\x1c\x15\x0f\x12\x59\x2f\x84\x5b\x25\x49\xbd\x2d\xe3\x8a\xa2\x47\x6d\xfc\x55\x26\x55\xbe\x29\xca\x15\xab\x18\x05\x7a\x55\x4c\xb3\x40\xf9\x20\x7c\x27\x58\xf4\x9a\x53\xe2\x58\x9e\x77\xb1\x47\x98\x73\x0c\x81\xff\xc6\xbd\xe9\x94\x7b\xed\x52\x75\x41\x23\xbe\x8e\x5e\xa2\x77\x1f\x1a\x8d\x0a\x8e\xc1\x44\x3f\x42\xba\x01\xb2\x25\x96\x3e\xa6\x9e\xbb\x42\xdf\x68\x0d\xf5\x44\x29\x23\x2e\x88\x52\x78\x5c\xc6\x8f\x89\x21\xea\x60\x81\xfc\x12\x78\xf5\xa4\xd4\x5c\x42\x9c\x66\x9d\xa7\xae\x76\x75\xba\x00\xc4\x16\x83\xd2\x9f\xe2\xd1\x9b\x8f\x7a\xef\xab\xa9\x23\x64\x2d\xbd\x15\x29\xa7\x7b\xed\x92\xba\x6b\x20\x09\x23\x71\x3d\x6b\xbe\x25\x5a\x19\x9e\xc7\x2f\xa1\x86\x36\xd1\xfe\xff\x66\x13\xa9\xef\x7a\x4b\xf3\xa8\x1f\x5e\x2d\x28\x38\xd5\xbd\x17\x4d\xef\x7e\x5a\xc6\x7d\xee\x52\xe6\xaf\x44\x8f\xe5\xa4\x94\x91\x0c\xb7\x5a\x67\x41\x50\xbd\xea\x4f\x0c\x89\xb4\xb0\x9a\x84\x5b\x7c\x4d\xe9\xd4\x2b\x0f\x08\x23\x96\x44\x60\x2c\xa4\xf2\x46\x89\xef\xb5\x4b\x1a\xfa\xa9\x4c\xcc\xb5\xa3\x21\x53\xda\x9e\x6a\x28\x86\x2a\x80\x5c\x10\xf6\x7d\x2b\x6a\x6d\x50\x3d\xd1\xb5\xea\x45\x49\xb3\x6c\xe2\xd0\x93\xbd\x9f\x7d\x3e\x86\xb6\x7b\xed\x92\x71\x16\x01\x2c\xaa\xd5\xad\x9a\x35\x52\x67\x0f\x9b\x53\x79\x7f\x53\xed\xf7\xf9\xd8\xe8\xa4\x0e\x7a\x89\xba\x97\x1c\xfc\x2f\x7e\x77\xc7\x6a\x66\x17\xdf\x42\xdb\xbd\x76\x09\xb7\xc4\x3b\x73\x97\x56\x4c\xdf\x88\xba\xb5\x9a\x26\x05\x0f\xd5\x1e\xba\x8d\xb0\x57\x52\xa6\x3a\x1c\xa8\xe2\x31\x0d\xaf\x56\xa0\x71\x6a\xea\x33\x01\xf6\x4d\x4a\xdf\x6b\x97\x2a\x72\x63\x95\xe3\xd4\x16\xb8\xe0\x1d\x53\xa4\x77\x73\x36\x33\x8e\x55\x1d\x60\x16\x95\xb5\xe1\x55\xbe\xbf\x90\x99\x78\x52\x58\xc9\x6b\xe3\x94\xc5\xf0\x66\xa2\xe5\x87\xa2\xcf\xdf\x3d\x3c\xf1\x13\xd9\x96\xc3\x50\xb8\x2d\xa1\xb0\xba\xa9\xce\x63\x4b\x83\xed\xa2\xd4\x1a\x91\x5d\x6f\x82\x88\x28\x48\xb9\xe2\x7e\x20\xb4\xbd\xa1\xe8\xbd\x76\x89\x80\x05\x7e\xc1\x2d\xf2\x74\x6a\x9a\xa4\xfc\xc4\x52\xa6\x06\x0b\x55\x8c\xe5\xf0\x13\xd8\x31\xc4\x14\xae\x91\xd9\x2a\x35\xde\x1f\x60\x3c\xfa\x0a\xfc\x7f\x2b\x85\x48\xf7\xda\xa5\xf9\x65\xb4\x27\xe4\x73\x0c\x2c\xc6\xcf\x88\xa8\x51\xfe\x7f\x9a\xa0\xba\x9a\x27\x79\x55\xc7\x7a\x77\x84\x61\x22\xd0\xd0\x7c\xdd\xb0\x74\x76\x2c\xbe\x75\xf0\x4e\xf7\xda\xa5\x85\x27\x7a\xdd\x50\xc3\xf9\xfd\x41\x2c\x3b\x55\xc4\xa8\x9d\x2b\xcc\x31\x7a\xf5\xf7\x3a\x8d\x75\x28\xd7\x3d\x07\xe2\xad\x69\xc2\x4f\xf3\xca\x98\x84\x1e\xbc\x09\x84\x7b\xed\x92\x86\x27\x43\xb0\x7b\x86\xe3\xa8\xe1\x06\x84\x6a\x94\x6f\x62\x32\xd1\x63\x9e\x9d\xa4\x57\x91\x51\x31\xab\x92\xaa\x36\xbd\x9f\x6b\xce\x5c\x1c\xee\xa8\x94\xf6\x6d\x19\xf7\x2e\xcd\xc5\xb5\xaa\xde\xb3\x6a\x0c\xa4\xe1\x4b\x21\x9d\x8e\xf3\xce\xce\x67\x77\x35\x0b\x54\x1e\x47\x91\xe4\x2f\x33\xaa\xbb\x63\x4f\x6b\xc5\xe4\x35\x86\xb0\xb8\x47\x87\xad\xf7\x7e\xe6\xa0\x28\xa4\x5f\x23\xc7\x55\x93\x8e\x4a\x48\x20\x09\x92\x52\xef\x69\x6b\x68\xae\x49\x43\x19\x28\x09\x6e\xa9\x8e\x7d\xe4\x15\x4b\x76\xca\xca\xaa\xe7\xf1\x8d\xfe\x5e\xbb\x74\x00\xe7\x1a\x4b\xff\x3a\x29\xe9\xa1\x0f\x2b\x70\x68\xe4\xe0\x51\x4a\xe6\xd8\x8e\x3e\xbd\xb1\x39\xea\xde\x9d\x11\xde\x95\xa3\x02\x4d\x51\x7a\x4b\x93\x73\xdf\x96\x71\xe7\xa2\x48\x36\xac\x6f\x3b\xe7\xf9\xe4\x10\xf4\xdc\x37\x2a\x41\x1e\x3b\x44\xae\x29\xe3\x69\x7f\x9f\x1c\x55\xdb\x35\xc1\xb8\xa0\x76\x75\xb6\x1a\xb2\x9b\xf0\xff\xb4\x8c\x7b\xed\x12\x71\x52\x73\xc1\x20\xc6\xc9\xe7\xa6\x2b\x50\x0f\xef\x3f\x33\x79\xc3\x38\x35\x76\xdd\xe9\xb9\xf8\x2b\xf3\x77\xac\x13\x45\x5d\x96\x08\x7b\x54\x20\xf4\x6f\x7c\xe3\x5e\xbb\x04\x13\xad\x65\x00\xe2\x51\x20\x1d\x08\xa7\x38\x87\xcb\x2a\x5d\xaf\xa3\x62\x0f\xa6\x79\xf5\x2a\x0a\x1d\xa5\x62\xa2\x65\x6d\x0d\x11\x83\x72\x74\x75\x1b\x7a\x43\xd1\x7b\xed\x52\xad\x15\xf9\x93\x90\x05\x47\x95\xb7\x3b\xd4\xad\x4a\x04\x9b\xb5\x36\x7f\xf4\x16\xa8\x56\xd1\x7a\x9e\xf5\xea\x3e\x5b\x7b\xeb\x3d\x2b\x21\x09\xac\x45\xc1\xbc\xc1\xd7\xbd\x76\x09\x69\xb6\x50\x89\x6b\x62\x8e\x08\x15\x4e\x07\x6c\x5a\x2e\x56\x8d\x19\x3f\xae\x41\xd0\x55\xe1\x35\x02\x26\x0a\x5a\x80\xef\x7a\x22\x5c\x04\x16\x3f\x96\xaf\x6f\x81\xbe\xfe\xc8\x17\x35\x78\x0c\x2b\x21\x9c\x67\x53\x3e\x2c\x11\xa6\xd7\xd2\x35\x3a\x64\x74\x1c\xb4\xc0\xfd\x16\x66\x8a\xd2\xaf\xe9\xa8\xb9\xb5\xaf\xd5\xfb\xaf\x99\xf3\x7a\xdc\x8d\xfc\x23\xa5\x19\x8d\xa2\x34\x40\x35\xe0\x50\xf5\x01\xbb\x0e\x19\x34\x33\x58\xa0\x57\x76\x39\x8c\x47\x2d\x2e\xda\xd7\x1d\x17\xcb\x45\x20\xd8\x52\x72\x5e\x78\x4c\xfe\x4f\xf7\xda\x25\x5d\xb5\xc6\x71\x08\xe4\x4e\x13\xc9\xfa\x0e\x02\x70\x2c\x90\xe0\xef\xa7\x6a\xda\x72\x56\xee\x30\xbc\xab\x9f\x6f\xa4\x9d\xc9\x4f\x4e\xeb\xb6\xd0\x73\x8f\xbb\x71\xaf\x5d\x42\xd1\x39\x56\xa2\x44\xaf\xe1\x1c\x82\x9d\xfd\x77\xa7\x01\x90\x20\x89\xae\x40\x5d\x4f\x29\xa9\xbc\x4b\x2f\x1a\xff\x5d\xd9\xb9\xe4\x08\x8e\x02\x41\x74\xdf\xa7\x31\x98\xef\x61\x66\x81\x01\xdf\xff\x08\xfd\x5e\xb5\x34\x3b\x97\xc4\xb6\xab\xa4\x72\x1b\xc8\x8c\xc0\x19\x11\xea\x94\x27\x3b\xb8\xd4\x06\x8e\xbe\x0e\xaf\xee\xeb\x2f\x89\x21\x62\x1d\xbf\xf1\xe6\x4a\x15\xa5\xc3\x46\x4f\xa8\x0e\xfb\x13\x6c\x53\x97\x25\xd4\x05\x28\x37\x90\x27\x7a\x13\x23\xcf\x74\xba\x64\xaf\xb8\xce\x20\xf1\xb7\x76\xe9\x06\xf2\xd5\x62\x2a\x47\x61\x4f\xd2\x44\xe7\xab\x17\xce\x4f\x22\xad\xb9\x1d\x8e\x1f\xea\x8b\xb2\xb4\x7a\x32\xca\xc3\xe0\x71\xc5\x5d\xb1\x66\xf5\x67\x47\x8f\xf1\x4b\xee\x52\x03\x64\xae\xb7\xb5\x66\xd4\xfc\x6b\x94\x35\xb5\x42\xf7\x9e\xfc\xdc\x85\x4e\x7f\x3f\x3b\x0e\x1d\xcd\xb7\x81\x94\x80\xae\xe5\x85\xcc\x02\x8e\x80\x1c\x0f\x19\xfd\xb7\x76\xe9\xed\x30\x13\x13\x41\x36\x67\xd3\x51\x92\x79\x7b\x5b\xfe\xce\x00\xfe\xa2\x87\x42\x04\x26\x0b\x17\xe1\x04\xe5\xc9\x00\x54\x33\xe1\xb5\x2d\xec\xda\x65\xac\xb3\x4b\xa7\x6f\xed\x52\xba\xdd\x8e\xb7\x03\x80\xfa\xdf\x85\x6b\x8d\xfd\x13\xa4\x18\xb4\x12\x2f\x3f\x74\x71\xc3\x1d\xcc\x8a\xb3\xc3\x4d\xde\x45\x32\xb6\x55\x2f\x84\x72\xe6\x46\x92\xbe\xb5\x4b\x5d\xf7\x28\xd3\x69\xd8\x0c\x11\x86\x68\x1e\x57\x56\x7c\x08\x06\xdc\xba\x66\x9b\x7e\xda\x29\x21\x3a\xe4\xbd\x23\x6c\x7e\x72\x45\xbf\xc5\xd1\x5f\x77\x3d\xdc\x1b\xe9\x9b\x2e\x65\x6a\xf3\xc8\x5e\xab\x45\x03\xb4\xcd\x1f\x37\x58\xef\x27\x1a\x2b\xcf\x38\x72\xd9\xaf\x77\xf5\x6b\xe4\xa4\xb3\xba\x5a\x33\x4e\xeb\xc3\x49\x9f\xfb\x70\x51\xbe\xa7\xee\x2f\x53\x0b\xe1\xca\xb1\xc1\xa3\x67\x7c\x0d\xeb\xa3\xa6\x8e\x65\xfc\x17\xcd\x0b\xfa\x00\x2e\x62\x05\x38\xb3\xd6\x92\x60\x08\x40\xdd\x8d\xda\x42\x83\x39\x7c\x8c\xef\xaf\x4b\x0f\x38\x5c\x5f\x8d\x9c\xcb\xa2\xa1\x04\x36\xc3\x5d\x52\x5c\xb5\x69\x97\xa7\x4f\xf7\xe5\xe5\xd3\x6b\x20\x2b\x0b\xd6\xe9\xbd\xfb\x2a\x74\xc1\xa5\xf9\xd1\xe1\xde\xf8\x4e\x52\x86\x15\x7b\xff\xc5\xdf\x74\x60\xbb\xd3\xf8\xe9\xe5\x74\x1a\xde\x51\xd3\xac\xd0\xaf\x8f\xc3\xcc\x73\xf8\xdb\xa6\xd9\xa7\xf5\x2f\x4f\x86\x7d\x3d\xee\x33\x8f\x85\xf4\xad\x5d\xd2\xc2\x6c\x27\x51\x36\x90\x5b\xd0\xe7\xf1\xe5\xd0\x4c\x38\x6c\x4e\xe3\xa1\xfb\x6a\x94\x98\xae\x41\x93\x99\xd0\xfa\x91\x80\xe8\x0e\x2b\x76\x96\xef\x90\xc3\x7e\x6b\x97\x38\x7e\x74\xb1\xc0\xa1\xa5\x34\x08\x37\xfd\x8c\x64\x26\xda\xc5\xeb\xd0\x96\x0e\x7c\xe3\x3e\x99\x8e\xf5\x8c\x9b\xad\x4a\xa7\x49\x30\x48\x4d\x9d\xf6\x73\x56\xbe\xbe\xb5\x4b\xb1\x82\x67\x62\xa3\xad\xdd\xf1\x09\xf1\xa1\x6b\x0e\x7a\x4a\x75\x30\x6f\x2c\xf6\xcb\xb8\x58\xb3\xbb\x2e\xba\xb0\x0e\xc6\x5d\x36\x31\xff\x99\x80\xe6\x36\xce\x90\xf9\xb7\x76\xe9\xb9\x32\x00\xf4\x85\x95\x5c\xe1\xe5\x99\xa2\x43\x82\xbd\xb6\x22\x85\x9f\xb0\x93\xf6\x80\xc5\xee\xe2\x3c\x1c\x54\x6a\x65\x7e\x65\xc0\xa3\xfc\x0e\xc3\xcf\xcf\xa8\xf4\xb7\x76\x69\xfe\x24\xe3\x4e\x08\x0a\x6c\xba\x0e\xe8\x81\x9f\x52\x58\x23\x75\x90\x4f\xee\xf6\xd7\x17\xe8\xb3\xd2\xc8\x10\x83\x92\x1a\x6d\x3e\x76\xfa\xcf\x05\x80\x3f\xcb\x79\x4c\xbf\x68\x97\xd2\x50\xf7\x11\x27\x88\x9b\x77\xee\x3d\x92\xc6\x28\x15\xae\xaa\x82\x6e\xe4\xcd\x3a\xb1\x83\x21\xd1\xb0\xa4\xe2\xa7\x27\x18\xdc\x78\xfa\xd6\x4a\x6f\x9c\x9d\x94\x6f\xed\x92\x42\x7e\xa1\x54\xd2\x50\xf3\x6d\xc1\x6c\xed\x7b\x71\x44\x7b\x31\xf7\x31\x5c\xbb\x2d\xfe\x65\x0e\xc0\x28\x9d\x38\x42\x77\xe7\x02\xc6\x47\xda\x50\x6b\x67\x12\xaa\xf4\xad\x5d\x0a\x01\xde\xfc\xd6\x46\xfd\x86\xa8\x79\x3b\x5a\x21\x68\x66\x1d\x04\xb3\xa3\x9b\xd6\x88\x6d\xdc\x96\xd3\x31\x35\xb8\xef\xb0\xed\xe1\x05\x95\xa2\x9a\x33\xb5\x4e\xfa\xd6\x2e\x8d\x7b\x03\x67\x0c\x29\x34\x3c\x5a\x36\x1b\x83\xc9\xc1\xc6\x2e\x70\x6a\xd6\x25\xa7\x76\xf6\xac\x6c\x2d\xc9\x0c\x86\x72\xf2\xe4\xdd\x8f\xd3\x1b\x67\x26\x6c\xe9\x5b\xbb\xd4\xa3\x76\x09\x14\xaf\xc8\xff\xad\x3c\x41\x1d\xf9\xdb\xcb\x2b\x34\xac\x86\xa7\xdd\x2c\x4a\xed\xa0\xd2\xd8\xab\x89\x0d\x8a\x63\xa7\xbf\x12\xfb\x3d\xc2\x19\xa3\xff\xd6\x2e\x51\x1d\xf5\x98\xaf\x4e\x3a\x85\x60\xd2\x0e\x8c\xa5\x38\xf0\xd7\xb5\x5d\xd8\x14\x32\xc7\x12\xc1\x3e\xec\x57\x76\xa9\xda\x9e\x1b\xb6\xcb\x5b\xab\x86\x63\x9e\x3d\xc6\xf7\xa4\x53\x0a\xb0\xe8\x1c\xf4\xdb\x07\x6f\x3b\xf4\x1e\x28\xa3\xa0\xbc\x2e\xf6\x06\xf7\xb1\x57\xb3\x43\xe5\xbd\xf6\xb2\xa5\xd8\x6b\x05\x15\xd4\xd3\xf1\x96\xa3\xc7\xc8\xdf\xda\xa5\xeb\xc7\xf1\xb8\x44\xa3\x58\xd3\x55\x81\x5e\xa3\x3c\x7e\x54\x72\xfa\x9c\xae\x01\x55\x52\xd7\xef\xb1\x89\x9c\x62\x2a\xc9\x54\xb6\x9a\xeb\x72\xca\xe7\x7f\x9f\xc0\x3f\xff\xfd\xf9\x0b\xf5\x95\xa5\x0b\xc8\x07\x01\x00")
+
+func dataUsersJsonBytes() ([]byte, error) {
+	return bindataRead(
+		_dataUsersJson,
+		"data/users.json",
+	)
+}
+
+func dataUsersJson() (*asset, error) {
+	bytes, err := dataUsersJsonBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "data/users.json", size: 67528, mode: os.FileMode(420), modTime: time.Unix(1792280311, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -205,8 +310,13 @@ func AssetNames() []string {
 var _bindata = map[string]func() (*asset, error){
 	"data/geo.json": dataGeoJson,
 	"data/hotels.json": dataHotelsJson,
+	"data/images.json": dataImagesJson,
 	"data/inventory.json": dataInventoryJson,
 	"data/locales.json": dataLocalesJson,
+	"data/pricing.json": dataPricingJson,
+	"data/rates.json": dataRatesJson,
+	"data/recommendations.json": dataRecommendationsJson,
+	"data/users.json": dataUsersJson,
 }
 
 // AssetDir returns the file names below a certain
@@ -252,8 +362,13 @@ var _bintree = &bintree{nil, map[string]*bintree{
 	"data": &bintree{nil, map[string]*bintree{
 		"geo.json": &bintree{dataGeoJson, map[string]*bintree{}},
 		"hotels.json": &bintree{dataHotelsJson, map[string]*bintree{}},
+		"images.json": &bintree{dataImagesJson, map[string]*bintree{}},
 		"inventory.json": &bintree{dataInventoryJson, map[string]*bintree{}},
 		"locales.json": &bintree{dataLocalesJson, map[string]*bintree{}},
+		"pricing.json": &bintree{dataPricingJson, map[string]*bintree{}},
+		"rates.json": &bintree{dataRatesJson, map[string]*bintree{}},
+		"recommendations.json": &bintree{dataRecommendationsJson, map[string]*bintree{}},
+		"users.json": &bintree{dataUsersJson, map[string]*bintree{}},
 	}},
 }}
 
@@ -0,0 +1,112 @@
+// Package media stores hotel images and their thumbnails on a blob store.
+//
+// Images are stored as JPEG under slash separated keys, e.g. "1/lobby.jpg",
+// with a thumbnail next to each, e.g. "1/lobby_thumb.jpg". The frontend
+// serves the blob of a key at URL(key), which it streams from the profile
+// service.
+package media
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"io/fs"
+	"strings"
+	"sync"
+	"time"
+)
+
+var (
+	// ErrNotFound is returned for keys nothing is stored under
+	ErrNotFound = errors.New("media: blob not found")
+	// ErrInvalidKey is returned for keys that aren't slash separated
+	// relative paths without "." or ".." elements
+	ErrInvalidKey = errors.New("media: invalid blob key")
+)
+
+// BlobStore stores blobs by key
+type BlobStore interface {
+	// Put stores content under key, replacing what was stored before.
+	// Readers see either the old or the new content, never a mix.
+	Put(key string, content []byte) error
+	// Open returns the blob stored under key, or ErrNotFound.
+	Open(key string) (Blob, error)
+}
+
+// Blob is the content of a stored blob, which must be closed after use
+type Blob interface {
+	io.ReadSeeker
+	io.Closer
+	// ModTime is when the blob was stored
+	ModTime() time.Time
+	// Size is the length of the content in bytes
+	Size() int64
+}
+
+// checkKey returns ErrInvalidKey for keys that aren't slash separated
+// relative paths without "." or ".." elements
+func checkKey(key string) error {
+	if !fs.ValidPath(key) || key == "." || strings.Contains(key, "\\") {
+		return ErrInvalidKey
+	}
+	return nil
+}
+
+type memoryStore struct {
+	mu    sync.RWMutex
+	blobs map[string]memoryBlob
+}
+
+type memoryBlob struct {
+	content []byte
+	modTime time.Time
+}
+
+// NewMemoryStore returns a BlobStore that keeps blobs in memory
+func NewMemoryStore() BlobStore {
+	return &memoryStore{blobs: make(map[string]memoryBlob)}
+}
+
+func (m *memoryStore) Put(key string, content []byte) error {
+	if err := checkKey(key); err != nil {
+		return err
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.blobs[key] = memoryBlob{
+		content: append([]byte(nil), content...),
+		modTime: time.Now(),
+	}
+	return nil
+}
+
+func (m *memoryStore) Open(key string) (Blob, error) {
+	if err := checkKey(key); err != nil {
+		return nil, err
+	}
+
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	blob, ok := m.blobs[key]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	// content is never changed once put
+	return &readerBlob{Reader: bytes.NewReader(blob.content), modTime: blob.modTime}, nil
+}
+
+type readerBlob struct {
+	*bytes.Reader
+	modTime time.Time
+}
+
+func (b *readerBlob) Close() error {
+	return nil
+}
+
+func (b *readerBlob) ModTime() time.Time {
+	return b.modTime
+}
@@ -0,0 +1,91 @@
+package media
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+type fileStore struct {
+	dir string
+}
+
+// NewFileStore returns a BlobStore that keeps each blob in a file under
+// dir, at the path of its key.
+func NewFileStore(dir string) BlobStore {
+	return &fileStore{dir: dir}
+}
+
+func (f *fileStore) path(key string) (string, error) {
+	if err := checkKey(key); err != nil {
+		return "", err
+	}
+	return filepath.Join(f.dir, filepath.FromSlash(key)), nil
+}
+
+func (f *fileStore) Put(key string, content []byte) error {
+	path, err := f.path(key)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+
+	// write aside and rename, so the blob is replaced at once
+	tmp, err := ioutil.TempFile(filepath.Dir(path), ".put-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(content); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	if err := os.Chmod(tmp.Name(), 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+func (f *fileStore) Open(key string) (Blob, error) {
+	path, err := f.path(key)
+	if err != nil {
+		return nil, err
+	}
+	file, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+	if !info.Mode().IsRegular() {
+		file.Close()
+		return nil, ErrNotFound
+	}
+	return &fileBlob{File: file, modTime: info.ModTime(), size: info.Size()}, nil
+}
+
+type fileBlob struct {
+	*os.File
+	modTime time.Time
+	size    int64
+}
+
+func (b *fileBlob) ModTime() time.Time {
+	return b.modTime
+}
+
+func (b *fileBlob) Size() int64 {
+	return b.size
+}
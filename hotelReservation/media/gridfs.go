@@ -0,0 +1,92 @@
+package media
+
+import (
+	"time"
+
+	"gopkg.in/mgo.v2"
+	"gopkg.in/mgo.v2/bson"
+)
+
+// gridFSPrefix names the GridFS collections blobs are kept in, i.e.
+// "images.files" and "images.chunks"
+const gridFSPrefix = "images"
+
+type gridFSStore struct {
+	session *mgo.Session
+	db      string
+}
+
+// NewGridFSStore returns a BlobStore that keeps blobs in GridFS in the
+// database db, named by their key. Every replica of a service sees the same
+// blobs.
+func NewGridFSStore(session *mgo.Session, db string) BlobStore {
+	return &gridFSStore{session: session, db: db}
+}
+
+func (g *gridFSStore) Put(key string, content []byte) error {
+	if err := checkKey(key); err != nil {
+		return err
+	}
+	s := g.session.Copy()
+	defer s.Close()
+	gfs := s.DB(g.db).GridFS(gridFSPrefix)
+
+	// readers open the latest file of a name, so the new one replaces the
+	// old ones at once when it is closed
+	file, err := gfs.Create(key)
+	if err != nil {
+		return err
+	}
+	if _, err := file.Write(content); err != nil {
+		file.Abort()
+		file.Close()
+		return err
+	}
+	if err := file.Close(); err != nil {
+		return err
+	}
+
+	var old struct {
+		Id bson.ObjectId `bson:"_id"`
+	}
+	iter := gfs.Find(bson.M{"filename": key, "_id": bson.M{"$ne": file.Id()}}).Select(bson.M{"_id": 1}).Iter()
+	for iter.Next(&old) {
+		if err := gfs.RemoveId(old.Id); err != nil {
+			iter.Close()
+			return err
+		}
+	}
+	return iter.Close()
+}
+
+func (g *gridFSStore) Open(key string) (Blob, error) {
+	if err := checkKey(key); err != nil {
+		return nil, err
+	}
+	// the session is used while the blob is read, and closed with it
+	s := g.session.Copy()
+	file, err := s.DB(g.db).GridFS(gridFSPrefix).Open(key)
+	if err == mgo.ErrNotFound {
+		s.Close()
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		s.Close()
+		return nil, err
+	}
+	return &gridFSBlob{GridFile: file, session: s}, nil
+}
+
+type gridFSBlob struct {
+	*mgo.GridFile
+	session *mgo.Session
+}
+
+func (b *gridFSBlob) Close() error {
+	defer b.session.Close()
+	return b.GridFile.Close()
+}
+
+func (b *gridFSBlob) ModTime() time.Time {
+	return b.UploadDate()
+}
@@ -0,0 +1,143 @@
+package media
+
+import (
+	"bytes"
+	"image"
+	"image/draw"
+	"image/jpeg"
+	"path"
+	"strings"
+)
+
+const (
+	// URLPrefix is the path the frontend serves blobs under
+	URLPrefix = "/images/"
+
+	// ThumbnailWidth and ThumbnailHeight bound the size of thumbnails
+	ThumbnailWidth  = 320
+	ThumbnailHeight = 240
+
+	jpegQuality = 85
+)
+
+// Image is an image stored with its thumbnail
+type Image struct {
+	Key          string
+	ThumbnailKey string
+	Width        int
+	Height       int
+}
+
+// URL returns the URL the frontend serves the blob of key at
+func URL(key string) string {
+	return URLPrefix + key
+}
+
+// ThumbnailKey returns the key of the thumbnail of the image of key, e.g.
+// "1/lobby_thumb.jpg" for "1/lobby.jpg"
+func ThumbnailKey(key string) string {
+	ext := path.Ext(key)
+	return strings.TrimSuffix(key, ext) + "_thumb" + ext
+}
+
+// Store stores an image as JPEG under key, together with its thumbnail.
+// The thumbnail is stored first, so an image that can be found always has
+// one.
+func Store(blobs BlobStore, key string, img image.Image) (*Image, error) {
+	stored := &Image{
+		Key:          key,
+		ThumbnailKey: ThumbnailKey(key),
+		Width:        img.Bounds().Dx(),
+		Height:       img.Bounds().Dy(),
+	}
+
+	for _, blob := range []struct {
+		key string
+		img image.Image
+	}{
+		{stored.ThumbnailKey, Thumbnail(img, ThumbnailWidth, ThumbnailHeight)},
+		{stored.Key, img},
+	} {
+		var buf bytes.Buffer
+		if err := jpeg.Encode(&buf, blob.img, &jpeg.Options{Quality: jpegQuality}); err != nil {
+			return nil, err
+		}
+		if err := blobs.Put(blob.key, buf.Bytes()); err != nil {
+			return nil, err
+		}
+	}
+	return stored, nil
+}
+
+// Load returns the image stored under key by Store, or ErrNotFound.
+func Load(blobs BlobStore, key string) (*Image, error) {
+	blob, err := blobs.Open(key)
+	if err != nil {
+		return nil, err
+	}
+	defer blob.Close()
+	config, _, err := image.DecodeConfig(blob)
+	if err != nil {
+		return nil, err
+	}
+	return &Image{
+		Key:          key,
+		ThumbnailKey: ThumbnailKey(key),
+		Width:        config.Width,
+		Height:       config.Height,
+	}, nil
+}
+
+// Thumbnail scales an image down to fit within width x height, keeping its
+// aspect ratio. Each pixel of the thumbnail is the average of the pixels of
+// the image it covers. Images that fit already are returned as they are.
+func Thumbnail(img image.Image, width, height int) image.Image {
+	b := img.Bounds()
+	if b.Dx() <= width && b.Dy() <= height {
+		return img
+	}
+	// scale both sides by the smaller ratio
+	if b.Dx()*height > b.Dy()*width {
+		height = max(1, b.Dy()*width/b.Dx())
+	} else {
+		width = max(1, b.Dx()*height/b.Dy())
+	}
+
+	// average the pixels themselves rather than through At
+	src, ok := img.(*image.RGBA)
+	if !ok {
+		src = image.NewRGBA(b)
+		draw.Draw(src, b, img, b.Min, draw.Src)
+	}
+
+	thumb := image.NewRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		y0 := b.Min.Y + y*b.Dy()/height
+		y1 := b.Min.Y + (y+1)*b.Dy()/height
+		for x := 0; x < width; x++ {
+			x0 := b.Min.X + x*b.Dx()/width
+			x1 := b.Min.X + (x+1)*b.Dx()/width
+			var sum [4]int
+			for sy := y0; sy < y1; sy++ {
+				for i := src.PixOffset(x0, sy); i < src.PixOffset(x1, sy); i += 4 {
+					for c := 0; c < 4; c++ {
+						sum[c] += int(src.Pix[i+c])
+					}
+				}
+			}
+			n := (y1 - y0) * (x1 - x0)
+			i := thumb.PixOffset(x, y)
+			for c := 0; c < 4; c++ {
+				thumb.Pix[i+c] = uint8(sum[c] / n)
+			}
+		}
+	}
+	return thumb
+}
+
+func max(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
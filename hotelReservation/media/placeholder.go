@@ -0,0 +1,71 @@
+package media
+
+import (
+	"hash/fnv"
+	"image"
+	"math/rand"
+)
+
+// Placeholder renders a picture that stands in for a hotel image no one
+// has taken yet: a skyline against a sky, whose colours and buildings are
+// picked by seed. The same seed always renders the same picture. Film
+// grain keeps its JPEG encoding about as large as a photograph's.
+func Placeholder(width, height int, seed string) image.Image {
+	h := fnv.New64a()
+	h.Write([]byte(seed))
+	rnd := rand.New(rand.NewSource(int64(h.Sum64())))
+
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	top := [3]int{rnd.Intn(80), 60 + rnd.Intn(100), 140 + rnd.Intn(116)}
+	bottom := [3]int{200 + rnd.Intn(56), 120 + rnd.Intn(100), 80 + rnd.Intn(100)}
+	for y := 0; y < height; y++ {
+		for x := 0; x < width; x++ {
+			i := img.PixOffset(x, y)
+			for c := 0; c < 3; c++ {
+				img.Pix[i+c] = uint8(top[c] + (bottom[c]-top[c])*y/height)
+			}
+			img.Pix[i+3] = 0xff
+		}
+	}
+
+	// buildings side by side along the bottom, some windows lit
+	for x := 0; x < width; {
+		w := width/20 + rnd.Intn(width/8+1)
+		bh := height/4 + rnd.Intn(height/2+1)
+		shade := 20 + rnd.Intn(60)
+		lights := rnd.Intn(1000)
+		for by := height - bh; by < height; by++ {
+			for bx := x; bx < x+w && bx < width; bx++ {
+				i := img.PixOffset(bx, by)
+				wx, wy := (bx-x)/12, (by-height+bh)/16
+				lit := (bx-x)%12 > 3 && (by-height+bh)%16 > 5 && (wx*31+wy*17+lights)%5 == 0
+				for c := 0; c < 3; c++ {
+					if lit {
+						img.Pix[i+c] = uint8(250 - 50*c)
+					} else {
+						img.Pix[i+c] = uint8(shade + 10*c)
+					}
+				}
+			}
+		}
+		x += w
+	}
+
+	for i := 0; i < len(img.Pix); i += 4 {
+		grain := rnd.Intn(25) - 12
+		for c := 0; c < 3; c++ {
+			img.Pix[i+c] = clamp(int(img.Pix[i+c]) + grain)
+		}
+	}
+	return img
+}
+
+func clamp(v int) uint8 {
+	if v < 0 {
+		return 0
+	}
+	if v > 0xff {
+		return 0xff
+	}
+	return uint8(v)
+}
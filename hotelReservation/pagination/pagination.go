@@ -0,0 +1,107 @@
+// Package pagination pages through result lists with opaque page tokens.
+//
+// A page token holds the offset of the page it points to, bound to the query
+// it was returned for, so a token can't be used to page through the results
+// of a different query.
+package pagination
+
+import (
+	"encoding/base64"
+	"fmt"
+	"hash/fnv"
+	"strconv"
+	"strings"
+
+	"github.com/harlow/go-micro-services/rpcerr"
+)
+
+// Page is the part of a result list a request asks for.
+type Page struct {
+	// Offset is the index of the first result of the page.
+	Offset int
+	// Size is the maximum number of results of the page.
+	Size int
+}
+
+// New returns the page a request with pageSize and pageToken asks for.
+// query identifies the results being paged through, e.g. the request with
+// its page token and page size left out. A pageSize of 0 picks defaultSize,
+// larger ones than maxSize are lowered to it.
+func New(pageSize int32, pageToken, query string, defaultSize, maxSize int) (Page, error) {
+	page := Page{Size: int(pageSize)}
+	if pageSize < 0 {
+		return page, rpcerr.InvalidArgument("pageSize", "%d is negative", pageSize)
+	}
+	if page.Size == 0 {
+		page.Size = defaultSize
+	}
+	if page.Size > maxSize {
+		page.Size = maxSize
+	}
+
+	if pageToken == "" {
+		return page, nil
+	}
+	offset, err := decode(pageToken, query)
+	if err != nil {
+		return page, rpcerr.InvalidArgument("pageToken", "%s", err)
+	}
+	page.Offset = offset
+	return page, nil
+}
+
+// End returns the index after the last result of the page in a list of
+// total results.
+func (p Page) End(total int) int {
+	if p.Offset+p.Size > total {
+		return total
+	}
+	return p.Offset + p.Size
+}
+
+// Start returns the index of the first result of the page in a list of
+// total results.
+func (p Page) Start(total int) int {
+	if p.Offset > total {
+		return total
+	}
+	return p.Offset
+}
+
+// NextToken returns the token of the page after p in a list of total
+// results, or an empty string if p is the last page.
+func (p Page) NextToken(total int, query string) string {
+	if p.End(total) >= total {
+		return ""
+	}
+	return encode(p.End(total), query)
+}
+
+func encode(offset int, query string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(fmt.Sprintf("%d:%x", offset, hash(query))))
+}
+
+func decode(token, query string) (int, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return 0, fmt.Errorf("malformed page token")
+	}
+	parts := strings.SplitN(string(raw), ":", 2)
+	if len(parts) != 2 {
+		return 0, fmt.Errorf("malformed page token")
+	}
+	offset, err := strconv.Atoi(parts[0])
+	if err != nil || offset < 0 {
+		return 0, fmt.Errorf("malformed page token")
+	}
+	if parts[1] != fmt.Sprintf("%x", hash(query)) {
+		return 0, fmt.Errorf("page token of a different query")
+	}
+	return offset, nil
+}
+
+func hash(query string) uint64 {
+	h := fnv.New64a()
+	h.Write([]byte(query))
+	return h.Sum64()
+}
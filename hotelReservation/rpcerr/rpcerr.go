@@ -0,0 +1,123 @@
+package rpcerr
+
+import (
+	"fmt"
+	"io"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"golang.org/x/net/context"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// retryDelay is the delay suggested to clients of an unavailable backend
+const retryDelay = time.Second
+
+// InvalidArgument reports a request field with a bad value
+func InvalidArgument(field, format string, a ...interface{}) error {
+	description := fmt.Sprintf(format, a...)
+	return newError(codes.InvalidArgument, fmt.Sprintf("invalid %s: %s", field, description),
+		&errdetails.BadRequest{
+			FieldViolations: []*errdetails.BadRequest_FieldViolation{
+				{Field: field, Description: description},
+			},
+		})
+}
+
+// NotFound reports a resource that does not exist
+func NotFound(resourceType, resourceName string) error {
+	return newError(codes.NotFound, fmt.Sprintf("%s %s not found", resourceType, resourceName),
+		&errdetails.ResourceInfo{
+			ResourceType: resourceType,
+			ResourceName: resourceName,
+		})
+}
+
+// AlreadyExists reports a resource that can't be created because it exists
+// already
+func AlreadyExists(resourceType, resourceName string) error {
+	return newError(codes.AlreadyExists, fmt.Sprintf("%s %s already exists", resourceType, resourceName),
+		&errdetails.ResourceInfo{
+			ResourceType: resourceType,
+			ResourceName: resourceName,
+		})
+}
+
+// ResourceExhausted reports a resource that has run out, such as the rooms
+// of a hotel on some night
+func ResourceExhausted(resourceType, resourceName, description string) error {
+	return newError(codes.ResourceExhausted, fmt.Sprintf("%s %s: %s", resourceType, resourceName, description),
+		&errdetails.ResourceInfo{
+			ResourceType: resourceType,
+			ResourceName: resourceName,
+			Description:  description,
+		})
+}
+
+// Aborted reports a change given up because the resource was changed
+// concurrently, clients may retry it
+func Aborted(resourceType, resourceName string) error {
+	return newError(codes.Aborted, fmt.Sprintf("%s %s changed concurrently", resourceType, resourceName),
+		&errdetails.ResourceInfo{
+			ResourceType: resourceType,
+			ResourceName: resourceName,
+		})
+}
+
+// Unavailable reports a backend that can't be reached, clients may retry
+func Unavailable(backend string, err error) error {
+	return newError(codes.Unavailable, fmt.Sprintf("%s unavailable", backend),
+		&errdetails.RetryInfo{RetryDelay: ptypes.DurationProto(retryDelay)},
+		&errdetails.DebugInfo{Detail: err.Error()})
+}
+
+// Internal reports an unexpected failure
+func Internal(err error) error {
+	return newError(codes.Internal, "internal error",
+		&errdetails.DebugInfo{Detail: err.Error()})
+}
+
+// Mongo converts an error returned by mgo. Connection problems are reported
+// as Unavailable, anything else as Internal.
+func Mongo(err error) error {
+	if isConnError(err) {
+		return Unavailable("mongodb", err)
+	}
+	return Internal(err)
+}
+
+// Context converts the error of a context that is done, i.e. a call that
+// was cancelled or timed out while waiting
+func Context(err error) error {
+	if err == context.DeadlineExceeded {
+		return status.Error(codes.DeadlineExceeded, err.Error())
+	}
+	return status.Error(codes.Canceled, err.Error())
+}
+
+func newError(code codes.Code, msg string, details ...proto.Message) error {
+	st, err := status.New(code, msg).WithDetails(details...)
+	if err != nil {
+		return status.Error(code, msg)
+	}
+	return st.Err()
+}
+
+func isConnError(err error) bool {
+	if _, ok := err.(net.Error); ok {
+		return true
+	}
+	if err == io.EOF || err == io.ErrUnexpectedEOF {
+		return true
+	}
+	// mgo reports lost servers by message only
+	msg := err.Error()
+	return strings.Contains(msg, "no reachable servers") ||
+		strings.Contains(msg, "Closed explicitly") ||
+		strings.Contains(msg, "connection refused")
+}
@@ -0,0 +1,93 @@
+package frontend
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/rs/zerolog/log"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// httpStatus maps the code of a gRPC status error to an HTTP status
+func httpStatus(code codes.Code) int {
+	switch code {
+	case codes.OK:
+		return http.StatusOK
+	case codes.InvalidArgument, codes.OutOfRange:
+		return http.StatusBadRequest
+	case codes.Unauthenticated:
+		return http.StatusUnauthorized
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.AlreadyExists, codes.Aborted:
+		return http.StatusConflict
+	case codes.FailedPrecondition:
+		return http.StatusPreconditionFailed
+	case codes.ResourceExhausted:
+		// services run out of rooms, not of request quota
+		return http.StatusConflict
+	case codes.Canceled:
+		// client closed request
+		return 499
+	case codes.Unimplemented:
+		return http.StatusNotImplemented
+	case codes.Unavailable:
+		return http.StatusServiceUnavailable
+	case codes.DeadlineExceeded:
+		return http.StatusGatewayTimeout
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// writeError replies to a request whose downstream call failed with the
+// HTTP status of the error and a JSON body of the form
+//
+//	{"error": {"code": "NotFound", "message": "...", "details": [...]}}
+//
+// where details holds the error details attached by the service. Debug
+// info is logged rather than sent, it is not meant for end users.
+func writeError(w http.ResponseWriter, err error) {
+	st := status.Convert(err)
+
+	details := make([]interface{}, 0)
+	for _, d := range st.Details() {
+		m, ok := d.(proto.Message)
+		if !ok {
+			continue
+		}
+		if debug, ok := m.(*errdetails.DebugInfo); ok {
+			// backend errors name hosts and collections, keep them here
+			log.Error().Msgf("%s: %s", st.Message(), debug.Detail)
+			continue
+		}
+		if retry, ok := m.(*errdetails.RetryInfo); ok {
+			if delay, err := ptypes.Duration(retry.RetryDelay); err == nil {
+				w.Header().Set("Retry-After", strconv.Itoa(int(delay.Seconds()+0.5)))
+			}
+		}
+		details = append(details, map[string]interface{}{
+			"type":  proto.MessageName(m),
+			"value": m,
+		})
+	}
+
+	res := map[string]interface{}{
+		"error": map[string]interface{}{
+			"code":    st.Code().String(),
+			"message": st.Message(),
+			"details": details,
+		},
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(httpStatus(st.Code()))
+	json.NewEncoder(w).Encode(res)
+}
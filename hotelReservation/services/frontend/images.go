@@ -0,0 +1,147 @@
+package frontend
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/harlow/go-micro-services/media"
+	profile "github.com/harlow/go-micro-services/services/profile/proto"
+	"github.com/rs/zerolog/log"
+)
+
+// imageMaxAge is how long browsers and proxies may cache images without
+// asking again. An image replaced under the same URL gets a new ETag.
+const imageMaxAge = 24 * time.Hour
+
+// imageHandler serves the hotel images of the profile service, at the URLs
+// recorded in the profiles. Images are streamed through as the profile
+// service reads them, and not read at all for clients that have them
+// already.
+func (s *Server) imageHandler(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Access-Control-Allow-Origin", "*")
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.Header().Set("Allow", "GET, HEAD")
+		http.Error(w, "Please use GET or HEAD", http.StatusMethodNotAllowed)
+		return
+	}
+	// ends the stream of images that aren't sent in full
+	ctx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+
+	key := strings.TrimPrefix(r.URL.Path, media.URLPrefix)
+	stream, err := s.profileClient.GetImage(ctx, &profile.ImageRequest{Key: key, IfModTime: ifModTime(r)})
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	// the first chunk tells when the image was stored and its size
+	image, err := stream.Recv()
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	modTime := time.Unix(0, image.ModTime)
+	w.Header().Set("Cache-Control", fmt.Sprintf("public, max-age=%d", int(imageMaxAge.Seconds())))
+	w.Header().Set("ETag", etagOf(image.ModTime))
+	w.Header().Set("Last-Modified", modTime.UTC().Format(http.TimeFormat))
+	if notModified(r, image.ModTime) {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+	if ctype := mime.TypeByExtension(path.Ext(key)); ctype != "" {
+		w.Header().Set("Content-Type", ctype)
+	}
+	w.Header().Set("Content-Length", strconv.FormatInt(image.Size, 10))
+	w.WriteHeader(http.StatusOK)
+	if r.Method == http.MethodHead {
+		return
+	}
+
+	for {
+		chunk, err := stream.Recv()
+		if err == io.EOF {
+			return
+		}
+		if err != nil {
+			// the status is sent already, the client sees the image cut
+			// short of its Content-Length
+			log.Error().Msgf("Tried to stream image [%v], but got error = %s", key, err)
+			return
+		}
+		if _, err := w.Write(chunk.Content); err != nil {
+			return
+		}
+	}
+}
+
+// etagOf returns the ETag of an image stored at modTime, in nanoseconds
+// since the Unix epoch
+func etagOf(modTime int64) string {
+	return fmt.Sprintf(`"%x"`, modTime)
+}
+
+// etags returns the entity tags of the If-None-Match header of r, weak
+// ones without their W/ prefix
+func etags(r *http.Request) []string {
+	tags := make([]string, 0)
+	for _, tag := range strings.Split(r.Header.Get("If-None-Match"), ",") {
+		tag = strings.TrimPrefix(strings.TrimSpace(tag), "W/")
+		if tag != "" {
+			tags = append(tags, tag)
+		}
+	}
+	return tags
+}
+
+// ifModTime returns the time the image the client has was stored at, by
+// the first ETag of If-None-Match this handler could have sent, or 0
+func ifModTime(r *http.Request) int64 {
+	for _, tag := range etags(r) {
+		modTime, err := strconv.ParseInt(strings.Trim(tag, `"`), 16, 64)
+		if err == nil && etagOf(modTime) == tag {
+			return modTime
+		}
+	}
+	return 0
+}
+
+// notModified reports whether the client has the image stored at modTime
+// already, by If-None-Match or, without it, by If-Modified-Since
+func notModified(r *http.Request, modTime int64) bool {
+	if r.Header.Get("If-None-Match") != "" {
+		for _, tag := range etags(r) {
+			if tag == "*" || tag == etagOf(modTime) {
+				return true
+			}
+		}
+		return false
+	}
+	since, err := http.ParseTime(r.Header.Get("If-Modified-Since"))
+	if err != nil {
+		return false
+	}
+	// Last-Modified is in whole seconds
+	return !time.Unix(0, modTime).Truncate(time.Second).After(since)
+}
+
+// defaultImage returns the default image of a hotel, its first image if
+// none is marked default, or nil if it has none.
+func defaultImage(h *profile.Hotel) *profile.Image {
+	for _, image := range h.Images {
+		if image.Default {
+			return image
+		}
+	}
+	if len(h.Images) > 0 {
+		return h.Images[0]
+	}
+	return nil
+}
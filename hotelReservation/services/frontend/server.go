@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net/http"
 	"strconv"
+	"strings"
 
 	recommendation "github.com/harlow/go-micro-services/services/recommendation/proto"
 	reservation "github.com/harlow/go-micro-services/services/reservation/proto"
@@ -12,17 +13,22 @@ import (
 	"github.com/rs/zerolog/log"
 
 	"github.com/harlow/go-micro-services/dialer"
+	"github.com/harlow/go-micro-services/media"
 	"github.com/harlow/go-micro-services/registry"
+	geo "github.com/harlow/go-micro-services/services/geo/proto"
 	profile "github.com/harlow/go-micro-services/services/profile/proto"
 	search "github.com/harlow/go-micro-services/services/search/proto"
 	"github.com/harlow/go-micro-services/tls"
 	"github.com/harlow/go-micro-services/tracing"
 	"github.com/opentracing/opentracing-go"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 // Server implements frontend service
 type Server struct {
 	searchClient         search.SearchClient
+	geoClient            geo.GeoClient
 	profileClient        profile.ProfileClient
 	recommendationClient recommendation.RecommendationClient
 	userClient           user.UserClient
@@ -44,6 +50,10 @@ func (s *Server) Run() error {
 		return err
 	}
 
+	if err := s.initGeoClient("srv-geo"); err != nil {
+		return err
+	}
+
 	if err := s.initProfileClient("srv-profile"); err != nil {
 		return err
 	}
@@ -65,9 +75,13 @@ func (s *Server) Run() error {
 	mux := tracing.NewServeMux(s.Tracer)
 	mux.Handle("/", http.FileServer(http.Dir("services/frontend/static")))
 	mux.Handle("/hotels", http.HandlerFunc(s.searchHandler))
+	mux.Handle("/hotels/bbox", http.HandlerFunc(s.boundsHandler))
 	mux.Handle("/recommendations", http.HandlerFunc(s.recommendHandler))
 	mux.Handle("/user", http.HandlerFunc(s.userHandler))
 	mux.Handle("/reservation", http.HandlerFunc(s.reservationHandler))
+	mux.Handle("/reservations", http.HandlerFunc(s.reservationsHandler))
+	mux.Handle("/availability", http.HandlerFunc(s.availabilityHandler))
+	mux.Handle(media.URLPrefix, http.HandlerFunc(s.imageHandler))
 
 	log.Trace().Msg("frontend starts serving")
 
@@ -99,6 +113,19 @@ func (s *Server) initSearchClient(name string) error {
 	return nil
 }
 
+func (s *Server) initGeoClient(name string) error {
+	conn, err := dialer.Dial(
+		name,
+		dialer.WithTracer(s.Tracer),
+		dialer.WithBalancer(s.Registry.Client),
+	)
+	if err != nil {
+		return fmt.Errorf("dialer error: %v", err)
+	}
+	s.geoClient = geo.NewGeoClient(conn)
+	return nil
+}
+
 func (s *Server) initProfileClient(name string) error {
 	conn, err := dialer.Dial(
 		name,
@@ -164,30 +191,132 @@ func (s *Server) searchHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// lan/lon from query params
-	sLat, sLon := r.URL.Query().Get("lat"), r.URL.Query().Get("lon")
-	if sLat == "" || sLon == "" {
-		http.Error(w, "Please specify location params", http.StatusBadRequest)
-		return
+	// ranking weights from query params, search picks its defaults if none
+	// are given
+	weights := new(search.Weights)
+	for param, weight := range map[string]*float64{
+		"distanceWeight": &weights.Distance,
+		"priceWeight":    &weights.Price,
+		"discountWeight": &weights.Discount,
+		"ratingWeight":   &weights.Rating,
+	} {
+		v := r.URL.Query().Get(param)
+		if v == "" {
+			continue
+		}
+		f, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			http.Error(w, fmt.Sprintf("Please check %s format", param), http.StatusBadRequest)
+			return
+		}
+		*weight = f
 	}
 
-	Lat, _ := strconv.ParseFloat(sLat, 32)
-	lat := float32(Lat)
-	Lon, _ := strconv.ParseFloat(sLon, 32)
-	lon := float32(Lon)
+	// paging through the results
+	pageSize, radius := 0, 0.0
+	if v := r.URL.Query().Get("pageSize"); v != "" {
+		var err error
+		if pageSize, err = strconv.Atoi(v); err != nil {
+			http.Error(w, "Please check pageSize format", http.StatusBadRequest)
+			return
+		}
+	}
+	if v := r.URL.Query().Get("radius"); v != "" {
+		var err error
+		if radius, err = strconv.ParseFloat(v, 32); err != nil {
+			http.Error(w, "Please check radius format", http.StatusBadRequest)
+			return
+		}
+	}
+	pageToken := r.URL.Query().Get("pageToken")
 
-	log.Trace().Msg("starts searchHandler querying downstream")
+	// only keep hotels with one of the comma separated room types free, any
+	// room type will do if none is given
+	roomTypes := make([]string, 0)
+	for _, roomType := range strings.Split(r.URL.Query().Get("roomType"), ",") {
+		if roomType != "" {
+			roomTypes = append(roomTypes, roomType)
+		}
+	}
 
-	log.Trace().Msgf("SEARCH [lat: %v, lon: %v, inDate: %v, outDate: %v", lat, lon, inDate, outDate)
-	// search for best hotels
-	searchResp, err := s.searchClient.Nearby(ctx, &search.NearbyRequest{
-		Lat:     lat,
-		Lon:     lon,
-		InDate:  inDate,
-		OutDate: outDate,
-	})
+	// price range, currency and comma separated amenity tags to filter by
+	minPrice, maxPrice := 0.0, 0.0
+	for param, price := range map[string]*float64{
+		"minPrice": &minPrice,
+		"maxPrice": &maxPrice,
+	} {
+		v := r.URL.Query().Get(param)
+		if v == "" {
+			continue
+		}
+		f, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			http.Error(w, fmt.Sprintf("Please check %s format", param), http.StatusBadRequest)
+			return
+		}
+		*price = f
+	}
+	currency := r.URL.Query().Get("currency")
+	amenities := make([]string, 0)
+	for _, amenity := range strings.Split(r.URL.Query().Get("amenities"), ",") {
+		if amenity != "" {
+			amenities = append(amenities, amenity)
+		}
+	}
+
+	var searchResp *search.SearchResult
+	var err error
+	if city := r.URL.Query().Get("city"); city != "" {
+		log.Trace().Msgf("SEARCH [city: %v, inDate: %v, outDate: %v", city, inDate, outDate)
+		// search for best hotels in the city
+		searchResp, err = s.searchClient.City(ctx, &search.CityRequest{
+			City:      city,
+			InDate:    inDate,
+			OutDate:   outDate,
+			Weights:   weights,
+			PageSize:  int32(pageSize),
+			PageToken: pageToken,
+			RoomTypes: roomTypes,
+			MinPrice:  minPrice,
+			MaxPrice:  maxPrice,
+			Currency:  currency,
+			Amenities: amenities,
+		})
+	} else {
+		// lan/lon from query params
+		sLat, sLon := r.URL.Query().Get("lat"), r.URL.Query().Get("lon")
+		if sLat == "" || sLon == "" {
+			http.Error(w, "Please specify location params (lat/lon or city)", http.StatusBadRequest)
+			return
+		}
+
+		Lat, _ := strconv.ParseFloat(sLat, 32)
+		lat := float32(Lat)
+		Lon, _ := strconv.ParseFloat(sLon, 32)
+		lon := float32(Lon)
+
+		log.Trace().Msg("starts searchHandler querying downstream")
+
+		log.Trace().Msgf("SEARCH [lat: %v, lon: %v, inDate: %v, outDate: %v", lat, lon, inDate, outDate)
+		// search for best hotels
+		searchResp, err = s.searchClient.Nearby(ctx, &search.NearbyRequest{
+			Lat:       lat,
+			Lon:       lon,
+			InDate:    inDate,
+			OutDate:   outDate,
+			Weights:   weights,
+			Radius:    float32(radius),
+			PageSize:  int32(pageSize),
+			PageToken: pageToken,
+			RoomTypes: roomTypes,
+			MinPrice:  minPrice,
+			MaxPrice:  maxPrice,
+			Currency:  currency,
+			Amenities: amenities,
+		})
+	}
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		writeError(w, err)
 		return
 	}
 
@@ -202,36 +331,132 @@ func (s *Server) searchHandler(w http.ResponseWriter, r *http.Request) {
 		locale = "en"
 	}
 
-	reservationResp, err := s.reservationClient.CheckAvailability(ctx, &reservation.Request{
-		CustomerName: "",
-		HotelId:      searchResp.HotelIds,
-		InDate:       inDate,
-		OutDate:      outDate,
-		RoomNumber:   1,
+	// hotel profiles
+	profileResp, err := s.profileClient.GetProfiles(ctx, &profile.Request{
+		HotelIds:  searchResp.HotelIds,
+		Locale:    locale,
+		FieldMask: searchProfileFields,
 	})
 	if err != nil {
-		log.Error().Msg("SearchHandler CheckAvailability failed")
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		log.Error().Msg("SearchHandler GetProfiles failed")
+		writeError(w, err)
 		return
 	}
 
-	log.Trace().Msgf("searchHandler gets reserveResp")
-	log.Trace().Msgf("searchHandler gets reserveResp.HotelId = %s", reservationResp.HotelId)
+	log.Trace().Msg("searchHandler gets profileResp")
+
+	details := make(map[string]*search.HotelResult)
+	for _, h := range searchResp.Hotels {
+		details[h.HotelId] = h
+	}
+
+	res := geoJSONResponse(profileResp.Hotels, details)
+	if searchResp.NextPageToken != "" {
+		res["next_page_token"] = searchResp.NextPageToken
+	}
+
+	json.NewEncoder(w).Encode(res)
+}
+
+// boundsHandler returns the hotels within a map viewport, given as
+// bbox=minLon,minLat,maxLon,maxLat, or within a polygon, given as
+// polygon=lat,lon;lat,lon;...
+func (s *Server) boundsHandler(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Access-Control-Allow-Origin", "*")
+	ctx := r.Context()
+
+	var limit int32
+	if sLimit := r.URL.Query().Get("limit"); sLimit != "" {
+		l, err := strconv.ParseInt(sLimit, 10, 32)
+		if err != nil {
+			http.Error(w, "Please check limit format (a number)", http.StatusBadRequest)
+			return
+		}
+		limit = int32(l)
+	}
+
+	var (
+		geoResp *geo.Result
+		err     error
+	)
+	if sPolygon := r.URL.Query().Get("polygon"); sPolygon != "" {
+		vertices := make([]*geo.Point, 0)
+		for _, sVertex := range strings.Split(sPolygon, ";") {
+			coords, ok := parseFloats(sVertex, 2)
+			if !ok {
+				http.Error(w, "Please check polygon format (lat,lon;lat,lon;...)", http.StatusBadRequest)
+				return
+			}
+			vertices = append(vertices, &geo.Point{Lat: float32(coords[0]), Lon: float32(coords[1])})
+		}
+		geoResp, err = s.geoClient.WithinPolygon(ctx, &geo.PolygonRequest{
+			Vertices: vertices,
+			Limit:    limit,
+		})
+	} else {
+		sBbox := r.URL.Query().Get("bbox")
+		if sBbox == "" {
+			http.Error(w, "Please specify bbox or polygon params", http.StatusBadRequest)
+			return
+		}
+		coords, ok := parseFloats(sBbox, 4)
+		if !ok {
+			http.Error(w, "Please check bbox format (minLon,minLat,maxLon,maxLat)", http.StatusBadRequest)
+			return
+		}
+		geoResp, err = s.geoClient.WithinBounds(ctx, &geo.BoundsRequest{
+			MinLon: float32(coords[0]),
+			MinLat: float32(coords[1]),
+			MaxLon: float32(coords[2]),
+			MaxLat: float32(coords[3]),
+			Limit:  limit,
+		})
+	}
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	// grab locale from query params or default to en
+	locale := r.URL.Query().Get("locale")
+	if locale == "" {
+		locale = "en"
+	}
 
 	// hotel profiles
 	profileResp, err := s.profileClient.GetProfiles(ctx, &profile.Request{
-		HotelIds: reservationResp.HotelId,
-		Locale:   locale,
+		HotelIds:  geoResp.HotelIds,
+		Locale:    locale,
+		FieldMask: boundsProfileFields,
 	})
 	if err != nil {
-		log.Error().Msg("SearchHandler GetProfiles failed")
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		writeError(w, err)
 		return
 	}
 
-	log.Trace().Msg("searchHandler gets profileResp")
+	res := geoJSONResponse(profileResp.Hotels, nil)
+	if geoResp.Truncated {
+		res["truncated"] = true
+	}
 
-	json.NewEncoder(w).Encode(geoJSONResponse(profileResp.Hotels))
+	json.NewEncoder(w).Encode(res)
+}
+
+// parseFloats parses n comma separated numbers
+func parseFloats(s string, n int) ([]float64, bool) {
+	parts := strings.Split(s, ",")
+	if len(parts) != n {
+		return nil, false
+	}
+	fs := make([]float64, 0, n)
+	for _, part := range parts {
+		f, err := strconv.ParseFloat(strings.TrimSpace(part), 64)
+		if err != nil {
+			return nil, false
+		}
+		fs = append(fs, f)
+	}
+	return fs, true
 }
 
 func (s *Server) recommendHandler(w http.ResponseWriter, r *http.Request) {
@@ -240,7 +465,7 @@ func (s *Server) recommendHandler(w http.ResponseWriter, r *http.Request) {
 
 	sLat, sLon := r.URL.Query().Get("lat"), r.URL.Query().Get("lon")
 	if sLat == "" || sLon == "" {
-		http.Error(w, "Please specify location params", http.StatusBadRequest)
+		http.Error(w, "Please specify location params (lat/lon or city)", http.StatusBadRequest)
 		return
 	}
 	Lat, _ := strconv.ParseFloat(sLat, 64)
@@ -261,7 +486,7 @@ func (s *Server) recommendHandler(w http.ResponseWriter, r *http.Request) {
 		Lon:     float64(lon),
 	})
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		writeError(w, err)
 		return
 	}
 
@@ -273,15 +498,16 @@ func (s *Server) recommendHandler(w http.ResponseWriter, r *http.Request) {
 
 	// hotel profiles
 	profileResp, err := s.profileClient.GetProfiles(ctx, &profile.Request{
-		HotelIds: recResp.HotelIds,
-		Locale:   locale,
+		HotelIds:  recResp.HotelIds,
+		Locale:    locale,
+		FieldMask: recommendProfileFields,
 	})
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		writeError(w, err)
 		return
 	}
 
-	json.NewEncoder(w).Encode(geoJSONResponse(profileResp.Hotels))
+	json.NewEncoder(w).Encode(geoJSONResponse(profileResp.Hotels, nil))
 }
 
 func (s *Server) userHandler(w http.ResponseWriter, r *http.Request) {
@@ -300,7 +526,7 @@ func (s *Server) userHandler(w http.ResponseWriter, r *http.Request) {
 		Password: password,
 	})
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		writeError(w, err)
 		return
 	}
 
@@ -320,33 +546,177 @@ func (s *Server) reservationHandler(w http.ResponseWriter, r *http.Request) {
 	w.Header().Set("Access-Control-Allow-Origin", "*")
 	ctx := r.Context()
 
+	switch r.Method {
+	case http.MethodDelete:
+		s.cancelReservationHandler(w, r)
+		return
+	case http.MethodPatch:
+		s.modifyReservationHandler(w, r)
+		return
+	}
+
+	req, ok := reservationRequest(w, r)
+	if !ok {
+		return
+	}
+
+	username, password := r.URL.Query().Get("username"), r.URL.Query().Get("password")
+	if username == "" || password == "" {
+		http.Error(w, "Please specify username and password", http.StatusBadRequest)
+		return
+	}
+
+	// Check username and password
+	recResp, err := s.userClient.CheckUser(ctx, &user.Request{
+		Username: username,
+		Password: password,
+	})
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	str := "Reserve successfully!"
+	if recResp.Correct == false {
+		str = "Failed. Please check your username and password. "
+	}
+
+	// retries that pass the same idempotencyKey only reserve once. Keys
+	// are scoped to the customer, only they may use them.
+	if key := r.URL.Query().Get("idempotencyKey"); key != "" {
+		if !recResp.Correct || req.CustomerName != username {
+			http.Error(w, "Idempotency keys can only be used by the customer reserving", http.StatusForbidden)
+			return
+		}
+		req.IdempotencyKey = key
+	}
+
+	// Make reservation
+	resResp, err := s.reservationClient.MakeReservation(ctx, req)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+	if len(resResp.HotelId) == 0 {
+		str = "Failed. Already reserved. "
+	}
+
+	res := map[string]interface{}{
+		"message": str,
+	}
+	if resResp.ReservationId != "" {
+		res["reservationId"] = resResp.ReservationId
+		res["roomType"] = resResp.RoomType
+	}
+
+	json.NewEncoder(w).Encode(res)
+}
+
+func (s *Server) cancelReservationHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	req, ok := existingReservation(w, r)
+	if !ok {
+		return
+	}
+
+	if !s.checkUser(w, r) {
+		return
+	}
+
+	// Cancel reservation
+	resResp, err := s.reservationClient.CancelReservation(ctx, req)
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	res := map[string]interface{}{
+		"message":       "Cancel successfully!",
+		"reservationId": resResp.ReservationId,
+	}
+
+	json.NewEncoder(w).Encode(res)
+}
+
+func (s *Server) modifyReservationHandler(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	req, ok := existingReservation(w, r)
+	if !ok {
+		return
+	}
+
+	// new dates, room number and room type, missing ones stay unchanged
+	newInDate, newOutDate := r.URL.Query().Get("newInDate"), r.URL.Query().Get("newOutDate")
+	if (newInDate != "" && !checkDataFormat(newInDate)) || (newOutDate != "" && !checkDataFormat(newOutDate)) {
+		http.Error(w, "Please check newInDate/newOutDate format (YYYY-MM-DD)", http.StatusBadRequest)
+		return
+	}
+
+	newNumberOfRoom := 0
+	num := r.URL.Query().Get("newNumber")
+	if num != "" {
+		newNumberOfRoom, _ = strconv.Atoi(num)
+	}
+
+	newRoomType := r.URL.Query().Get("newRoomType")
+
+	if newInDate == "" && newOutDate == "" && newNumberOfRoom == 0 && newRoomType == "" {
+		http.Error(w, "Please specify newInDate, newOutDate, newNumber or newRoomType params", http.StatusBadRequest)
+		return
+	}
+
+	if !s.checkUser(w, r) {
+		return
+	}
+
+	// Modify reservation
+	resResp, err := s.reservationClient.ModifyReservation(ctx, &reservation.ModifyRequest{
+		Reservation: req,
+		InDate:      newInDate,
+		OutDate:     newOutDate,
+		RoomNumber:  int32(newNumberOfRoom),
+		RoomType:    newRoomType,
+	})
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	res := map[string]interface{}{
+		"message":       "Modify successfully!",
+		"reservationId": resResp.ReservationId,
+		"roomType":      resResp.RoomType,
+	}
+
+	json.NewEncoder(w).Encode(res)
+}
+
+// reservationRequest reads the reservation described by the query params.
+// It reports a bad request and returns false if a param is missing.
+func reservationRequest(w http.ResponseWriter, r *http.Request) (*reservation.Request, bool) {
 	inDate, outDate := r.URL.Query().Get("inDate"), r.URL.Query().Get("outDate")
 	if inDate == "" || outDate == "" {
 		http.Error(w, "Please specify inDate/outDate params", http.StatusBadRequest)
-		return
+		return nil, false
 	}
 
 	if !checkDataFormat(inDate) || !checkDataFormat(outDate) {
 		http.Error(w, "Please check inDate/outDate format (YYYY-MM-DD)", http.StatusBadRequest)
-		return
+		return nil, false
 	}
 
 	hotelId := r.URL.Query().Get("hotelId")
 	if hotelId == "" {
 		http.Error(w, "Please specify hotelId params", http.StatusBadRequest)
-		return
+		return nil, false
 	}
 
 	customerName := r.URL.Query().Get("customerName")
 	if customerName == "" {
 		http.Error(w, "Please specify customerName params", http.StatusBadRequest)
-		return
-	}
-
-	username, password := r.URL.Query().Get("username"), r.URL.Query().Get("password")
-	if username == "" || password == "" {
-		http.Error(w, "Please specify username and password", http.StatusBadRequest)
-		return
+		return nil, false
 	}
 
 	numberOfRoom := 0
@@ -355,62 +725,269 @@ func (s *Server) reservationHandler(w http.ResponseWriter, r *http.Request) {
 		numberOfRoom, _ = strconv.Atoi(num)
 	}
 
+	return &reservation.Request{
+		CustomerName: customerName,
+		HotelId:      []string{hotelId},
+		InDate:       inDate,
+		OutDate:      outDate,
+		RoomNumber:   int32(numberOfRoom),
+		RoomType:     r.URL.Query().Get("roomType"),
+	}, true
+}
+
+// existingReservation reads the reservation to cancel or modify, either by
+// its reservationId or by the params it was made with. Either way it only
+// matches reservations made in the name of the user.
+func existingReservation(w http.ResponseWriter, r *http.Request) (*reservation.Request, bool) {
+	username := r.URL.Query().Get("username")
+	if reservationId := r.URL.Query().Get("reservationId"); reservationId != "" {
+		return &reservation.Request{
+			ReservationId: reservationId,
+			CustomerName:  username,
+		}, true
+	}
+
+	req, ok := reservationRequest(w, r)
+	if !ok {
+		return nil, false
+	}
+	if req.CustomerName != username {
+		http.Error(w, "Reservations can only be changed by the customer who made them", http.StatusForbidden)
+		return nil, false
+	}
+	return req, true
+}
+
+// checkUser checks the username and password query params against the
+// user service. It writes the response and returns false unless they are
+// correct.
+func (s *Server) checkUser(w http.ResponseWriter, r *http.Request) bool {
+	username, password := r.URL.Query().Get("username"), r.URL.Query().Get("password")
+	if username == "" || password == "" {
+		http.Error(w, "Please specify username and password", http.StatusBadRequest)
+		return false
+	}
+
 	// Check username and password
-	recResp, err := s.userClient.CheckUser(ctx, &user.Request{
+	recResp, err := s.userClient.CheckUser(r.Context(), &user.Request{
 		Username: username,
 		Password: password,
 	})
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
-		return
+		writeError(w, err)
+		return false
 	}
 
-	str := "Reserve successfully!"
 	if recResp.Correct == false {
-		str = "Failed. Please check your username and password. "
+		res := map[string]interface{}{
+			"message": "Failed. Please check your username and password. ",
+		}
+		json.NewEncoder(w).Encode(res)
+		return false
 	}
 
-	// Make reservation
-	resResp, err := s.reservationClient.MakeReservation(ctx, &reservation.Request{
-		CustomerName: customerName,
-		HotelId:      []string{hotelId},
-		InDate:       inDate,
-		OutDate:      outDate,
-		RoomNumber:   int32(numberOfRoom),
+	return true
+}
+
+func (s *Server) reservationsHandler(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Access-Control-Allow-Origin", "*")
+	ctx := r.Context()
+
+	if !s.checkUser(w, r) {
+		return
+	}
+
+	// users only get to see their own reservations
+	username := r.URL.Query().Get("username")
+
+	reservationId := r.URL.Query().Get("reservationId")
+	if reservationId != "" {
+		resResp, err := s.reservationClient.GetReservation(ctx, &reservation.GetRequest{
+			ReservationId: reservationId,
+		})
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+		if resResp.CustomerName != username {
+			writeError(w, status.Errorf(codes.NotFound, "reservation %s not found", reservationId))
+			return
+		}
+
+		json.NewEncoder(w).Encode(resResp)
+		return
+	}
+
+	listResp, err := s.reservationClient.ListReservationsByCustomer(ctx, &reservation.CustomerRequest{
+		CustomerName: username,
 	})
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		writeError(w, err)
 		return
 	}
-	if len(resResp.HotelId) == 0 {
-		str = "Failed. Already reserved. "
-	}
 
 	res := map[string]interface{}{
-		"message": str,
+		"reservations": listResp.Reservations,
 	}
 
 	json.NewEncoder(w).Encode(res)
 }
 
+// availabilityHandler returns the rooms left per night of one or more
+// comma separated hotels
+func (s *Server) availabilityHandler(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Access-Control-Allow-Origin", "*")
+	ctx := r.Context()
+
+	inDate, outDate := r.URL.Query().Get("inDate"), r.URL.Query().Get("outDate")
+	if inDate == "" || outDate == "" {
+		http.Error(w, "Please specify inDate/outDate params", http.StatusBadRequest)
+		return
+	}
+
+	if !checkDataFormat(inDate) || !checkDataFormat(outDate) {
+		http.Error(w, "Please check inDate/outDate format (YYYY-MM-DD)", http.StatusBadRequest)
+		return
+	}
+
+	hotelIds := make([]string, 0)
+	for _, hotelId := range strings.Split(r.URL.Query().Get("hotelId"), ",") {
+		if hotelId != "" {
+			hotelIds = append(hotelIds, hotelId)
+		}
+	}
+	if len(hotelIds) == 0 {
+		http.Error(w, "Please specify hotelId params", http.StatusBadRequest)
+		return
+	}
+	if len(hotelIds) > maxAvailabilityHotels {
+		http.Error(w, fmt.Sprintf("Please specify at most %d hotelId params", maxAvailabilityHotels), http.StatusBadRequest)
+		return
+	}
+
+	calendarResp, err := s.reservationClient.GetAvailabilityCalendar(ctx, &reservation.CalendarRequest{
+		HotelId:  hotelIds,
+		InDate:   inDate,
+		OutDate:  outDate,
+		RoomType: r.URL.Query().Get("roomType"),
+	})
+	if err != nil {
+		writeError(w, err)
+		return
+	}
+
+	json.NewEncoder(w).Encode(calendarOf(calendarResp))
+}
+
+// maxAvailabilityHotels bounds the hotels of a single /availability request
+const maxAvailabilityHotels = 50
+
+// calendar is the JSON of an availability calendar. Unlike the generated
+// structs it keeps zero counts, a sold out night has 0 roomsLeft.
+type calendar struct {
+	Hotels []hotelCalendar `json:"hotels"`
+}
+
+type hotelCalendar struct {
+	HotelId string              `json:"hotelId"`
+	Nights  []nightAvailability `json:"nights"`
+}
+
+type nightAvailability struct {
+	Date      string                 `json:"date"`
+	RoomsLeft int32                  `json:"roomsLeft"`
+	RoomTypes []roomTypeAvailability `json:"roomTypes"`
+}
+
+type roomTypeAvailability struct {
+	RoomType  string `json:"roomType"`
+	RoomsLeft int32  `json:"roomsLeft"`
+	Capacity  int32  `json:"capacity"`
+}
+
+func calendarOf(cal *reservation.Calendar) calendar {
+	res := calendar{Hotels: make([]hotelCalendar, 0, len(cal.Hotels))}
+	for _, h := range cal.Hotels {
+		hotel := hotelCalendar{HotelId: h.HotelId, Nights: make([]nightAvailability, 0, len(h.Nights))}
+		for _, n := range h.Nights {
+			night := nightAvailability{Date: n.Date, RoomsLeft: n.RoomsLeft, RoomTypes: make([]roomTypeAvailability, 0, len(n.RoomTypes))}
+			for _, rt := range n.RoomTypes {
+				night.RoomTypes = append(night.RoomTypes, roomTypeAvailability{
+					RoomType:  rt.RoomType,
+					RoomsLeft: rt.RoomsLeft,
+					Capacity:  rt.Capacity,
+				})
+			}
+			hotel.Nights = append(hotel.Nights, night)
+		}
+		res.Hotels = append(res.Hotels, hotel)
+	}
+	return res
+}
+
+// The profile fields each endpoint shows. Search results list hotels with
+// their thumbnail, the map viewport only plots them, and recommendations
+// describe them in full.
+var (
+	searchProfileFields = &profile.FieldMask{Paths: []string{
+		"name", "phoneNumber", "amenities", "images", "locale",
+		"address.lat", "address.lon", "address.formatted",
+	}}
+	boundsProfileFields = &profile.FieldMask{Paths: []string{
+		"name", "phoneNumber", "address.lat", "address.lon",
+	}}
+	recommendProfileFields = &profile.FieldMask{Paths: []string{
+		"name", "phoneNumber", "description", "amenities", "images", "locale",
+		"address.lat", "address.lon", "address.formatted",
+	}}
+)
+
 // return a geoJSON response that allows google map to plot points directly on map
 // https://developers.google.com/maps/documentation/javascript/datalayer#sample_geojson
-func geoJSONResponse(hs []*profile.Hotel) map[string]interface{} {
+// The search details of a hotel in details, if any, are added to its
+// properties. Profile fields the endpoint didn't ask for are left out.
+func geoJSONResponse(hs []*profile.Hotel, details map[string]*search.HotelResult) map[string]interface{} {
 	fs := []interface{}{}
 
 	for _, h := range hs {
+		properties := map[string]interface{}{
+			"name":         h.Name,
+			"phone_number": h.PhoneNumber,
+		}
+		if h.Description != "" {
+			properties["description"] = h.Description
+		}
+		if h.GetAddress().GetFormatted() != "" {
+			properties["address"] = h.Address.Formatted
+		}
+		if h.Amenities != nil {
+			properties["amenities"] = h.Amenities
+		}
+		if h.Locale != "" {
+			properties["locale"] = h.Locale
+		}
+		if image := defaultImage(h); image != nil {
+			properties["image"] = image.Url
+			properties["thumbnail"] = image.ThumbnailUrl
+		}
+		if d, ok := details[h.Id]; ok {
+			properties["score"] = d.Score
+			properties["rate_plan"] = d.RatePlanCode
+			properties["price"] = d.Price
+			properties["currency"] = d.Currency
+			properties["distance_km"] = d.Distance
+			properties["room_types"] = d.RoomTypes
+		}
+
 		fs = append(fs, map[string]interface{}{
-			"type": "Feature",
-			"id":   h.Id,
-			"properties": map[string]string{
-				"name":         h.Name,
-				"phone_number": h.PhoneNumber,
-			},
+			"type":       "Feature",
+			"id":         h.Id,
+			"properties": properties,
 			"geometry": map[string]interface{}{
 				"type": "Point",
 				"coordinates": []float32{
-					h.Address.Lon,
-					h.Address.Lat,
+					h.GetAddress().GetLon(),
+					h.GetAddress().GetLat(),
 				},
 			},
 		})
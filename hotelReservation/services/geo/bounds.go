@@ -0,0 +1,196 @@
+package geo
+
+import (
+	"math"
+
+	"github.com/hailocab/go-geoindex"
+	"github.com/harlow/go-micro-services/rpcerr"
+	pb "github.com/harlow/go-micro-services/services/geo/proto"
+	"github.com/rs/zerolog/log"
+	"golang.org/x/net/context"
+)
+
+const (
+	defaultBoundsLimit = 100
+	maxBoundsLimit     = 1000
+
+	// maxBoundsDiagonal bounds the area searched, in km. The index is
+	// searched cell by cell, so its cost grows with the area.
+	maxBoundsDiagonal = 2 * maxSearchRadius
+
+	maxPolygonVertices = 100
+)
+
+// bounds is a bounding box
+type bounds struct {
+	minLat, minLon, maxLat, maxLon float64
+}
+
+// WithinBounds returns the hotels within a bounding box
+func (s *Server) WithinBounds(ctx context.Context, req *pb.BoundsRequest) (*pb.Result, error) {
+	log.Trace().Msgf("In geo WithinBounds")
+
+	b := bounds{
+		minLat: float64(req.MinLat),
+		minLon: float64(req.MinLon),
+		maxLat: float64(req.MaxLat),
+		maxLon: float64(req.MaxLon),
+	}
+	if err := checkBounds(b); err != nil {
+		return nil, err
+	}
+	limit, err := limitOf(req.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return resultWithin(b, s.getPointsWithin(b), limit), nil
+}
+
+// WithinPolygon returns the hotels within a polygon
+func (s *Server) WithinPolygon(ctx context.Context, req *pb.PolygonRequest) (*pb.Result, error) {
+	log.Trace().Msgf("In geo WithinPolygon, vertices = %d", len(req.Vertices))
+
+	if len(req.Vertices) < 3 {
+		return nil, rpcerr.InvalidArgument("vertices", "a polygon has at least 3 vertices, got %d", len(req.Vertices))
+	}
+	if len(req.Vertices) > maxPolygonVertices {
+		return nil, rpcerr.InvalidArgument("vertices", "a polygon has at most %d vertices, got %d", maxPolygonVertices, len(req.Vertices))
+	}
+	b := bounds{minLat: 90, minLon: 180, maxLat: -90, maxLon: -180}
+	for _, v := range req.Vertices {
+		lat, lon := float64(v.Lat), float64(v.Lon)
+		if lat < -90 || lat > 90 {
+			return nil, rpcerr.InvalidArgument("vertices", "lat %v is not between -90 and 90", v.Lat)
+		}
+		if lon < -180 || lon > 180 {
+			return nil, rpcerr.InvalidArgument("vertices", "lon %v is not between -180 and 180", v.Lon)
+		}
+		if lat < b.minLat {
+			b.minLat = lat
+		}
+		if lat > b.maxLat {
+			b.maxLat = lat
+		}
+		if lon < b.minLon {
+			b.minLon = lon
+		}
+		if lon > b.maxLon {
+			b.maxLon = lon
+		}
+	}
+	if err := checkBounds(b); err != nil {
+		return nil, err
+	}
+	limit, err := limitOf(req.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	points := make([]geoindex.Point, 0)
+	for _, p := range s.getPointsWithin(b) {
+		if inPolygon(req.Vertices, p.Lat(), p.Lon()) {
+			points = append(points, p)
+		}
+	}
+	return resultWithin(b, points, limit), nil
+}
+
+// checkBounds validates a bounding box
+func checkBounds(b bounds) error {
+	if b.minLat < -90 || b.maxLat > 90 {
+		return rpcerr.InvalidArgument("lat", "bounds from %v to %v are not between -90 and 90", b.minLat, b.maxLat)
+	}
+	if b.minLon < -180 || b.maxLon > 180 {
+		return rpcerr.InvalidArgument("lon", "bounds from %v to %v are not between -180 and 180", b.minLon, b.maxLon)
+	}
+	if b.minLat > b.maxLat {
+		return rpcerr.InvalidArgument("maxLat", "%v is below minLat %v", b.maxLat, b.minLat)
+	}
+	if b.minLon > b.maxLon {
+		return rpcerr.InvalidArgument("maxLon", "%v is west of minLon %v, bounds can't cross the antimeridian", b.maxLon, b.minLon)
+	}
+	diagonal := geoindex.Distance(
+		&geoindex.GeoPoint{Plat: b.minLat, Plon: b.minLon},
+		&geoindex.GeoPoint{Plat: b.maxLat, Plon: b.maxLon},
+	) / 1000
+	if diagonal > maxBoundsDiagonal {
+		return rpcerr.InvalidArgument("bounds", "the diagonal of %.0fkm is larger than %dkm", diagonal, maxBoundsDiagonal)
+	}
+	return nil
+}
+
+// boundsAround returns a bounding box holding the circle of radius km
+// around lat/lon. It is cut off at the poles and at the antimeridian.
+func boundsAround(lat, lon, radius float64) bounds {
+	// a degree of latitude is at least 110.5km long
+	dLat := radius / 110.5
+	dLon := 180.0
+	if cos := math.Cos(lat * math.Pi / 180); cos > dLat/180 {
+		dLon = math.Min(dLat/cos, 180)
+	}
+	return bounds{
+		minLat: math.Max(lat-dLat, -90),
+		minLon: math.Max(lon-dLon, -180),
+		maxLat: math.Min(lat+dLat, 90),
+		maxLon: math.Min(lon+dLon, 180),
+	}
+}
+
+// limitOf returns the number of hotels to return
+func limitOf(limit int32) (int, error) {
+	switch {
+	case limit < 0:
+		return 0, rpcerr.InvalidArgument("limit", "%d is negative", limit)
+	case limit == 0:
+		return defaultBoundsLimit, nil
+	case limit > maxBoundsLimit:
+		return maxBoundsLimit, nil
+	}
+	return int(limit), nil
+}
+
+// getPointsWithin returns the points within a bounding box
+func (s *Server) getPointsWithin(b bounds) []geoindex.Point {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.index.Range(
+		&geoindex.GeoPoint{Plat: b.maxLat, Plon: b.minLon},
+		&geoindex.GeoPoint{Plat: b.minLat, Plon: b.maxLon},
+	)
+}
+
+// resultWithin returns at most limit of points, nearest to the center of
+// the bounding box first.
+func resultWithin(b bounds, points []geoindex.Point, limit int) *pb.Result {
+	center := &geoindex.GeoPoint{Plat: (b.minLat + b.maxLat) / 2, Plon: (b.minLon + b.maxLon) / 2}
+
+	sortNearestFirst(center, points)
+
+	res := new(pb.Result)
+	if len(points) > limit {
+		points = points[:limit]
+		res.Truncated = true
+	}
+	for _, p := range points {
+		res.HotelIds = append(res.HotelIds, p.Id())
+		res.Distances = append(res.Distances, float32(geoindex.Distance(center, p)/1000))
+	}
+	return res
+}
+
+// inPolygon tells whether lat/lon is within the polygon of vertices, by
+// counting how many of its edges a ray going east from lat/lon crosses.
+func inPolygon(vertices []*pb.Point, lat, lon float64) bool {
+	in := false
+	for i, j := 0, len(vertices)-1; i < len(vertices); j, i = i, i+1 {
+		lat1, lon1 := float64(vertices[i].Lat), float64(vertices[i].Lon)
+		lat2, lon2 := float64(vertices[j].Lat), float64(vertices[j].Lon)
+		if (lat1 > lat) != (lat2 > lat) &&
+			lon < lon1+(lat-lat1)*(lon2-lon1)/(lat2-lat1) {
+			in = !in
+		}
+	}
+	return in
+}
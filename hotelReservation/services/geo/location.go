@@ -0,0 +1,136 @@
+package geo
+
+import (
+	"time"
+
+	"github.com/hailocab/go-geoindex"
+	"github.com/harlow/go-micro-services/rpcerr"
+	pb "github.com/harlow/go-micro-services/services/geo/proto"
+	"github.com/rs/zerolog/log"
+	"golang.org/x/net/context"
+)
+
+// resyncInterval is how often the index is rebuilt from the store. It picks
+// up locations changed through other replicas, which only update their own
+// index.
+const resyncInterval = 5 * time.Minute
+
+// AddHotelLocation adds the location of a new hotel
+func (s *Server) AddHotelLocation(ctx context.Context, req *pb.Location) (*pb.Location, error) {
+	p, err := pointOf(req)
+	if err != nil {
+		return nil, err
+	}
+
+	ok, err := s.Store.AddPoint(p)
+	if err != nil {
+		log.Error().Msgf("Tried to add location of hotelId [%v], but got error = %s", req.HotelId, err)
+		return nil, rpcerr.Mongo(err)
+	}
+	if !ok {
+		return nil, rpcerr.AlreadyExists("hotel location", req.HotelId)
+	}
+
+	s.updateIndex(func(index *geoindex.PointsIndex) {
+		index.Add(p)
+	})
+	return req, nil
+}
+
+// MoveHotelLocation changes the location of a hotel
+func (s *Server) MoveHotelLocation(ctx context.Context, req *pb.Location) (*pb.Location, error) {
+	p, err := pointOf(req)
+	if err != nil {
+		return nil, err
+	}
+
+	ok, err := s.Store.MovePoint(p)
+	if err != nil {
+		log.Error().Msgf("Tried to move location of hotelId [%v], but got error = %s", req.HotelId, err)
+		return nil, rpcerr.Mongo(err)
+	}
+	if !ok {
+		return nil, rpcerr.NotFound("hotel location", req.HotelId)
+	}
+
+	// Add replaces the point of the same hotel
+	s.updateIndex(func(index *geoindex.PointsIndex) {
+		index.Add(p)
+	})
+	return req, nil
+}
+
+// RemoveHotelLocation removes the location of a hotel
+func (s *Server) RemoveHotelLocation(ctx context.Context, req *pb.Location) (*pb.Location, error) {
+	if req.HotelId == "" {
+		return nil, rpcerr.InvalidArgument("hotelId", "no hotel given")
+	}
+
+	ok, err := s.Store.RemovePoint(req.HotelId)
+	if err != nil {
+		log.Error().Msgf("Tried to remove location of hotelId [%v], but got error = %s", req.HotelId, err)
+		return nil, rpcerr.Mongo(err)
+	}
+	if !ok {
+		return nil, rpcerr.NotFound("hotel location", req.HotelId)
+	}
+
+	s.updateIndex(func(index *geoindex.PointsIndex) {
+		index.Remove(req.HotelId)
+	})
+	return req, nil
+}
+
+// pointOf validates a location and returns its point
+func pointOf(req *pb.Location) (*point, error) {
+	if req.HotelId == "" {
+		return nil, rpcerr.InvalidArgument("hotelId", "no hotel given")
+	}
+	if req.Lat < -90 || req.Lat > 90 {
+		return nil, rpcerr.InvalidArgument("lat", "%v is not between -90 and 90", req.Lat)
+	}
+	if req.Lon < -180 || req.Lon > 180 {
+		return nil, rpcerr.InvalidArgument("lon", "%v is not between -180 and 180", req.Lon)
+	}
+	return &point{Pid: req.HotelId, Plat: float64(req.Lat), Plon: float64(req.Lon)}, nil
+}
+
+// updateIndex applies a change to the index. Changes are made after the
+// store has been updated, so a resync never loses them.
+func (s *Server) updateIndex(update func(index *geoindex.PointsIndex)) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	update(s.index)
+	s.version++
+}
+
+// resyncIndex periodically replaces the index with a new one loaded from
+// the store. A new index is only swapped in if the index has not been
+// updated while it was loaded, since it might miss that update otherwise;
+// the next resync tries again.
+func (s *Server) resyncIndex() {
+	ticker := time.NewTicker(resyncInterval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		s.mu.RLock()
+		version := s.version
+		s.mu.RUnlock()
+
+		index, err := loadGeoIndex(s.Store)
+		if err != nil {
+			log.Error().Msgf("Tried to resync geo index, but got error = %s", err)
+			continue
+		}
+
+		s.mu.Lock()
+		if s.version == version {
+			s.index = index
+			log.Trace().Msg("geo index resynced")
+		} else {
+			log.Trace().Msg("geo index updated during resync, keeping it")
+		}
+		s.mu.Unlock()
+	}
+}
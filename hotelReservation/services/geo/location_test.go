@@ -0,0 +1,110 @@
+package geo
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	pb "github.com/harlow/go-micro-services/services/geo/proto"
+	"golang.org/x/net/context"
+)
+
+const (
+	parallelWriters = 8
+	writesEach      = 50
+	parallelReaders = 8
+
+	movedLat = 37.79
+)
+
+// TestNearbyWhileLocationsChange searches while other goroutines add, move
+// and remove hotels. Run it with -race.
+func TestNearbyWhileLocationsChange(t *testing.T) {
+	s := &Server{Store: NewMemoryStore()}
+	s.index = newGeoIndex(s.Store)
+	ctx := context.Background()
+
+	done := make(chan struct{})
+	var readers sync.WaitGroup
+	for i := 0; i < parallelReaders; i++ {
+		readers.Add(1)
+		go func() {
+			defer readers.Done()
+			for {
+				select {
+				case <-done:
+					return
+				default:
+				}
+				res, err := s.Nearby(ctx, &pb.Request{Lat: 37.7867, Lon: -122.4112, PageSize: maxPageSize})
+				if err != nil {
+					t.Error(err)
+					return
+				}
+				seen := make(map[string]bool)
+				for _, hotelId := range res.HotelIds {
+					if seen[hotelId] {
+						t.Errorf("hotel %s found twice", hotelId)
+					}
+					seen[hotelId] = true
+				}
+				// no one touches hotel 1
+				if !seen["1"] {
+					t.Errorf("hotel 1 not found among %v", res.HotelIds)
+				}
+			}
+		}()
+	}
+
+	var writers sync.WaitGroup
+	for i := 0; i < parallelWriters; i++ {
+		writers.Add(1)
+		go func(i int) {
+			defer writers.Done()
+			for j := 0; j < writesEach; j++ {
+				loc := &pb.Location{HotelId: fmt.Sprintf("new-%d-%d", i, j), Lat: 37.78, Lon: -122.40}
+				if _, err := s.AddHotelLocation(ctx, loc); err != nil {
+					t.Error(err)
+					return
+				}
+				loc.Lat = movedLat
+				if _, err := s.MoveHotelLocation(ctx, loc); err != nil {
+					t.Error(err)
+					return
+				}
+				// keep every other hotel
+				if j%2 == 1 {
+					if _, err := s.RemoveHotelLocation(ctx, loc); err != nil {
+						t.Error(err)
+						return
+					}
+				}
+			}
+		}(i)
+	}
+	writers.Wait()
+	close(done)
+	readers.Wait()
+
+	points, err := s.Store.Points()
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if indexed := len(s.index.GetAll()); indexed != len(points) {
+		t.Errorf("%d hotels indexed, %d stored", indexed, len(points))
+	}
+	for i := 0; i < parallelWriters; i++ {
+		for j := 0; j < writesEach; j++ {
+			hotelId := fmt.Sprintf("new-%d-%d", i, j)
+			p := s.index.Get(hotelId)
+			if j%2 == 1 && p != nil {
+				t.Errorf("removed hotel %s still indexed", hotelId)
+			}
+			if j%2 == 0 && (p == nil || p.Lat() != float64(float32(movedLat))) {
+				t.Errorf("hotel %s indexed at %v, want it moved", hotelId, p)
+			}
+		}
+	}
+}
@@ -10,6 +10,10 @@ It is generated from these files:
 It has these top-level messages:
 	Request
 	Result
+	Location
+	Point
+	BoundsRequest
+	PolygonRequest
 */
 package geo
 
@@ -37,6 +41,12 @@ const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
 type Request struct {
 	Lat float32 `protobuf:"fixed32,1,opt,name=lat" json:"lat,omitempty"`
 	Lon float32 `protobuf:"fixed32,2,opt,name=lon" json:"lon,omitempty"`
+	// search radius in km, 0 for the default of 10km
+	Radius float32 `protobuf:"fixed32,3,opt,name=radius" json:"radius,omitempty"`
+	// maximum number of hotels returned, 0 for the default of 5
+	PageSize int32 `protobuf:"varint,4,opt,name=pageSize" json:"pageSize,omitempty"`
+	// nextPageToken of the previous page, empty for the first page
+	PageToken string `protobuf:"bytes,5,opt,name=pageToken" json:"pageToken,omitempty"`
 }
 
 func (m *Request) Reset()                    { *m = Request{} }
@@ -58,8 +68,36 @@ func (m *Request) GetLon() float32 {
 	return 0
 }
 
+func (m *Request) GetRadius() float32 {
+	if m != nil {
+		return m.Radius
+	}
+	return 0
+}
+
+func (m *Request) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *Request) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
+	}
+	return ""
+}
+
 type Result struct {
 	HotelIds []string `protobuf:"bytes,1,rep,name=hotelIds" json:"hotelIds,omitempty"`
+	// distance in km of each of hotelIds from the requested lat/lon, or from
+	// the center of the requested bounds
+	Distances []float32 `protobuf:"fixed32,2,rep,name=distances" json:"distances,omitempty"`
+	// token of the next page, empty on the last page
+	NextPageToken string `protobuf:"bytes,3,opt,name=nextPageToken" json:"nextPageToken,omitempty"`
+	// set if more hotels than the requested limit are within the bounds
+	Truncated bool `protobuf:"varint,4,opt,name=truncated" json:"truncated,omitempty"`
 }
 
 func (m *Result) Reset()                    { *m = Result{} }
@@ -74,9 +112,167 @@ func (m *Result) GetHotelIds() []string {
 	return nil
 }
 
+func (m *Result) GetDistances() []float32 {
+	if m != nil {
+		return m.Distances
+	}
+	return nil
+}
+
+func (m *Result) GetNextPageToken() string {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return ""
+}
+
+func (m *Result) GetTruncated() bool {
+	if m != nil {
+		return m.Truncated
+	}
+	return false
+}
+
+type Location struct {
+	HotelId string  `protobuf:"bytes,1,opt,name=hotelId" json:"hotelId,omitempty"`
+	Lat     float32 `protobuf:"fixed32,2,opt,name=lat" json:"lat,omitempty"`
+	Lon     float32 `protobuf:"fixed32,3,opt,name=lon" json:"lon,omitempty"`
+}
+
+func (m *Location) Reset()                    { *m = Location{} }
+func (m *Location) String() string            { return proto.CompactTextString(m) }
+func (*Location) ProtoMessage()               {}
+func (*Location) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{2} }
+
+func (m *Location) GetHotelId() string {
+	if m != nil {
+		return m.HotelId
+	}
+	return ""
+}
+
+func (m *Location) GetLat() float32 {
+	if m != nil {
+		return m.Lat
+	}
+	return 0
+}
+
+func (m *Location) GetLon() float32 {
+	if m != nil {
+		return m.Lon
+	}
+	return 0
+}
+
+type Point struct {
+	Lat float32 `protobuf:"fixed32,1,opt,name=lat" json:"lat,omitempty"`
+	Lon float32 `protobuf:"fixed32,2,opt,name=lon" json:"lon,omitempty"`
+}
+
+func (m *Point) Reset()                    { *m = Point{} }
+func (m *Point) String() string            { return proto.CompactTextString(m) }
+func (*Point) ProtoMessage()               {}
+func (*Point) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{3} }
+
+func (m *Point) GetLat() float32 {
+	if m != nil {
+		return m.Lat
+	}
+	return 0
+}
+
+func (m *Point) GetLon() float32 {
+	if m != nil {
+		return m.Lon
+	}
+	return 0
+}
+
+// A bounding box, which may not cross the antimeridian.
+type BoundsRequest struct {
+	MinLat float32 `protobuf:"fixed32,1,opt,name=minLat" json:"minLat,omitempty"`
+	MinLon float32 `protobuf:"fixed32,2,opt,name=minLon" json:"minLon,omitempty"`
+	MaxLat float32 `protobuf:"fixed32,3,opt,name=maxLat" json:"maxLat,omitempty"`
+	MaxLon float32 `protobuf:"fixed32,4,opt,name=maxLon" json:"maxLon,omitempty"`
+	// maximum number of hotels returned, 0 for the default of 100
+	Limit int32 `protobuf:"varint,5,opt,name=limit" json:"limit,omitempty"`
+}
+
+func (m *BoundsRequest) Reset()                    { *m = BoundsRequest{} }
+func (m *BoundsRequest) String() string            { return proto.CompactTextString(m) }
+func (*BoundsRequest) ProtoMessage()               {}
+func (*BoundsRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{4} }
+
+func (m *BoundsRequest) GetMinLat() float32 {
+	if m != nil {
+		return m.MinLat
+	}
+	return 0
+}
+
+func (m *BoundsRequest) GetMinLon() float32 {
+	if m != nil {
+		return m.MinLon
+	}
+	return 0
+}
+
+func (m *BoundsRequest) GetMaxLat() float32 {
+	if m != nil {
+		return m.MaxLat
+	}
+	return 0
+}
+
+func (m *BoundsRequest) GetMaxLon() float32 {
+	if m != nil {
+		return m.MaxLon
+	}
+	return 0
+}
+
+func (m *BoundsRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type PolygonRequest struct {
+	// at least 3 vertices of a simple polygon, in order; it is closed
+	// implicitly
+	Vertices []*Point `protobuf:"bytes,1,rep,name=vertices" json:"vertices,omitempty"`
+	// maximum number of hotels returned, 0 for the default of 100
+	Limit int32 `protobuf:"varint,2,opt,name=limit" json:"limit,omitempty"`
+}
+
+func (m *PolygonRequest) Reset()                    { *m = PolygonRequest{} }
+func (m *PolygonRequest) String() string            { return proto.CompactTextString(m) }
+func (*PolygonRequest) ProtoMessage()               {}
+func (*PolygonRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{5} }
+
+func (m *PolygonRequest) GetVertices() []*Point {
+	if m != nil {
+		return m.Vertices
+	}
+	return nil
+}
+
+func (m *PolygonRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Request)(nil), "geo.Request")
 	proto.RegisterType((*Result)(nil), "geo.Result")
+	proto.RegisterType((*Location)(nil), "geo.Location")
+	proto.RegisterType((*Point)(nil), "geo.Point")
+	proto.RegisterType((*BoundsRequest)(nil), "geo.BoundsRequest")
+	proto.RegisterType((*PolygonRequest)(nil), "geo.PolygonRequest")
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -92,6 +288,19 @@ const _ = grpc.SupportPackageIsVersion4
 type GeoClient interface {
 	// Finds the hotels contained nearby the current lat/lon.
 	Nearby(ctx context.Context, in *Request, opts ...grpc.CallOption) (*Result, error)
+	// AddHotelLocation adds the location of a new hotel.
+	AddHotelLocation(ctx context.Context, in *Location, opts ...grpc.CallOption) (*Location, error)
+	// MoveHotelLocation changes the location of a hotel.
+	MoveHotelLocation(ctx context.Context, in *Location, opts ...grpc.CallOption) (*Location, error)
+	// RemoveHotelLocation removes the location of a hotel, only hotelId is
+	// looked at.
+	RemoveHotelLocation(ctx context.Context, in *Location, opts ...grpc.CallOption) (*Location, error)
+	// WithinBounds finds the hotels within a bounding box, nearest to its
+	// center first.
+	WithinBounds(ctx context.Context, in *BoundsRequest, opts ...grpc.CallOption) (*Result, error)
+	// WithinPolygon finds the hotels within a polygon, nearest to the center
+	// of its bounding box first.
+	WithinPolygon(ctx context.Context, in *PolygonRequest, opts ...grpc.CallOption) (*Result, error)
 }
 
 type geoClient struct {
@@ -111,11 +320,69 @@ func (c *geoClient) Nearby(ctx context.Context, in *Request, opts ...grpc.CallOp
 	return out, nil
 }
 
+func (c *geoClient) AddHotelLocation(ctx context.Context, in *Location, opts ...grpc.CallOption) (*Location, error) {
+	out := new(Location)
+	err := grpc.Invoke(ctx, "/geo.Geo/AddHotelLocation", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *geoClient) MoveHotelLocation(ctx context.Context, in *Location, opts ...grpc.CallOption) (*Location, error) {
+	out := new(Location)
+	err := grpc.Invoke(ctx, "/geo.Geo/MoveHotelLocation", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *geoClient) RemoveHotelLocation(ctx context.Context, in *Location, opts ...grpc.CallOption) (*Location, error) {
+	out := new(Location)
+	err := grpc.Invoke(ctx, "/geo.Geo/RemoveHotelLocation", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *geoClient) WithinBounds(ctx context.Context, in *BoundsRequest, opts ...grpc.CallOption) (*Result, error) {
+	out := new(Result)
+	err := grpc.Invoke(ctx, "/geo.Geo/WithinBounds", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *geoClient) WithinPolygon(ctx context.Context, in *PolygonRequest, opts ...grpc.CallOption) (*Result, error) {
+	out := new(Result)
+	err := grpc.Invoke(ctx, "/geo.Geo/WithinPolygon", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for Geo service
 
 type GeoServer interface {
 	// Finds the hotels contained nearby the current lat/lon.
 	Nearby(context.Context, *Request) (*Result, error)
+	// AddHotelLocation adds the location of a new hotel.
+	AddHotelLocation(context.Context, *Location) (*Location, error)
+	// MoveHotelLocation changes the location of a hotel.
+	MoveHotelLocation(context.Context, *Location) (*Location, error)
+	// RemoveHotelLocation removes the location of a hotel, only hotelId is
+	// looked at.
+	RemoveHotelLocation(context.Context, *Location) (*Location, error)
+	// WithinBounds finds the hotels within a bounding box, nearest to its
+	// center first.
+	WithinBounds(context.Context, *BoundsRequest) (*Result, error)
+	// WithinPolygon finds the hotels within a polygon, nearest to the center
+	// of its bounding box first.
+	WithinPolygon(context.Context, *PolygonRequest) (*Result, error)
 }
 
 func RegisterGeoServer(s *grpc.Server, srv GeoServer) {
@@ -140,6 +407,96 @@ func _Geo_Nearby_Handler(srv interface{}, ctx context.Context, dec func(interfac
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Geo_AddHotelLocation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Location)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GeoServer).AddHotelLocation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/geo.Geo/AddHotelLocation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GeoServer).AddHotelLocation(ctx, req.(*Location))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Geo_MoveHotelLocation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Location)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GeoServer).MoveHotelLocation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/geo.Geo/MoveHotelLocation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GeoServer).MoveHotelLocation(ctx, req.(*Location))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Geo_RemoveHotelLocation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Location)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GeoServer).RemoveHotelLocation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/geo.Geo/RemoveHotelLocation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GeoServer).RemoveHotelLocation(ctx, req.(*Location))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Geo_WithinBounds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BoundsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GeoServer).WithinBounds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/geo.Geo/WithinBounds",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GeoServer).WithinBounds(ctx, req.(*BoundsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Geo_WithinPolygon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PolygonRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GeoServer).WithinPolygon(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/geo.Geo/WithinPolygon",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GeoServer).WithinPolygon(ctx, req.(*PolygonRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Geo_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "geo.Geo",
 	HandlerType: (*GeoServer)(nil),
@@ -148,6 +505,26 @@ var _Geo_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Nearby",
 			Handler:    _Geo_Nearby_Handler,
 		},
+		{
+			MethodName: "AddHotelLocation",
+			Handler:    _Geo_AddHotelLocation_Handler,
+		},
+		{
+			MethodName: "MoveHotelLocation",
+			Handler:    _Geo_MoveHotelLocation_Handler,
+		},
+		{
+			MethodName: "RemoveHotelLocation",
+			Handler:    _Geo_RemoveHotelLocation_Handler,
+		},
+		{
+			MethodName: "WithinBounds",
+			Handler:    _Geo_WithinBounds_Handler,
+		},
+		{
+			MethodName: "WithinPolygon",
+			Handler:    _Geo_WithinPolygon_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "services/geo/proto/geo.proto",
@@ -156,15 +533,34 @@ var _Geo_serviceDesc = grpc.ServiceDesc{
 func init() { proto.RegisterFile("services/geo/proto/geo.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 156 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x29, 0x4e, 0x2d, 0x2a,
-	0xcb, 0x4c, 0x4e, 0x2d, 0xd6, 0x4f, 0x4f, 0xcd, 0xd7, 0x2f, 0x28, 0xca, 0x2f, 0xc9, 0x07, 0xb1,
-	0xf4, 0xc0, 0x2c, 0x21, 0xe6, 0xf4, 0xd4, 0x7c, 0x25, 0x5d, 0x2e, 0xf6, 0xa0, 0xd4, 0xc2, 0xd2,
-	0xd4, 0xe2, 0x12, 0x21, 0x01, 0x2e, 0xe6, 0x9c, 0xc4, 0x12, 0x09, 0x46, 0x05, 0x46, 0x0d, 0xa6,
-	0x20, 0x10, 0x13, 0x2c, 0x92, 0x9f, 0x27, 0xc1, 0x04, 0x15, 0xc9, 0xcf, 0x53, 0x52, 0xe1, 0x62,
-	0x0b, 0x4a, 0x2d, 0x2e, 0xcd, 0x29, 0x11, 0x92, 0xe2, 0xe2, 0xc8, 0xc8, 0x2f, 0x49, 0xcd, 0xf1,
-	0x4c, 0x29, 0x96, 0x60, 0x54, 0x60, 0xd6, 0xe0, 0x0c, 0x82, 0xf3, 0x8d, 0xb4, 0xb8, 0x98, 0xdd,
-	0x53, 0xf3, 0x85, 0x94, 0xb9, 0xd8, 0xfc, 0x52, 0x13, 0x8b, 0x92, 0x2a, 0x85, 0x78, 0xf4, 0x40,
-	0xd6, 0x42, 0x2d, 0x92, 0xe2, 0x86, 0xf2, 0x40, 0xe6, 0x24, 0xb1, 0x81, 0x1d, 0x63, 0x0c, 0x08,
-	0x00, 0x00, 0xff, 0xff, 0x1f, 0x18, 0x14, 0xc4, 0xac, 0x00, 0x00, 0x00,
+	// 451 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0xc1, 0x6e, 0xd4, 0x30,
+	0x14, 0x54, 0x92, 0x26, 0xdd, 0x7d, 0xed, 0xa2, 0xe2, 0xa2, 0xca, 0x5a, 0xf5, 0x10, 0x05, 0x84,
+	0x22, 0x21, 0x75, 0x69, 0xfb, 0x05, 0x70, 0xa1, 0x48, 0xa5, 0x5a, 0x19, 0x24, 0xce, 0xe9, 0xe6,
+	0x69, 0x6b, 0x91, 0xf5, 0x2b, 0xb1, 0xb3, 0x6a, 0xb9, 0x70, 0x82, 0x6f, 0xe1, 0x33, 0x91, 0x1d,
+	0x27, 0x69, 0xc4, 0x85, 0xbd, 0xbd, 0x99, 0x78, 0xc6, 0xf6, 0x8c, 0x03, 0xa7, 0x1a, 0xeb, 0xad,
+	0x5c, 0xa1, 0x5e, 0xac, 0x91, 0x16, 0xf7, 0x35, 0x19, 0xb2, 0xd3, 0x99, 0x9b, 0x58, 0xb4, 0x46,
+	0xca, 0x7e, 0xc2, 0xbe, 0xc0, 0xef, 0x0d, 0x6a, 0xc3, 0x8e, 0x20, 0xaa, 0x0a, 0xc3, 0x83, 0x34,
+	0xc8, 0x43, 0x61, 0x47, 0xc7, 0x90, 0xe2, 0xa1, 0x67, 0x48, 0xb1, 0x13, 0x48, 0xea, 0xa2, 0x94,
+	0x8d, 0xe6, 0x91, 0x23, 0x3d, 0x62, 0x73, 0x98, 0xdc, 0x17, 0x6b, 0xfc, 0x2c, 0x7f, 0x20, 0xdf,
+	0x4b, 0x83, 0x3c, 0x16, 0x3d, 0x66, 0xa7, 0x30, 0xb5, 0xf3, 0x17, 0xfa, 0x86, 0x8a, 0xc7, 0x69,
+	0x90, 0x4f, 0xc5, 0x40, 0x64, 0xbf, 0x03, 0x48, 0x04, 0xea, 0xa6, 0x32, 0xd6, 0xe4, 0x8e, 0x0c,
+	0x56, 0x1f, 0x4b, 0xcd, 0x83, 0x34, 0xca, 0xa7, 0xa2, 0xc7, 0xd6, 0xa4, 0x94, 0xda, 0x14, 0x6a,
+	0x85, 0x9a, 0x87, 0x69, 0x94, 0x87, 0x62, 0x20, 0xd8, 0x2b, 0x98, 0x29, 0x7c, 0x30, 0xcb, 0x7e,
+	0x9b, 0xc8, 0x6d, 0x33, 0x26, 0xad, 0x87, 0xa9, 0x1b, 0xb5, 0x2a, 0x0c, 0x96, 0xee, 0x94, 0x13,
+	0x31, 0x10, 0xd9, 0x15, 0x4c, 0xae, 0x69, 0x55, 0x18, 0x49, 0x8a, 0x71, 0xd8, 0xf7, 0x3b, 0xbb,
+	0x38, 0xa6, 0xa2, 0x83, 0x5d, 0x48, 0xe1, 0x3f, 0x21, 0x45, 0x7d, 0x48, 0xd9, 0x1b, 0x88, 0x97,
+	0x24, 0xd5, 0x7f, 0x25, 0x9a, 0xfd, 0x0a, 0x60, 0xf6, 0x9e, 0x1a, 0x55, 0xea, 0xae, 0x87, 0x13,
+	0x48, 0x36, 0x52, 0x5d, 0xf7, 0x42, 0x8f, 0x3a, 0xbe, 0x97, 0x7b, 0xe4, 0xf8, 0xe2, 0xc1, 0xae,
+	0xf7, 0x9d, 0xb4, 0xa8, 0xe3, 0x49, 0xf1, 0xbd, 0x81, 0x27, 0xc5, 0x5e, 0x40, 0x5c, 0xc9, 0x8d,
+	0x34, 0xae, 0x8b, 0x58, 0xb4, 0x20, 0xbb, 0x81, 0x67, 0x4b, 0xaa, 0x1e, 0xd7, 0xa4, 0xba, 0x73,
+	0xbc, 0x86, 0xc9, 0x16, 0x6b, 0x63, 0xdf, 0x8f, 0xab, 0xe3, 0xe0, 0x02, 0xce, 0xec, 0xeb, 0x71,
+	0x77, 0x13, 0xfd, 0xb7, 0xc1, 0x2f, 0x7c, 0xe2, 0x77, 0xf1, 0x27, 0x84, 0xe8, 0x03, 0x12, 0x7b,
+	0x09, 0xc9, 0x0d, 0x16, 0xf5, 0xed, 0x23, 0x3b, 0x74, 0x6a, 0xef, 0x3e, 0x3f, 0xf0, 0xc8, 0x35,
+	0xff, 0x16, 0x8e, 0xde, 0x95, 0xe5, 0x95, 0xcd, 0xb8, 0xef, 0x60, 0xe6, 0x16, 0x74, 0x70, 0x3e,
+	0x86, 0xec, 0x1c, 0x9e, 0x7f, 0xa2, 0x2d, 0xee, 0x22, 0xb9, 0x84, 0x63, 0x81, 0x9b, 0x1d, 0x45,
+	0x0b, 0x38, 0xfc, 0x2a, 0xcd, 0x9d, 0x54, 0x6d, 0x47, 0x8c, 0xb9, 0xcf, 0xa3, 0xc2, 0xc6, 0x57,
+	0x39, 0x87, 0x59, 0x2b, 0xf0, 0x69, 0xb2, 0x63, 0x1f, 0xda, 0xd3, 0x6c, 0x47, 0x92, 0xdb, 0xc4,
+	0xfd, 0x8f, 0x97, 0x7f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x03, 0x00, 0xf9, 0xaf, 0xae, 0x74, 0xaf,
+	0x03, 0x00, 0x00,
 }
@@ -4,18 +4,19 @@ import (
 	// "encoding/json"
 	"fmt"
 
-	"gopkg.in/mgo.v2"
-	"gopkg.in/mgo.v2/bson"
-
 	// "io/ioutil"
 	"net"
+	"sort"
+	"sync"
 	// "os"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/grpc-ecosystem/grpc-opentracing/go/otgrpc"
 	"github.com/hailocab/go-geoindex"
+	"github.com/harlow/go-micro-services/pagination"
 	"github.com/harlow/go-micro-services/registry"
+	"github.com/harlow/go-micro-services/rpcerr"
 	pb "github.com/harlow/go-micro-services/services/geo/proto"
 	"github.com/harlow/go-micro-services/tls"
 	opentracing "github.com/opentracing/opentracing-go"
@@ -26,21 +27,42 @@ import (
 )
 
 const (
-	name             = "srv-geo"
-	maxSearchRadius  = 10
-	maxSearchResults = 5
+	name = "srv-geo"
+
+	// search radius in km
+	defaultSearchRadius = 10
+	maxSearchRadius     = 100
+
+	defaultPageSize = 5
+	maxPageSize     = 100
+
+	// maxNearbyResults bounds how many hotels a search can page through,
+	// so the index never has to find and sort more
+	maxNearbyResults = 1000
 )
 
+// indexResolution is the cell size of the index. A search walks the cells
+// around the query point until it has found enough hotels, up to twice the
+// radius where there are fewer, and sorts the hotels of the cells walked.
+// Smaller cells make the former dear, larger ones the latter. At 2km the
+// slowest search of BenchmarkNearby is over ten times faster than at the
+// 0.5km of a geoindex.ClusteringIndex, which isn't used as it also ranges
+// over clusters rather than hotels beyond 45km.
+var indexResolution = geoindex.Km(2)
+
 // Server implements the geo service
 type Server struct {
-	index *geoindex.ClusteringIndex
-	uuid  string
+	// mu guards index, which is updated while it is being searched
+	mu      sync.RWMutex
+	index   *geoindex.PointsIndex
+	version int
+	uuid    string
 
 	Registry *registry.Client
 	Tracer   opentracing.Tracer
 	Port     int
-	IpAddr	 string
-	MongoSession 	*mgo.Session
+	IpAddr   string
+	Store    GeoStore
 }
 
 // Run starts the server
@@ -50,8 +72,9 @@ func (s *Server) Run() error {
 	}
 
 	if s.index == nil {
-		s.index = newGeoIndex(s.MongoSession)
+		s.index = newGeoIndex(s.Store)
 	}
+	go s.resyncIndex()
 
 	s.uuid = uuid.New().String()
 
@@ -120,22 +143,46 @@ func (s *Server) Shutdown() {
 func (s *Server) Nearby(ctx context.Context, req *pb.Request) (*pb.Result, error) {
 	log.Trace().Msgf("In geo Nearby")
 
+	radius := float64(req.Radius)
+	if radius < 0 || radius > maxSearchRadius {
+		return nil, rpcerr.InvalidArgument("radius", "%v is not between 0 and %dkm", req.Radius, maxSearchRadius)
+	}
+	if radius == 0 {
+		radius = defaultSearchRadius
+	}
+
+	query := fmt.Sprintf("%v,%v,%v", req.Lat, req.Lon, radius)
+	page, err := pagination.New(req.PageSize, req.PageToken, query, defaultPageSize, maxPageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	// one more point than the page holds tells if there is a next page
+	k := page.Offset + page.Size + 1
+	if k > maxNearbyResults {
+		k = maxNearbyResults
+	}
 	var (
-		points = s.getNearbyPoints(ctx, float64(req.Lat), float64(req.Lon))
+		center = &geoindex.GeoPoint{Plat: float64(req.Lat), Plon: float64(req.Lon)}
+		points = s.getNearbyPoints(ctx, float64(req.Lat), float64(req.Lon), radius, k)
 		res    = &pb.Result{}
 	)
 
 	log.Trace().Msgf("geo after getNearbyPoints, len = %d", len(points))
 
-	for _, p := range points {
+	for _, p := range points[page.Start(len(points)):page.End(len(points))] {
 		log.Trace().Msgf("In geo Nearby return hotelId = %s", p.Id())
 		res.HotelIds = append(res.HotelIds, p.Id())
+		res.Distances = append(res.Distances, float32(geoindex.Distance(center, p)/1000))
 	}
+	res.NextPageToken = page.NextToken(len(points), query)
 
 	return res, nil
 }
 
-func (s *Server) getNearbyPoints(ctx context.Context, lat, lon float64) []geoindex.Point {
+// getNearbyPoints returns the k points nearest to lat/lon within radius km,
+// nearest first.
+func (s *Server) getNearbyPoints(ctx context.Context, lat, lon, radius float64, k int) []geoindex.Point {
 	log.Trace().Msgf("In geo getNearbyPoints, lat = %f, lon = %f", lat, lon)
 
 	center := &geoindex.GeoPoint{
@@ -144,48 +191,92 @@ func (s *Server) getNearbyPoints(ctx context.Context, lat, lon float64) []geoind
 		Plon: lon,
 	}
 
-	return s.index.KNearest(
-		center,
-		maxSearchResults,
-		geoindex.Km(maxSearchRadius), func(p geoindex.Point) bool {
-			return true
-		},
-	)
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	// KNearest stops at the first square of cells holding k points, so it
+	// misses points beyond the square that are nearer than some in its
+	// corners. It does tell how far the k nearest are at most, and the
+	// whole circle out to there is searched for them.
+	within := geoindex.Km(radius)
+	if points := s.index.KNearest(center, k, within, func(p geoindex.Point) bool {
+		return true
+	}); len(points) == k {
+		within = 0
+		for _, p := range points {
+			if d := geoindex.Distance(center, p); d > within {
+				within = d
+			}
+		}
+	}
+
+	b := boundsAround(lat, lon, float64(within)/1000)
+	points := make([]geoindex.Point, 0)
+	for _, p := range s.index.Range(
+		&geoindex.GeoPoint{Plat: b.maxLat, Plon: b.minLon},
+		&geoindex.GeoPoint{Plat: b.minLat, Plon: b.maxLon},
+	) {
+		if geoindex.Distance(center, p) <= within {
+			points = append(points, p)
+		}
+	}
+
+	sortNearestFirst(center, points)
+	if len(points) > k {
+		points = points[:k]
+	}
+	return points
 }
 
-// newGeoIndex returns a geo index with points loaded
-func newGeoIndex(session *mgo.Session) *geoindex.ClusteringIndex {
-	// session, err := mgo.Dial("mongodb-geo")
-	// if err != nil {
-	// 	panic(err)
-	// }
-	// defer session.Close()
+// sortNearestFirst sorts points by their distance to center, and points as
+// far by id, so that pages of the same search never overlap.
+func sortNearestFirst(center geoindex.Point, points []geoindex.Point) {
+	distances := make(map[string]geoindex.Meters, len(points))
+	for _, p := range points {
+		distances[p.Id()] = geoindex.Distance(center, p)
+	}
+	sort.Slice(points, func(i, j int) bool {
+		di, dj := distances[points[i].Id()], distances[points[j].Id()]
+		if di != dj {
+			return di < dj
+		}
+		return points[i].Id() < points[j].Id()
+	})
+}
 
+// newGeoIndex returns a geo index with points loaded
+func newGeoIndex(store GeoStore) *geoindex.PointsIndex {
 	log.Trace().Msg("new geo newGeoIndex")
 
-	s := session.Copy()
-	defer s.Close()
-	c := s.DB("geo-db").C("geo")
+	index, err := loadGeoIndex(store)
+	if err != nil {
+		log.Error().Msgf("Failed get geo data: %s", err)
+		return geoindex.NewPointsIndex(indexResolution)
+	}
+
+	return index
+}
 
-	var points []*point
-	err := c.Find(bson.M{}).All(&points)
+// loadGeoIndex returns a geo index of the points in store
+func loadGeoIndex(store GeoStore) (*geoindex.PointsIndex, error) {
+	points, err := store.Points()
 	if err != nil {
-		log.Error().Msgf("Failed get geo data: ", err)
+		return nil, err
 	}
 
 	// add points to index
-	index := geoindex.NewClusteringIndex()
+	index := geoindex.NewPointsIndex(indexResolution)
 	for _, point := range points {
 		index.Add(point)
 	}
 
-	return index
+	return index, nil
 }
 
 type point struct {
-	Pid  string  `bson:"hotelId"`
-	Plat float64 `bson:"lat"`
-	Plon float64 `bson:"lon"`
+	Pid  string  `bson:"hotelId" json:"hotelId"`
+	Plat float64 `bson:"lat" json:"lat"`
+	Plon float64 `bson:"lon" json:"lon"`
 }
 
 // Implement Point interface
@@ -0,0 +1,109 @@
+package geo
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/hailocab/go-geoindex"
+	pb "github.com/harlow/go-micro-services/services/geo/proto"
+	"golang.org/x/net/context"
+)
+
+// cityOf returns a store of n hotels spread over downtown San Francisco
+func cityOf(n int) GeoStore {
+	city := &memoryStore{points: make(map[string]*point)}
+	rnd := rand.New(rand.NewSource(1))
+	for i := 0; i < n; i++ {
+		p := &point{
+			Pid:  fmt.Sprintf("city-%d", i),
+			Plat: 37.70 + rnd.Float64()*0.15,
+			Plon: -122.50 + rnd.Float64()*0.15,
+		}
+		city.points[p.Pid] = p
+	}
+	return city
+}
+
+func TestNearbyPagesUpToMaxResults(t *testing.T) {
+	s := &Server{Store: cityOf(maxNearbyResults + 50)}
+	s.index = newGeoIndex(s.Store)
+
+	// every hotel of the city is within the default radius
+	req := &pb.Request{Lat: 37.7867, Lon: -122.4112, PageSize: maxPageSize}
+	center := &geoindex.GeoPoint{Plat: float64(req.Lat), Plon: float64(req.Lon)}
+	nearest := make([]geoindex.Point, 0)
+	for _, p := range s.index.GetAll() {
+		nearest = append(nearest, p)
+	}
+	sortNearestFirst(center, nearest)
+
+	paged := make([]string, 0)
+	for {
+		res, err := s.Nearby(context.Background(), req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		paged = append(paged, res.HotelIds...)
+		if res.NextPageToken == "" {
+			break
+		}
+		req.PageToken = res.NextPageToken
+	}
+
+	if len(paged) != maxNearbyResults {
+		t.Fatalf("paged through %d hotels, want %d", len(paged), maxNearbyResults)
+	}
+	for i, hotelId := range paged {
+		if hotelId != nearest[i].Id() {
+			t.Fatalf("hotel %d is %s, want %s", i, hotelId, nearest[i].Id())
+		}
+	}
+}
+
+// BenchmarkNearby searches the seeded hotels and a dense city of hotels at
+// index resolutions around indexResolution. A search walks the cells
+// around the query point until it has found a page of hotels: where there
+// are few it walks every cell within twice the radius, where there are
+// many it sorts every hotel of the cells it walked.
+func BenchmarkNearby(b *testing.B) {
+	defer func(resolution geoindex.Meters) { indexResolution = resolution }(indexResolution)
+
+	stores := []struct {
+		name  string
+		store GeoStore
+	}{
+		{"seeded", NewMemoryStore()},
+		{"city", cityOf(2000)},
+	}
+
+	queries := []struct {
+		name string
+		req  *pb.Request
+	}{
+		{"downtown", &pb.Request{Lat: 37.7867, Lon: -122.4112}},
+		{"downtown/radius=max", &pb.Request{Lat: 37.7867, Lon: -122.4112, Radius: maxSearchRadius}},
+		{"downtown/pageSize=max", &pb.Request{Lat: 37.7867, Lon: -122.4112, PageSize: maxPageSize}},
+		{"nowhere", &pb.Request{Lat: 36.5, Lon: -121}},
+		{"nowhere/radius=max", &pb.Request{Lat: 36.5, Lon: -121, Radius: maxSearchRadius}},
+	}
+
+	for _, store := range stores {
+		for _, km := range []float64{0.5, 1, 2, 5} {
+			indexResolution = geoindex.Km(km)
+			s := &Server{Store: store.store}
+			s.index = newGeoIndex(s.Store)
+
+			for _, query := range queries {
+				req := query.req
+				b.Run(fmt.Sprintf("%s/resolution=%vkm/%s", store.name, km, query.name), func(b *testing.B) {
+					for i := 0; i < b.N; i++ {
+						if _, err := s.Nearby(context.Background(), req); err != nil {
+							b.Fatal(err)
+						}
+					}
+				})
+			}
+		}
+	}
+}
@@ -0,0 +1,150 @@
+package geo
+
+import (
+	"encoding/json"
+	"sync"
+
+	"github.com/harlow/go-micro-services/data"
+	"gopkg.in/mgo.v2"
+	"gopkg.in/mgo.v2/bson"
+)
+
+// GeoStore stores the location of hotels
+type GeoStore interface {
+	// Points returns the location of every hotel.
+	Points() ([]*point, error)
+	// AddPoint stores the location of a new hotel. It returns false if the
+	// hotel has a location already.
+	AddPoint(p *point) (bool, error)
+	// MovePoint changes the location of a hotel. It returns false if the
+	// hotel has no location.
+	MovePoint(p *point) (bool, error)
+	// RemovePoint removes the location of a hotel. It returns false if the
+	// hotel has no location.
+	RemovePoint(hotelId string) (bool, error)
+}
+
+type mongoStore struct {
+	session *mgo.Session
+}
+
+// NewMongoStore returns a GeoStore backed by the geo-db database
+func NewMongoStore(session *mgo.Session) GeoStore {
+	return &mongoStore{session: session}
+}
+
+func (m *mongoStore) Points() ([]*point, error) {
+	s := m.session.Copy()
+	defer s.Close()
+
+	points := make([]*point, 0)
+	err := s.DB("geo-db").C("geo").Find(bson.M{}).All(&points)
+	return points, err
+}
+
+func (m *mongoStore) AddPoint(p *point) (bool, error) {
+	s := m.session.Copy()
+	defer s.Close()
+
+	info, err := s.DB("geo-db").C("geo").Upsert(
+		&bson.M{"hotelId": p.Pid},
+		&bson.M{"$setOnInsert": bson.M{"lat": p.Plat, "lon": p.Plon}})
+	if err != nil {
+		return false, err
+	}
+	return info.UpsertedId != nil, nil
+}
+
+func (m *mongoStore) MovePoint(p *point) (bool, error) {
+	s := m.session.Copy()
+	defer s.Close()
+
+	err := s.DB("geo-db").C("geo").Update(
+		&bson.M{"hotelId": p.Pid},
+		&bson.M{"$set": bson.M{"lat": p.Plat, "lon": p.Plon}})
+	if err == mgo.ErrNotFound {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (m *mongoStore) RemovePoint(hotelId string) (bool, error) {
+	s := m.session.Copy()
+	defer s.Close()
+
+	err := s.DB("geo-db").C("geo").Remove(&bson.M{"hotelId": hotelId})
+	if err == mgo.ErrNotFound {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+type memoryStore struct {
+	mu     sync.RWMutex
+	points map[string]*point
+}
+
+// NewMemoryStore returns a GeoStore kept in memory and seeded from
+// data/geo.json
+func NewMemoryStore() GeoStore {
+	points := make([]*point, 0)
+	if err := json.Unmarshal(data.MustAsset("data/geo.json"), &points); err != nil {
+		panic(err)
+	}
+	m := &memoryStore{points: make(map[string]*point)}
+	for _, p := range points {
+		m.points[p.Pid] = p
+	}
+	return m
+}
+
+func (m *memoryStore) Points() ([]*point, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	points := make([]*point, 0, len(m.points))
+	for _, p := range m.points {
+		cp := *p
+		points = append(points, &cp)
+	}
+	return points, nil
+}
+
+func (m *memoryStore) AddPoint(p *point) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, ok := m.points[p.Pid]; ok {
+		return false, nil
+	}
+	cp := *p
+	m.points[p.Pid] = &cp
+	return true, nil
+}
+
+func (m *memoryStore) MovePoint(p *point) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, ok := m.points[p.Pid]; !ok {
+		return false, nil
+	}
+	cp := *p
+	m.points[p.Pid] = &cp
+	return true, nil
+}
+
+func (m *memoryStore) RemovePoint(hotelId string) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, ok := m.points[hotelId]; !ok {
+		return false, nil
+	}
+	delete(m.points, hotelId)
+	return true, nil
+}
@@ -0,0 +1,58 @@
+package profile
+
+import (
+	"strings"
+
+	"github.com/rs/zerolog/log"
+)
+
+// amenityIndex finds hotels by their amenity tags. Tags are matched
+// ignoring case and surrounding whitespace.
+type amenityIndex struct {
+	hotels map[string]map[string]bool
+}
+
+// newAmenityIndex returns an amenity index of the hotels in store
+func newAmenityIndex(store ProfileStore) *amenityIndex {
+	log.Trace().Msg("new profile newAmenityIndex")
+
+	amenities, err := store.Amenities()
+	if err != nil {
+		log.Error().Msgf("Failed get amenity data: %s", err)
+	}
+
+	index := &amenityIndex{hotels: make(map[string]map[string]bool)}
+	for id, tags := range amenities {
+		for _, tag := range tags {
+			tag = normalizeAmenity(tag)
+			if index.hotels[tag] == nil {
+				index.hotels[tag] = make(map[string]bool)
+			}
+			index.hotels[tag][id] = true
+		}
+	}
+	return index
+}
+
+// Filter returns the hotels of hotelIds that have all of amenities, in the
+// order of hotelIds.
+func (idx *amenityIndex) Filter(hotelIds, amenities []string) []string {
+	hotels := make([]string, 0, len(hotelIds))
+	for _, id := range hotelIds {
+		ok := true
+		for _, amenity := range amenities {
+			if !idx.hotels[normalizeAmenity(amenity)][id] {
+				ok = false
+				break
+			}
+		}
+		if ok {
+			hotels = append(hotels, id)
+		}
+	}
+	return hotels
+}
+
+func normalizeAmenity(tag string) string {
+	return strings.ToLower(strings.TrimSpace(tag))
+}
@@ -0,0 +1,79 @@
+package profile
+
+import (
+	"sort"
+	"strings"
+
+	pb "github.com/harlow/go-micro-services/services/profile/proto"
+	"github.com/rs/zerolog/log"
+)
+
+// cityIndex finds hotels by the city, state or country of their address.
+// A hotel in San Francisco, CA, United States is found by each of these
+// names on its own as well as by "San Francisco, CA", "San Francisco,
+// United States", "CA, United States" and all three together. Names are
+// matched ignoring case and extra whitespace.
+type cityIndex struct {
+	hotels map[string][]string
+}
+
+// newCityIndex returns a city index of the addresses in store
+func newCityIndex(store ProfileStore) *cityIndex {
+	log.Trace().Msg("new profile newCityIndex")
+
+	addresses, err := store.Addresses()
+	if err != nil {
+		log.Error().Msgf("Failed get address data: %s", err)
+	}
+
+	index := &cityIndex{hotels: make(map[string][]string)}
+	for id, address := range addresses {
+		for _, name := range locationNames(address) {
+			index.hotels[name] = append(index.hotels[name], id)
+		}
+	}
+	for _, ids := range index.hotels {
+		sort.Strings(ids)
+	}
+	return index
+}
+
+// Lookup returns the hotels of a city, state or country.
+func (idx *cityIndex) Lookup(city string) []string {
+	return idx.hotels[normalizeLocation(city)]
+}
+
+// locationNames returns every name an address is found by.
+func locationNames(address *pb.Address) []string {
+	parts := []string{address.City, address.State, address.Country}
+
+	names := make([]string, 0)
+	// every non-empty combination of parts, in order
+	for mask := 1; mask < 1<<uint(len(parts)); mask++ {
+		combination := make([]string, 0, len(parts))
+		for i, part := range parts {
+			if mask&(1<<uint(i)) == 0 {
+				continue
+			}
+			if strings.TrimSpace(part) == "" {
+				combination = nil
+				break
+			}
+			combination = append(combination, part)
+		}
+		if combination != nil {
+			names = append(names, normalizeLocation(strings.Join(combination, ",")))
+		}
+	}
+	return names
+}
+
+// normalizeLocation lowercases a comma separated location name and trims
+// its parts.
+func normalizeLocation(name string) string {
+	parts := strings.Split(strings.ToLower(name), ",")
+	for i, part := range parts {
+		parts[i] = strings.Join(strings.Fields(part), " ")
+	}
+	return strings.Join(parts, ", ")
+}
@@ -0,0 +1,80 @@
+package profile
+
+import (
+	"strings"
+
+	"github.com/harlow/go-micro-services/rpcerr"
+	pb "github.com/harlow/go-micro-services/services/profile/proto"
+)
+
+// hotelFields copy each field of a hotel that can be masked, by path
+var hotelFields = map[string]func(dst, src *pb.Hotel){
+	"id":          func(dst, src *pb.Hotel) { dst.Id = src.Id },
+	"name":        func(dst, src *pb.Hotel) { dst.Name = src.Name },
+	"phoneNumber": func(dst, src *pb.Hotel) { dst.PhoneNumber = src.PhoneNumber },
+	"description": func(dst, src *pb.Hotel) { dst.Description = src.Description },
+	"address":     func(dst, src *pb.Hotel) { dst.Address = src.Address },
+	"images":      func(dst, src *pb.Hotel) { dst.Images = src.Images },
+	"amenities":   func(dst, src *pb.Hotel) { dst.Amenities = src.Amenities },
+	"locale":      func(dst, src *pb.Hotel) { dst.Locale = src.Locale },
+}
+
+// addressFields copy each field of an address, by path below "address."
+var addressFields = map[string]func(dst, src *pb.Address){
+	"streetNumber": func(dst, src *pb.Address) { dst.StreetNumber = src.StreetNumber },
+	"streetName":   func(dst, src *pb.Address) { dst.StreetName = src.StreetName },
+	"city":         func(dst, src *pb.Address) { dst.City = src.City },
+	"state":        func(dst, src *pb.Address) { dst.State = src.State },
+	"country":      func(dst, src *pb.Address) { dst.Country = src.Country },
+	"postalCode":   func(dst, src *pb.Address) { dst.PostalCode = src.PostalCode },
+	"lat":          func(dst, src *pb.Address) { dst.Lat = src.Lat },
+	"lon":          func(dst, src *pb.Address) { dst.Lon = src.Lon },
+	"formatted":    func(dst, src *pb.Address) { dst.Formatted = src.Formatted },
+}
+
+// profileMask copies the fields of a field mask from hotel profiles
+type profileMask []func(dst, src *pb.Hotel)
+
+// newProfileMask validates a field mask. A nil mask keeps every field.
+func newProfileMask(fieldMask *pb.FieldMask) (profileMask, error) {
+	if len(fieldMask.GetPaths()) == 0 {
+		return nil, nil
+	}
+
+	mask := profileMask{hotelFields["id"]}
+	for _, path := range fieldMask.Paths {
+		if field, ok := hotelFields[path]; ok {
+			mask = append(mask, field)
+			continue
+		}
+		if sub := strings.TrimPrefix(path, "address."); sub != path {
+			if field, ok := addressFields[sub]; ok {
+				mask = append(mask, func(dst, src *pb.Hotel) {
+					if src.Address == nil {
+						return
+					}
+					if dst.Address == nil {
+						dst.Address = new(pb.Address)
+					}
+					field(dst.Address, src.Address)
+				})
+				continue
+			}
+		}
+		return nil, rpcerr.InvalidArgument("fieldMask", "%q is not a field of hotels", path)
+	}
+	return mask, nil
+}
+
+// apply returns a hotel with the fields of the mask copied from hotel,
+// which it may share values with.
+func (mask profileMask) apply(hotel *pb.Hotel) *pb.Hotel {
+	if mask == nil {
+		return hotel
+	}
+	masked := new(pb.Hotel)
+	for _, field := range mask {
+		field(masked, hotel)
+	}
+	return masked
+}
@@ -0,0 +1,177 @@
+package profile
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/harlow/go-micro-services/data"
+	"github.com/harlow/go-micro-services/media"
+	"github.com/harlow/go-micro-services/rpcerr"
+	pb "github.com/harlow/go-micro-services/services/profile/proto"
+	"github.com/rs/zerolog/log"
+)
+
+// placeholderWidth and placeholderHeight are the size of the pictures
+// rendered for the images of the catalogue
+const (
+	placeholderWidth  = 1600
+	placeholderHeight = 1067
+)
+
+// catalogueImage is an image of a hotel in data/images.json
+type catalogueImage struct {
+	HotelId string `json:"hotelId"`
+	Name    string `json:"name"`
+	Default bool   `json:"default"`
+}
+
+// placeholders renders the pictures of the images of the catalogue that
+// aren't stored, when they are first asked for. Rendering them all at once
+// would hold up startup for a while.
+type placeholders struct {
+	blobs media.BlobStore
+	// keys holds the image key of every blob key that is rendered as a
+	// placeholder, the image's and its thumbnail's
+	keys map[string]string
+
+	mu        sync.Mutex
+	rendering map[string]*sync.Mutex
+}
+
+// loadImages records the images of the catalogue of data/images.json in
+// the profiles of their hotels, and returns the placeholders of those that
+// aren't stored on blobs yet. Images stored before are kept, which lets
+// real pictures be put in their place.
+func loadImages(store ProfileStore, blobs media.BlobStore) (*placeholders, error) {
+	catalogue := make([]catalogueImage, 0)
+	if err := json.Unmarshal(data.MustAsset("data/images.json"), &catalogue); err != nil {
+		return nil, err
+	}
+
+	p := &placeholders{
+		blobs:     blobs,
+		keys:      make(map[string]string),
+		rendering: make(map[string]*sync.Mutex),
+	}
+	images := make(map[string][]*pb.Image)
+	hotelIds := make([]string, 0)
+	for _, c := range catalogue {
+		key := c.HotelId + "/" + c.Name + ".jpg"
+		img, err := media.Load(blobs, key)
+		if err == media.ErrNotFound {
+			img = &media.Image{
+				Key:          key,
+				ThumbnailKey: media.ThumbnailKey(key),
+				Width:        placeholderWidth,
+				Height:       placeholderHeight,
+			}
+			p.keys[img.Key] = key
+			p.keys[img.ThumbnailKey] = key
+		} else if err != nil {
+			return nil, fmt.Errorf("image %v of hotel %v: %v", c.Name, c.HotelId, err)
+		}
+
+		if _, ok := images[c.HotelId]; !ok {
+			hotelIds = append(hotelIds, c.HotelId)
+		}
+		images[c.HotelId] = append(images[c.HotelId], &pb.Image{
+			Url:          media.URL(img.Key),
+			Default:      c.Default,
+			ThumbnailUrl: media.URL(img.ThumbnailKey),
+			Width:        int32(img.Width),
+			Height:       int32(img.Height),
+		})
+	}
+
+	for _, hotelId := range hotelIds {
+		ok, err := store.SetImages(hotelId, images[hotelId])
+		if err != nil {
+			return nil, fmt.Errorf("images of hotel %v: %v", hotelId, err)
+		}
+		if !ok {
+			log.Warn().Msgf("Skipping images of unknown hotel [%v]", hotelId)
+		}
+	}
+	return p, nil
+}
+
+// render stores the placeholder of the image of key if nothing is stored
+// under key yet. Each image is rendered once, however many ask for it.
+func (p *placeholders) render(key string) error {
+	image_key, ok := p.keys[key]
+	if !ok {
+		return nil
+	}
+
+	p.mu.Lock()
+	lock, ok := p.rendering[image_key]
+	if !ok {
+		lock = new(sync.Mutex)
+		p.rendering[image_key] = lock
+	}
+	p.mu.Unlock()
+
+	lock.Lock()
+	defer lock.Unlock()
+	if _, err := media.Load(p.blobs, image_key); err != media.ErrNotFound {
+		return err
+	}
+	log.Trace().Msgf("Rendering placeholder for image [%v]", image_key)
+	_, err := media.Store(p.blobs, image_key, media.Placeholder(placeholderWidth, placeholderHeight, image_key))
+	return err
+}
+
+// imageChunkSize is the size of the chunks images are streamed in, well
+// below the default message size limit of gRPC
+const imageChunkSize = 64 << 10
+
+// GetImage streams an image of the profiles or its thumbnail, without its
+// content if the caller has it already
+func (s *Server) GetImage(req *pb.ImageRequest, stream pb.Profile_GetImageServer) error {
+	if s.Images == nil {
+		return rpcerr.NotFound("image", req.Key)
+	}
+
+	blob, err := s.Images.Open(req.Key)
+	if err == media.ErrNotFound && s.placeholders != nil {
+		if err = s.placeholders.render(req.Key); err == nil {
+			blob, err = s.Images.Open(req.Key)
+		}
+	}
+	if err == media.ErrNotFound || err == media.ErrInvalidKey {
+		return rpcerr.NotFound("image", req.Key)
+	}
+	if err != nil {
+		log.Error().Msgf("Tried to open image [%v], but got error = %s", req.Key, err)
+		return rpcerr.Mongo(err)
+	}
+	defer blob.Close()
+
+	modTime := blob.ModTime().UnixNano()
+	if err := stream.Send(&pb.ImageChunk{ModTime: modTime, Size: blob.Size()}); err != nil {
+		return err
+	}
+	if req.IfModTime == modTime {
+		return nil
+	}
+
+	buf := make([]byte, imageChunkSize)
+	for {
+		n, err := blob.Read(buf)
+		if n > 0 {
+			// Send is done with buf once it returns
+			if err := stream.Send(&pb.ImageChunk{Content: buf[:n]}); err != nil {
+				return err
+			}
+		}
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			log.Error().Msgf("Tried to read image [%v], but got error = %s", req.Key, err)
+			return rpcerr.Mongo(err)
+		}
+	}
+}
@@ -0,0 +1,210 @@
+package profile
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"image/jpeg"
+	"math/rand"
+	"sync"
+	"testing"
+
+	"github.com/harlow/go-micro-services/media"
+	pb "github.com/harlow/go-micro-services/services/profile/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// imageStream collects the chunks GetImage sends
+type imageStream struct {
+	grpc.ServerStream
+	chunks []*pb.ImageChunk
+}
+
+func (s *imageStream) Send(chunk *pb.ImageChunk) error {
+	// the server reuses the buffer of the content
+	chunk.Content = append([]byte(nil), chunk.Content...)
+	s.chunks = append(s.chunks, chunk)
+	return nil
+}
+
+// storedImage is an image as GetImage streams it
+type storedImage struct {
+	modTime int64
+	size    int64
+	content []byte
+	chunks  int
+}
+
+// getImage calls GetImage and puts the image streamed back together
+func getImage(s *Server, req *pb.ImageRequest) (*storedImage, error) {
+	stream := &imageStream{}
+	if err := s.GetImage(req, stream); err != nil {
+		return nil, err
+	}
+	if len(stream.chunks) == 0 {
+		return nil, errors.New("no chunk streamed")
+	}
+	img := &storedImage{modTime: stream.chunks[0].ModTime, size: stream.chunks[0].Size}
+	for _, chunk := range stream.chunks[1:] {
+		img.content = append(img.content, chunk.Content...)
+		img.chunks++
+	}
+	return img, nil
+}
+
+func newImageServer(t *testing.T, blobs media.BlobStore) *Server {
+	s := &Server{Store: NewMemoryStore(), Images: blobs}
+	placeholders, err := loadImages(s.Store, s.Images)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.placeholders = placeholders
+	return s
+}
+
+// imagesOf returns the images recorded in the profile of a hotel
+func imagesOf(t *testing.T, s *Server, hotelId string) []*pb.Image {
+	profiles, err := s.Store.GetProfiles([]string{hotelId})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return profiles[hotelId].Images
+}
+
+func TestPlaceholdersAreRenderedWhenFirstAskedFor(t *testing.T) {
+	blobs := media.NewMemoryStore()
+	s := newImageServer(t, blobs)
+
+	images := imagesOf(t, s, "1")
+	if len(images) == 0 || images[0].Url != "/images/1/exterior.jpg" || images[0].ThumbnailUrl != "/images/1/exterior_thumb.jpg" {
+		t.Fatalf("hotel 1 has images %v", images)
+	}
+	if _, err := blobs.Open("1/exterior.jpg"); err != media.ErrNotFound {
+		t.Fatalf("image stored before it was asked for, err = %v", err)
+	}
+
+	// the thumbnail stores the image too, once however many ask for it
+	thumbs := make([]*storedImage, 10)
+	var wg sync.WaitGroup
+	for i := range thumbs {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			thumb, err := getImage(s, &pb.ImageRequest{Key: "1/exterior_thumb.jpg"})
+			if err != nil {
+				t.Error(err)
+				return
+			}
+			thumbs[i] = thumb
+		}(i)
+	}
+	wg.Wait()
+	for _, thumb := range thumbs {
+		if thumb == nil || thumb.modTime != thumbs[0].modTime || !bytes.Equal(thumb.content, thumbs[0].content) {
+			t.Fatal("thumbnail rendered more than once")
+		}
+	}
+	config, err := jpeg.DecodeConfig(bytes.NewReader(thumbs[0].content))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if config.Width != media.ThumbnailWidth || config.Height > media.ThumbnailHeight {
+		t.Errorf("thumbnail is %dx%d", config.Width, config.Height)
+	}
+
+	img, err := getImage(s, &pb.ImageRequest{Key: "1/exterior.jpg"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	config, err = jpeg.DecodeConfig(bytes.NewReader(img.content))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if config.Width != int(images[0].Width) || config.Height != int(images[0].Height) {
+		t.Errorf("image is %dx%d, recorded as %dx%d", config.Width, config.Height, images[0].Width, images[0].Height)
+	}
+}
+
+func TestStoredPicturesAreKept(t *testing.T) {
+	blobs := media.NewFileStore(t.TempDir())
+	picture := image.NewRGBA(image.Rect(0, 0, 640, 480))
+	if _, err := media.Store(blobs, "1/exterior.jpg", picture); err != nil {
+		t.Fatal(err)
+	}
+	s := newImageServer(t, blobs)
+
+	images := imagesOf(t, s, "1")
+	if images[0].Width != 640 || images[0].Height != 480 {
+		t.Errorf("picture recorded as %dx%d, want 640x480", images[0].Width, images[0].Height)
+	}
+	img, err := getImage(s, &pb.ImageRequest{Key: "1/exterior.jpg"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	config, err := jpeg.DecodeConfig(bytes.NewReader(img.content))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if config.Width != 640 {
+		t.Errorf("picture replaced by a %dx%d placeholder", config.Width, config.Height)
+	}
+}
+
+func TestGetImageNotFound(t *testing.T) {
+	s := newImageServer(t, media.NewMemoryStore())
+
+	for _, key := range []string{"", "1/unknown.jpg", "../1/exterior.jpg", "1/exterior.jpg/"} {
+		_, err := getImage(s, &pb.ImageRequest{Key: key})
+		if status.Code(err) != codes.NotFound {
+			t.Errorf("GetImage(%q) = %v, want NotFound", key, err)
+		}
+	}
+}
+
+func TestGetImageStreamsChunks(t *testing.T) {
+	blobs := media.NewMemoryStore()
+	content := make([]byte, 3*imageChunkSize+1)
+	rand.Read(content)
+	if err := blobs.Put("1/upload.jpg", content); err != nil {
+		t.Fatal(err)
+	}
+	s := newImageServer(t, blobs)
+
+	img, err := getImage(s, &pb.ImageRequest{Key: "1/upload.jpg"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if img.size != int64(len(content)) || !bytes.Equal(img.content, content) {
+		t.Errorf("streamed %d of %d bytes as %d, not the image", len(img.content), len(content), img.size)
+	}
+	if img.chunks != 4 {
+		t.Errorf("streamed in %d chunks, want 4", img.chunks)
+	}
+}
+
+func TestGetImageLeavesOutContentClientsHave(t *testing.T) {
+	s := newImageServer(t, media.NewMemoryStore())
+
+	img, err := getImage(s, &pb.ImageRequest{Key: "1/exterior.jpg"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cached, err := getImage(s, &pb.ImageRequest{Key: "1/exterior.jpg", IfModTime: img.modTime})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cached.modTime != img.modTime || cached.size != img.size || cached.chunks != 0 {
+		t.Errorf("image the client has streamed as %+v", cached)
+	}
+
+	stale, err := getImage(s, &pb.ImageRequest{Key: "1/exterior.jpg", IfModTime: img.modTime - 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(stale.content, img.content) {
+		t.Error("image left out for a client that has an older one")
+	}
+}
@@ -0,0 +1,168 @@
+package profile
+
+import (
+	"strings"
+
+	"github.com/harlow/go-micro-services/rpcerr"
+	pb "github.com/harlow/go-micro-services/services/profile/proto"
+)
+
+// defaultLocale is the locale profiles are stored in, which every locale
+// falls back to.
+const defaultLocale = "en"
+
+// maxLocaleSubtags bounds the subtags of a locale, e.g. "zh-Hant-TW" has 3
+const maxLocaleSubtags = 4
+
+// Localization is the name and description of a hotel in a locale. Empty
+// fields fall back to the next locale of the chain.
+type Localization struct {
+	HotelId     string `bson:"hotelId" json:"hotelId"`
+	Locale      string `bson:"locale" json:"locale"`
+	Name        string `bson:"name" json:"name"`
+	Description string `bson:"description" json:"description"`
+}
+
+// canonicalLocale validates a BCP 47 style locale and returns it in its
+// canonical case, e.g. "fr_ca" is "fr-CA" and "ZH-HANT" is "zh-Hant".
+func canonicalLocale(locale string) (string, error) {
+	if locale == "" {
+		return defaultLocale, nil
+	}
+
+	subtags := strings.FieldsFunc(locale, func(r rune) bool { return r == '-' || r == '_' })
+	if len(subtags) == 0 || len(subtags) > maxLocaleSubtags {
+		return "", rpcerr.InvalidArgument("locale", "%q is not a locale", locale)
+	}
+	for i, subtag := range subtags {
+		if !isAlnum(subtag) || len(subtag) > 8 || (i == 0 && (len(subtag) < 2 || len(subtag) > 3)) {
+			return "", rpcerr.InvalidArgument("locale", "%q is not a locale", locale)
+		}
+		switch {
+		case i == 0:
+			subtags[i] = strings.ToLower(subtag)
+		case len(subtag) == 4:
+			// script
+			subtags[i] = strings.ToUpper(subtag[:1]) + strings.ToLower(subtag[1:])
+		case len(subtag) == 2 || len(subtag) == 3:
+			// region
+			subtags[i] = strings.ToUpper(subtag)
+		default:
+			subtags[i] = strings.ToLower(subtag)
+		}
+	}
+	return strings.Join(subtags, "-"), nil
+}
+
+func isAlnum(s string) bool {
+	for _, r := range s {
+		if (r < 'a' || r > 'z') && (r < 'A' || r > 'Z') && (r < '0' || r > '9') {
+			return false
+		}
+	}
+	return true
+}
+
+// localeChain returns the locales a canonical locale falls back to, most
+// specific first, e.g. "fr-CA", "fr" and "en" for "fr-CA".
+func localeChain(locale string) []string {
+	chain := make([]string, 0)
+	for {
+		chain = append(chain, locale)
+		i := strings.LastIndex(locale, "-")
+		if i < 0 {
+			break
+		}
+		locale = locale[:i]
+	}
+	if locale != defaultLocale {
+		chain = append(chain, defaultLocale)
+	}
+	return chain
+}
+
+// localize replaces the name and description of a hotel by those of the
+// first locale of chain it is localized for, falling back field by field
+// along the chain. The locale served is the first one localized, or the
+// default locale the profile is stored in. The address is formatted for
+// the locale served.
+func localize(hotel *pb.Hotel, chain []string, localizations map[string]*Localization) {
+	hotel.Locale = ""
+	name, description := "", ""
+	for _, locale := range chain {
+		l, ok := localizations[locale]
+		if !ok {
+			continue
+		}
+		if hotel.Locale == "" {
+			hotel.Locale = locale
+		}
+		if name == "" {
+			name = l.Name
+		}
+		if description == "" {
+			description = l.Description
+		}
+	}
+	if hotel.Locale == "" {
+		hotel.Locale = defaultLocale
+	}
+	if name != "" {
+		hotel.Name = name
+	}
+	if description != "" {
+		hotel.Description = description
+	}
+	if hotel.Address != nil {
+		hotel.Address.Formatted = formatAddress(hotel.Address, localeChain(hotel.Locale))
+	}
+}
+
+// addressLines are the lines of an address format, each a list of address
+// fields separated by spaces. Empty fields and lines are left out.
+type addressLines [][]func(*pb.Address) string
+
+var (
+	streetNumber = (*pb.Address).GetStreetNumber
+	streetName   = (*pb.Address).GetStreetName
+	city         = (*pb.Address).GetCity
+	state        = (*pb.Address).GetState
+	postalCode   = (*pb.Address).GetPostalCode
+	country      = (*pb.Address).GetCountry
+)
+
+// addressFormats are the address formats by locale. Locales without one
+// use the format of the locale they fall back to.
+var addressFormats = map[string]addressLines{
+	"en":    {{streetNumber, streetName}, {city}, {state, postalCode}, {country}},
+	"fr":    {{streetNumber, streetName}, {postalCode, city}, {state}, {country}},
+	"fr-CA": {{streetNumber, streetName}, {city, state, postalCode}, {country}},
+	"de":    {{streetName, streetNumber}, {postalCode, city}, {state}, {country}},
+	"es":    {{streetName, streetNumber}, {postalCode, city}, {state}, {country}},
+}
+
+// formatAddress formats an address for the first locale of chain that has
+// an address format, on a single line.
+func formatAddress(addr *pb.Address, chain []string) string {
+	format := addressFormats[defaultLocale]
+	for _, locale := range chain {
+		if f, ok := addressFormats[locale]; ok {
+			format = f
+			break
+		}
+	}
+
+	lines := make([]string, 0, len(format))
+	for _, fields := range format {
+		words := make([]string, 0, len(fields))
+		for _, field := range fields {
+			if word := field(addr); word != "" {
+				words = append(words, word)
+			}
+		}
+		if len(words) > 0 {
+			lines = append(lines, strings.Join(words, " "))
+		}
+	}
+	return strings.Join(lines, ", ")
+}
@@ -9,10 +9,17 @@ It is generated from these files:
 
 It has these top-level messages:
 	Request
+	FieldMask
 	Result
 	Hotel
 	Address
 	Image
+	CityRequest
+	CityResult
+	FilterRequest
+	FilterResult
+	ImageRequest
+	ImageChunk
 */
 package profile
 
@@ -38,7 +45,12 @@ const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
 
 type Request struct {
 	HotelIds []string `protobuf:"bytes,1,rep,name=hotelIds" json:"hotelIds,omitempty"`
-	Locale   string   `protobuf:"bytes,2,opt,name=locale" json:"locale,omitempty"`
+	// locale of the profiles, e.g. "fr-CA", which falls back to "fr" and
+	// then "en" for hotels that aren't localized for it. Defaults to "en".
+	Locale string `protobuf:"bytes,2,opt,name=locale" json:"locale,omitempty"`
+	// fields of the profiles to return, all of them if empty. The id is
+	// always returned.
+	FieldMask *FieldMask `protobuf:"bytes,3,opt,name=fieldMask" json:"fieldMask,omitempty"`
 }
 
 func (m *Request) Reset()                    { *m = Request{} }
@@ -60,6 +72,32 @@ func (m *Request) GetLocale() string {
 	return ""
 }
 
+func (m *Request) GetFieldMask() *FieldMask {
+	if m != nil {
+		return m.FieldMask
+	}
+	return nil
+}
+
+// FieldMask lists fields by their proto names, e.g. "name", and the fields
+// of the address by "address." and theirs, e.g. "address.lat". It is
+// encoded as google.protobuf.FieldMask is.
+type FieldMask struct {
+	Paths []string `protobuf:"bytes,1,rep,name=paths" json:"paths,omitempty"`
+}
+
+func (m *FieldMask) Reset()                    { *m = FieldMask{} }
+func (m *FieldMask) String() string            { return proto.CompactTextString(m) }
+func (*FieldMask) ProtoMessage()               {}
+func (*FieldMask) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{1} }
+
+func (m *FieldMask) GetPaths() []string {
+	if m != nil {
+		return m.Paths
+	}
+	return nil
+}
+
 type Result struct {
 	Hotels []*Hotel `protobuf:"bytes,1,rep,name=hotels" json:"hotels,omitempty"`
 }
@@ -67,7 +105,7 @@ type Result struct {
 func (m *Result) Reset()                    { *m = Result{} }
 func (m *Result) String() string            { return proto.CompactTextString(m) }
 func (*Result) ProtoMessage()               {}
-func (*Result) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{1} }
+func (*Result) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{2} }
 
 func (m *Result) GetHotels() []*Hotel {
 	if m != nil {
@@ -83,12 +121,16 @@ type Hotel struct {
 	Description string   `protobuf:"bytes,4,opt,name=description" bson:"description,omitempty"`
 	Address     *Address `protobuf:"bytes,5,opt,name=address" bson:"address,omitempty"`
 	Images      []*Image `protobuf:"bytes,6,rep,name=images" bson:"images,omitempty"`
+	// amenity tags of the hotel, e.g. "wifi" or "pool"
+	Amenities []string `protobuf:"bytes,7,rep,name=amenities" bson:"amenities,omitempty"`
+	// locale the name, description and address are served in
+	Locale string `protobuf:"bytes,8,opt,name=locale" bson:"locale,omitempty"`
 }
 
 func (m *Hotel) Reset()                    { *m = Hotel{} }
 func (m *Hotel) String() string            { return proto.CompactTextString(m) }
 func (*Hotel) ProtoMessage()               {}
-func (*Hotel) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{2} }
+func (*Hotel) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{3} }
 
 func (m *Hotel) GetId() string {
 	if m != nil {
@@ -132,6 +174,20 @@ func (m *Hotel) GetImages() []*Image {
 	return nil
 }
 
+func (m *Hotel) GetAmenities() []string {
+	if m != nil {
+		return m.Amenities
+	}
+	return nil
+}
+
+func (m *Hotel) GetLocale() string {
+	if m != nil {
+		return m.Locale
+	}
+	return ""
+}
+
 type Address struct {
 	StreetNumber string  `protobuf:"bytes,1,opt,name=streetNumber" bson:"streetNumber,omitempty"`
 	StreetName   string  `protobuf:"bytes,2,opt,name=streetName" bson:"streetName,omitempty"`
@@ -141,12 +197,14 @@ type Address struct {
 	PostalCode   string  `protobuf:"bytes,6,opt,name=postalCode" bson:"postalCode,omitempty"`
 	Lat          float32 `protobuf:"fixed32,7,opt,name=lat" bson:"lat,omitempty"`
 	Lon          float32 `protobuf:"fixed32,8,opt,name=lon" bson:"lon,omitempty"`
+	// the address formatted for the locale of the hotel
+	Formatted string `protobuf:"bytes,9,opt,name=formatted" bson:"formatted,omitempty"`
 }
 
 func (m *Address) Reset()                    { *m = Address{} }
 func (m *Address) String() string            { return proto.CompactTextString(m) }
 func (*Address) ProtoMessage()               {}
-func (*Address) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{3} }
+func (*Address) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{4} }
 
 func (m *Address) GetStreetNumber() string {
 	if m != nil {
@@ -204,15 +262,27 @@ func (m *Address) GetLon() float32 {
 	return 0
 }
 
+func (m *Address) GetFormatted() string {
+	if m != nil {
+		return m.Formatted
+	}
+	return ""
+}
+
 type Image struct {
-	Url     string `protobuf:"bytes,1,opt,name=url" json:"url,omitempty"`
-	Default bool   `protobuf:"varint,2,opt,name=default" json:"default,omitempty"`
+	Url string `protobuf:"bytes,1,opt,name=url" json:"url,omitempty"`
+	// the image shown first for the hotel, one per hotel
+	Default bool `protobuf:"varint,2,opt,name=default" json:"default,omitempty"`
+	// url of the image scaled down to fit 320x240
+	ThumbnailUrl string `protobuf:"bytes,3,opt,name=thumbnailUrl" json:"thumbnailUrl,omitempty"`
+	Width        int32  `protobuf:"varint,4,opt,name=width" json:"width,omitempty"`
+	Height       int32  `protobuf:"varint,5,opt,name=height" json:"height,omitempty"`
 }
 
 func (m *Image) Reset()                    { *m = Image{} }
 func (m *Image) String() string            { return proto.CompactTextString(m) }
 func (*Image) ProtoMessage()               {}
-func (*Image) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{4} }
+func (*Image) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{5} }
 
 func (m *Image) GetUrl() string {
 	if m != nil {
@@ -228,12 +298,175 @@ func (m *Image) GetDefault() bool {
 	return false
 }
 
+func (m *Image) GetThumbnailUrl() string {
+	if m != nil {
+		return m.ThumbnailUrl
+	}
+	return ""
+}
+
+func (m *Image) GetWidth() int32 {
+	if m != nil {
+		return m.Width
+	}
+	return 0
+}
+
+func (m *Image) GetHeight() int32 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+type CityRequest struct {
+	City string `protobuf:"bytes,1,opt,name=city" json:"city,omitempty"`
+}
+
+func (m *CityRequest) Reset()                    { *m = CityRequest{} }
+func (m *CityRequest) String() string            { return proto.CompactTextString(m) }
+func (*CityRequest) ProtoMessage()               {}
+func (*CityRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{6} }
+
+func (m *CityRequest) GetCity() string {
+	if m != nil {
+		return m.City
+	}
+	return ""
+}
+
+type CityResult struct {
+	HotelIds []string `protobuf:"bytes,1,rep,name=hotelIds" json:"hotelIds,omitempty"`
+}
+
+func (m *CityResult) Reset()                    { *m = CityResult{} }
+func (m *CityResult) String() string            { return proto.CompactTextString(m) }
+func (*CityResult) ProtoMessage()               {}
+func (*CityResult) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{7} }
+
+func (m *CityResult) GetHotelIds() []string {
+	if m != nil {
+		return m.HotelIds
+	}
+	return nil
+}
+
+type FilterRequest struct {
+	HotelIds  []string `protobuf:"bytes,1,rep,name=hotelIds" json:"hotelIds,omitempty"`
+	Amenities []string `protobuf:"bytes,2,rep,name=amenities" json:"amenities,omitempty"`
+}
+
+func (m *FilterRequest) Reset()                    { *m = FilterRequest{} }
+func (m *FilterRequest) String() string            { return proto.CompactTextString(m) }
+func (*FilterRequest) ProtoMessage()               {}
+func (*FilterRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{8} }
+
+func (m *FilterRequest) GetHotelIds() []string {
+	if m != nil {
+		return m.HotelIds
+	}
+	return nil
+}
+
+func (m *FilterRequest) GetAmenities() []string {
+	if m != nil {
+		return m.Amenities
+	}
+	return nil
+}
+
+type FilterResult struct {
+	HotelIds []string `protobuf:"bytes,1,rep,name=hotelIds" json:"hotelIds,omitempty"`
+}
+
+func (m *FilterResult) Reset()                    { *m = FilterResult{} }
+func (m *FilterResult) String() string            { return proto.CompactTextString(m) }
+func (*FilterResult) ProtoMessage()               {}
+func (*FilterResult) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{9} }
+
+func (m *FilterResult) GetHotelIds() []string {
+	if m != nil {
+		return m.HotelIds
+	}
+	return nil
+}
+
+type ImageRequest struct {
+	// key of the image, its url without the "/images/" prefix
+	Key string `protobuf:"bytes,1,opt,name=key" json:"key,omitempty"`
+	// the content is left out if the image was stored at ifModTime, in
+	// nanoseconds since the Unix epoch, i.e. the caller has it already
+	IfModTime int64 `protobuf:"varint,2,opt,name=ifModTime" json:"ifModTime,omitempty"`
+}
+
+func (m *ImageRequest) Reset()                    { *m = ImageRequest{} }
+func (m *ImageRequest) String() string            { return proto.CompactTextString(m) }
+func (*ImageRequest) ProtoMessage()               {}
+func (*ImageRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{10} }
+
+func (m *ImageRequest) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *ImageRequest) GetIfModTime() int64 {
+	if m != nil {
+		return m.IfModTime
+	}
+	return 0
+}
+
+type ImageChunk struct {
+	// part of the image as JPEG
+	Content []byte `protobuf:"bytes,1,opt,name=content" json:"content,omitempty"`
+	// when the image was stored, in nanoseconds since the Unix epoch; set on
+	// the first chunk only
+	ModTime int64 `protobuf:"varint,2,opt,name=modTime" json:"modTime,omitempty"`
+	// the size of the image in bytes; set on the first chunk only
+	Size int64 `protobuf:"varint,3,opt,name=size" json:"size,omitempty"`
+}
+
+func (m *ImageChunk) Reset()                    { *m = ImageChunk{} }
+func (m *ImageChunk) String() string            { return proto.CompactTextString(m) }
+func (*ImageChunk) ProtoMessage()               {}
+func (*ImageChunk) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{11} }
+
+func (m *ImageChunk) GetContent() []byte {
+	if m != nil {
+		return m.Content
+	}
+	return nil
+}
+
+func (m *ImageChunk) GetModTime() int64 {
+	if m != nil {
+		return m.ModTime
+	}
+	return 0
+}
+
+func (m *ImageChunk) GetSize() int64 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Request)(nil), "profile.Request")
+	proto.RegisterType((*FieldMask)(nil), "profile.FieldMask")
 	proto.RegisterType((*Result)(nil), "profile.Result")
 	proto.RegisterType((*Hotel)(nil), "profile.Hotel")
 	proto.RegisterType((*Address)(nil), "profile.Address")
 	proto.RegisterType((*Image)(nil), "profile.Image")
+	proto.RegisterType((*CityRequest)(nil), "profile.CityRequest")
+	proto.RegisterType((*CityResult)(nil), "profile.CityResult")
+	proto.RegisterType((*FilterRequest)(nil), "profile.FilterRequest")
+	proto.RegisterType((*FilterResult)(nil), "profile.FilterResult")
+	proto.RegisterType((*ImageRequest)(nil), "profile.ImageRequest")
+	proto.RegisterType((*ImageChunk)(nil), "profile.ImageChunk")
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -248,6 +481,14 @@ const _ = grpc.SupportPackageIsVersion4
 
 type ProfileClient interface {
 	GetProfiles(ctx context.Context, in *Request, opts ...grpc.CallOption) (*Result, error)
+	// ResolveCity returns the hotels located in a city, state or country
+	ResolveCity(ctx context.Context, in *CityRequest, opts ...grpc.CallOption) (*CityResult, error)
+	// FilterHotels returns the given hotels that have all of the amenities
+	FilterHotels(ctx context.Context, in *FilterRequest, opts ...grpc.CallOption) (*FilterResult, error)
+	// GetImage streams an image of the profiles or its thumbnail. The first
+	// chunk holds when the image was stored and its size, the others its
+	// content.
+	GetImage(ctx context.Context, in *ImageRequest, opts ...grpc.CallOption) (Profile_GetImageClient, error)
 }
 
 type profileClient struct {
@@ -267,10 +508,68 @@ func (c *profileClient) GetProfiles(ctx context.Context, in *Request, opts ...gr
 	return out, nil
 }
 
+func (c *profileClient) ResolveCity(ctx context.Context, in *CityRequest, opts ...grpc.CallOption) (*CityResult, error) {
+	out := new(CityResult)
+	err := grpc.Invoke(ctx, "/profile.Profile/ResolveCity", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileClient) FilterHotels(ctx context.Context, in *FilterRequest, opts ...grpc.CallOption) (*FilterResult, error) {
+	out := new(FilterResult)
+	err := grpc.Invoke(ctx, "/profile.Profile/FilterHotels", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *profileClient) GetImage(ctx context.Context, in *ImageRequest, opts ...grpc.CallOption) (Profile_GetImageClient, error) {
+	stream, err := grpc.NewClientStream(ctx, &_Profile_serviceDesc.Streams[0], c.cc, "/profile.Profile/GetImage", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &profileGetImageClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Profile_GetImageClient interface {
+	Recv() (*ImageChunk, error)
+	grpc.ClientStream
+}
+
+type profileGetImageClient struct {
+	grpc.ClientStream
+}
+
+func (x *profileGetImageClient) Recv() (*ImageChunk, error) {
+	m := new(ImageChunk)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // Server API for Profile service
 
 type ProfileServer interface {
 	GetProfiles(context.Context, *Request) (*Result, error)
+	// ResolveCity returns the hotels located in a city, state or country
+	ResolveCity(context.Context, *CityRequest) (*CityResult, error)
+	// FilterHotels returns the given hotels that have all of the amenities
+	FilterHotels(context.Context, *FilterRequest) (*FilterResult, error)
+	// GetImage streams an image of the profiles or its thumbnail. The first
+	// chunk holds when the image was stored and its size, the others its
+	// content.
+	GetImage(*ImageRequest, Profile_GetImageServer) error
 }
 
 func RegisterProfileServer(s *grpc.Server, srv ProfileServer) {
@@ -295,6 +594,63 @@ func _Profile_GetProfiles_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Profile_ResolveCity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServer).ResolveCity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/profile.Profile/ResolveCity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServer).ResolveCity(ctx, req.(*CityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Profile_FilterHotels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FilterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProfileServer).FilterHotels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/profile.Profile/FilterHotels",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProfileServer).FilterHotels(ctx, req.(*FilterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Profile_GetImage_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ImageRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ProfileServer).GetImage(m, &profileGetImageServer{stream})
+}
+
+type Profile_GetImageServer interface {
+	Send(*ImageChunk) error
+	grpc.ServerStream
+}
+
+type profileGetImageServer struct {
+	grpc.ServerStream
+}
+
+func (x *profileGetImageServer) Send(m *ImageChunk) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 var _Profile_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "profile.Profile",
 	HandlerType: (*ProfileServer)(nil),
@@ -303,38 +659,69 @@ var _Profile_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetProfiles",
 			Handler:    _Profile_GetProfiles_Handler,
 		},
+		{
+			MethodName: "ResolveCity",
+			Handler:    _Profile_ResolveCity_Handler,
+		},
+		{
+			MethodName: "FilterHotels",
+			Handler:    _Profile_FilterHotels_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "GetImage",
+			Handler:       _Profile_GetImage_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "services/profile/proto/profile.proto",
 }
 
 func init() { proto.RegisterFile("services/profile/proto/profile.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 390 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x92, 0xc1, 0x6e, 0xd4, 0x30,
-	0x10, 0x86, 0x95, 0x6c, 0x93, 0xec, 0x4e, 0x50, 0xa9, 0x46, 0x08, 0x59, 0x3d, 0xa0, 0x28, 0x42,
-	0x28, 0xe2, 0x50, 0xaa, 0xed, 0x11, 0x71, 0x40, 0x1c, 0xa0, 0x17, 0x84, 0xfc, 0x06, 0x6e, 0x3c,
-	0xa5, 0x96, 0xbc, 0x71, 0xb0, 0x1d, 0xa4, 0x7d, 0x3e, 0x9e, 0x81, 0xf7, 0x41, 0x76, 0x9c, 0xdd,
-	0xd0, 0x53, 0xe6, 0xff, 0x66, 0x9c, 0xdf, 0xbf, 0x35, 0xf0, 0xd6, 0x91, 0xfd, 0xad, 0x7a, 0x72,
-	0x1f, 0x46, 0x6b, 0x1e, 0x95, 0xa6, 0xf0, 0xf5, 0x66, 0x51, 0x37, 0x51, 0x61, 0x95, 0x64, 0xfb,
-	0x09, 0x2a, 0x4e, 0xbf, 0x26, 0x72, 0x1e, 0xaf, 0x61, 0xfb, 0x64, 0x3c, 0xe9, 0x7b, 0xe9, 0x58,
-	0xd6, 0x6c, 0xba, 0x1d, 0x3f, 0x69, 0x7c, 0x0d, 0xa5, 0x36, 0xbd, 0xd0, 0xc4, 0xf2, 0x26, 0xeb,
-	0x76, 0x3c, 0xa9, 0xf6, 0x16, 0x4a, 0x4e, 0x6e, 0xd2, 0x1e, 0xdf, 0x41, 0x19, 0xa7, 0xe7, 0xb3,
-	0xf5, 0xfe, 0xf2, 0x66, 0x71, 0xfc, 0x16, 0x30, 0x4f, 0xdd, 0xf6, 0x4f, 0x06, 0x45, 0x24, 0x78,
-	0x09, 0xb9, 0x92, 0x2c, 0x8b, 0xff, 0xcb, 0x95, 0x44, 0x84, 0x8b, 0x41, 0x1c, 0x16, 0x87, 0x58,
-	0x63, 0x03, 0xf5, 0xf8, 0x64, 0x06, 0xfa, 0x3e, 0x1d, 0x1e, 0xc8, 0xb2, 0x4d, 0x6c, 0xad, 0x51,
-	0x98, 0x90, 0xe4, 0x7a, 0xab, 0x46, 0xaf, 0xcc, 0xc0, 0x2e, 0xe6, 0x89, 0x15, 0xc2, 0xf7, 0x50,
-	0x09, 0x29, 0x2d, 0x39, 0xc7, 0x8a, 0x26, 0xeb, 0xea, 0xfd, 0xd5, 0xe9, 0x6a, 0x9f, 0x67, 0xce,
-	0x97, 0x81, 0x90, 0x42, 0x1d, 0xc4, 0x4f, 0x72, 0xac, 0x7c, 0x96, 0xe2, 0x3e, 0x60, 0x9e, 0xba,
-	0xed, 0xdf, 0x0c, 0xaa, 0x74, 0x18, 0x5b, 0x78, 0xe1, 0xbc, 0x25, 0xf2, 0xe9, 0x92, 0x73, 0xa2,
-	0xff, 0x18, 0xbe, 0x01, 0x48, 0xfa, 0x9c, 0x70, 0x45, 0x42, 0xf6, 0x5e, 0xf9, 0x63, 0x0a, 0x18,
-	0x6b, 0x7c, 0x05, 0x85, 0xf3, 0xc2, 0x53, 0xca, 0x34, 0x0b, 0x64, 0x50, 0xf5, 0x66, 0x1a, 0xbc,
-	0x3d, 0xc6, 0x34, 0x3b, 0xbe, 0xc8, 0xe0, 0x31, 0x1a, 0xe7, 0x85, 0xfe, 0x62, 0x24, 0xb1, 0x72,
-	0xf6, 0x38, 0x13, 0xbc, 0x82, 0x8d, 0x16, 0x9e, 0x55, 0x4d, 0xd6, 0xe5, 0x3c, 0x94, 0x91, 0x98,
-	0x81, 0x6d, 0x13, 0x31, 0x43, 0x7b, 0x07, 0x45, 0x0c, 0x1a, 0x5a, 0x93, 0xd5, 0x29, 0x4b, 0x28,
-	0x83, 0xb1, 0xa4, 0x47, 0x31, 0x69, 0x1f, 0xef, 0xbf, 0xe5, 0x8b, 0xdc, 0x7f, 0x84, 0xea, 0xc7,
-	0xfc, 0x4a, 0x78, 0x0b, 0xf5, 0x57, 0xf2, 0x49, 0x39, 0x3c, 0xbf, 0x74, 0x5a, 0xb2, 0xeb, 0x97,
-	0x2b, 0x12, 0xf6, 0xe6, 0xa1, 0x8c, 0x0b, 0x79, 0xf7, 0x2f, 0x00, 0x00, 0xff, 0xff, 0x45, 0xf5,
-	0x1c, 0xec, 0xb8, 0x02, 0x00, 0x00,
+	// 670 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0x41, 0x6f, 0xd3, 0x4c,
+	0x10, 0x95, 0x93, 0x26, 0x8e, 0x27, 0xf9, 0xfa, 0x55, 0x0b, 0xad, 0xac, 0x08, 0xa1, 0xd4, 0x42,
+	0x28, 0xea, 0xa1, 0x44, 0xe5, 0xc2, 0x05, 0x24, 0x14, 0xa9, 0xa5, 0x87, 0x22, 0xb4, 0x2a, 0x3f,
+	0xc0, 0x8d, 0x27, 0xf5, 0xaa, 0x1b, 0x6f, 0xf0, 0x8e, 0x8b, 0xca, 0x9d, 0xbf, 0xcb, 0x85, 0x13,
+	0x37, 0xb4, 0xeb, 0xdd, 0xd8, 0x49, 0x25, 0xe0, 0xe4, 0x7d, 0x6f, 0xd7, 0x3b, 0xf3, 0xde, 0xcc,
+	0x2c, 0xbc, 0xd0, 0x58, 0xde, 0x8b, 0x05, 0xea, 0x57, 0xeb, 0x52, 0x2d, 0x85, 0x44, 0xf3, 0x25,
+	0xe5, 0xd1, 0xa9, 0x45, 0x2c, 0x74, 0x30, 0x51, 0x10, 0x72, 0xfc, 0x52, 0xa1, 0x26, 0x36, 0x86,
+	0x41, 0xae, 0x08, 0xe5, 0x65, 0xa6, 0xe3, 0x60, 0xd2, 0x9d, 0x46, 0x7c, 0x83, 0xd9, 0x11, 0xf4,
+	0xa5, 0x5a, 0xa4, 0x12, 0xe3, 0xce, 0x24, 0x98, 0x46, 0xdc, 0x21, 0x36, 0x83, 0x68, 0x29, 0x50,
+	0x66, 0x57, 0xa9, 0xbe, 0x8b, 0xbb, 0x93, 0x60, 0x3a, 0x3c, 0x63, 0xa7, 0x3e, 0xd4, 0xb9, 0xdf,
+	0xe1, 0xcd, 0xa1, 0xe4, 0x18, 0xa2, 0x0d, 0xcf, 0x9e, 0x42, 0x6f, 0x9d, 0x52, 0xee, 0xe3, 0xd5,
+	0x20, 0x99, 0x41, 0x9f, 0xa3, 0xae, 0x24, 0xb1, 0x97, 0xd0, 0xb7, 0x29, 0xd4, 0x07, 0x86, 0x67,
+	0xfb, 0x9b, 0xbb, 0x3f, 0x18, 0x9a, 0xbb, 0xdd, 0xe4, 0x57, 0x00, 0x3d, 0xcb, 0xb0, 0x7d, 0xe8,
+	0x88, 0x2c, 0x0e, 0x6c, 0x92, 0x1d, 0x91, 0x31, 0x06, 0x7b, 0x45, 0xba, 0xf2, 0x69, 0xdb, 0x35,
+	0x9b, 0xc0, 0x70, 0x9d, 0xab, 0x02, 0x3f, 0x56, 0xab, 0x1b, 0x2c, 0x6d, 0xda, 0x11, 0x6f, 0x53,
+	0xe6, 0x44, 0x86, 0x7a, 0x51, 0x8a, 0x35, 0x09, 0x55, 0xc4, 0x7b, 0xf5, 0x89, 0x16, 0xc5, 0x4e,
+	0x20, 0x4c, 0xb3, 0xac, 0x44, 0xad, 0xe3, 0x9e, 0x95, 0x7d, 0xb0, 0x49, 0xed, 0x7d, 0xcd, 0x73,
+	0x7f, 0xc0, 0xa8, 0x10, 0xab, 0xf4, 0x16, 0x75, 0xdc, 0xdf, 0x51, 0x71, 0x69, 0x68, 0xee, 0x76,
+	0xd9, 0x33, 0x88, 0xd2, 0x15, 0x16, 0x82, 0x04, 0xea, 0x38, 0xb4, 0x8e, 0x34, 0x44, 0xab, 0x04,
+	0x83, 0x76, 0x09, 0x92, 0x9f, 0x01, 0x84, 0x2e, 0x24, 0x4b, 0x60, 0xa4, 0xa9, 0x44, 0x24, 0x27,
+	0xad, 0xf6, 0x61, 0x8b, 0x63, 0xcf, 0x01, 0x1c, 0x6e, 0x7c, 0x69, 0x31, 0xc6, 0xb1, 0x85, 0xa0,
+	0x07, 0x67, 0x8b, 0x5d, 0x9b, 0x3a, 0x69, 0x4a, 0x09, 0x9d, 0x13, 0x35, 0x60, 0x31, 0x84, 0x0b,
+	0x55, 0x15, 0x54, 0x3e, 0x58, 0x0f, 0x22, 0xee, 0xa1, 0x89, 0xb1, 0x56, 0x9a, 0x52, 0x39, 0x57,
+	0x19, 0xc6, 0xfd, 0x3a, 0x46, 0xc3, 0xb0, 0x03, 0xe8, 0xca, 0x94, 0xe2, 0x70, 0x12, 0x4c, 0x3b,
+	0xdc, 0x2c, 0x2d, 0xa3, 0x8a, 0x78, 0xe0, 0x18, 0x55, 0x18, 0x37, 0x96, 0xaa, 0x5c, 0xa5, 0x44,
+	0x98, 0xc5, 0x91, 0xbd, 0xa2, 0x21, 0x92, 0xef, 0x01, 0xf4, 0xac, 0x7b, 0xe6, 0xcf, 0xaa, 0x94,
+	0x4e, 0xaa, 0x59, 0x9a, 0xbc, 0x32, 0x5c, 0xa6, 0x95, 0x24, 0x2b, 0x6f, 0xc0, 0x3d, 0x34, 0xfe,
+	0x50, 0x5e, 0xad, 0x6e, 0x8a, 0x54, 0xc8, 0xcf, 0xa5, 0x74, 0x1a, 0xb7, 0x38, 0xa3, 0xf5, 0xab,
+	0xc8, 0x28, 0xb7, 0x5a, 0x7b, 0xbc, 0x06, 0xc6, 0xfd, 0x1c, 0xc5, 0x6d, 0x4e, 0x56, 0x6a, 0x8f,
+	0x3b, 0x94, 0x1c, 0xc3, 0x70, 0x2e, 0xe8, 0xc1, 0xcf, 0x90, 0x37, 0x2f, 0x68, 0xcc, 0x4b, 0xa6,
+	0x00, 0xf5, 0x11, 0xdb, 0xd2, 0x7f, 0x98, 0xb2, 0xe4, 0x12, 0xfe, 0x3b, 0x17, 0x92, 0xb0, 0xfc,
+	0x97, 0x91, 0xdc, 0xea, 0x96, 0xce, 0x4e, 0xb7, 0x24, 0x27, 0x30, 0xf2, 0x57, 0xfd, 0x35, 0xec,
+	0x3b, 0x18, 0xd5, 0x8d, 0xe8, 0xa2, 0x1e, 0x40, 0xf7, 0x0e, 0xbd, 0x06, 0xb3, 0x34, 0xb1, 0xc4,
+	0xf2, 0x4a, 0x65, 0xd7, 0xc2, 0xb5, 0x4c, 0x97, 0x37, 0x44, 0x72, 0x0d, 0x60, 0xff, 0x9f, 0xe7,
+	0x55, 0x71, 0x57, 0x77, 0x45, 0x41, 0x58, 0x90, 0xbd, 0x61, 0xc4, 0x3d, 0x34, 0x3b, 0xab, 0xad,
+	0x3b, 0x3c, 0x34, 0xb6, 0x69, 0xf1, 0x0d, 0x6d, 0x3d, 0xba, 0xdc, 0xae, 0xcf, 0x7e, 0x04, 0x10,
+	0x7e, 0xaa, 0xe7, 0x84, 0xcd, 0x60, 0x78, 0x81, 0xe4, 0x90, 0x66, 0xcd, 0xac, 0xb9, 0x94, 0xc7,
+	0xff, 0xb7, 0x18, 0xab, 0xf7, 0x0d, 0x0c, 0x39, 0x6a, 0x25, 0xef, 0x71, 0x6e, 0x1b, 0x78, 0xb3,
+	0xdf, 0xaa, 0xd6, 0xf8, 0xc9, 0x0e, 0x6b, 0xff, 0x7c, 0xeb, 0x9d, 0xb3, 0x0f, 0x8a, 0x66, 0x47,
+	0xad, 0xf7, 0xac, 0x55, 0x9b, 0xf1, 0xe1, 0x23, 0xde, 0x05, 0x1e, 0x5c, 0x20, 0xd5, 0xad, 0x79,
+	0xb8, 0x33, 0xe8, 0x8f, 0xc2, 0x36, 0xb6, 0xcd, 0x82, 0x9b, 0xbe, 0x7d, 0x9a, 0x5f, 0xff, 0x06,
+	0x00, 0x00, 0xff, 0xff, 0x03, 0x00, 0x11, 0x08, 0x0f, 0xd5, 0xc2, 0x05, 0x00, 0x00,
 }
@@ -1,12 +1,8 @@
 package profile
 
 import (
-	"encoding/json"
 	"fmt"
 
-	"gopkg.in/mgo.v2"
-	"gopkg.in/mgo.v2/bson"
-
 	// "io/ioutil"
 	"net"
 	// "os"
@@ -16,29 +12,38 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/grpc-ecosystem/grpc-opentracing/go/otgrpc"
+	"github.com/harlow/go-micro-services/cachecodec"
+	"github.com/harlow/go-micro-services/media"
 	"github.com/harlow/go-micro-services/registry"
+	"github.com/harlow/go-micro-services/rpcerr"
 	pb "github.com/harlow/go-micro-services/services/profile/proto"
 	"github.com/harlow/go-micro-services/tls"
+	"github.com/harlow/go-micro-services/tune"
 	"github.com/opentracing/opentracing-go"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/keepalive"
 
 	"github.com/bradfitz/gomemcache/memcache"
-	// "strings"
+	"strings"
 )
 
 const name = "srv-profile"
 
 // Server implements the profile service
 type Server struct {
+	cities       *cityIndex
+	amenities    *amenityIndex
+	placeholders *placeholders
 	Tracer       opentracing.Tracer
 	uuid         string
 	Port         int
 	IpAddr       string
-	MongoSession *mgo.Session
+	Store        ProfileStore
 	Registry     *registry.Client
-	MemcClient   *memcache.Client
+	MemcClient   *tune.MemCClient
+	// Images holds the hotel images, none are recorded or served if nil
+	Images media.BlobStore
 }
 
 // Run starts the server
@@ -47,6 +52,20 @@ func (s *Server) Run() error {
 		return fmt.Errorf("server port must be set")
 	}
 
+	if s.cities == nil {
+		s.cities = newCityIndex(s.Store)
+	}
+	if s.amenities == nil {
+		s.amenities = newAmenityIndex(s.Store)
+	}
+	if s.Images != nil && s.placeholders == nil {
+		placeholders, err := loadImages(s.Store, s.Images)
+		if err != nil {
+			return fmt.Errorf("failed to load images: %v", err)
+		}
+		s.placeholders = placeholders
+	}
+
 	s.uuid = uuid.New().String()
 
 	log.Trace().Msgf("in run s.IpAddr = %s, port = %d", s.IpAddr, s.Port)
@@ -113,56 +132,161 @@ func (s *Server) GetProfiles(ctx context.Context, req *pb.Request) (*pb.Result,
 
 	log.Trace().Msgf("In GetProfiles")
 
+	locale, err := canonicalLocale(req.Locale)
+	if err != nil {
+		return nil, err
+	}
+	mask, err := newProfileMask(req.FieldMask)
+	if err != nil {
+		return nil, err
+	}
+
 	res := new(pb.Result)
 	hotels := make([]*pb.Hotel, 0)
 
 	// one hotel should only have one profile
-
+	profiles, err := s.getProfiles(req.HotelIds, locale)
+	if err != nil {
+		return nil, err
+	}
+	// profiles are cached whole, and masked once they are read
 	for _, i := range req.HotelIds {
-		// first check memcached
-		item, err := s.MemcClient.Get(i)
-		if err == nil {
-			// memcached hit
-			profile_str := string(item.Value)
-			log.Trace().Msgf("memc hit with %v", profile_str)
+		if hotel_prof, ok := profiles[i]; ok {
+			hotels = append(hotels, mask.apply(hotel_prof))
+		}
+	}
 
-			hotel_prof := new(pb.Hotel)
-			json.Unmarshal(item.Value, hotel_prof)
-			hotels = append(hotels, hotel_prof)
+	res.Hotels = hotels
+	log.Trace().Msgf("In GetProfiles after getting resp")
+	return res, nil
+}
 
-		} else if err == memcache.ErrCacheMiss {
-			// memcached miss, set up mongo connection
-			session := s.MongoSession.Copy()
-			defer session.Close()
-			c := session.DB("profile-db").C("hotels")
+// profileKey is the memcached key of the profile of a hotel localized for
+// a canonical locale. Profiles are cached by the locale asked for, which
+// may differ from the locale served.
+func profileKey(hotelId, locale string) string {
+	return hotelId + "_profile_" + locale
+}
 
-			hotel_prof := new(pb.Hotel)
-			err := c.Find(bson.M{"id": i}).One(&hotel_prof)
+// getProfiles returns the profiles of several hotels localized for a
+// canonical locale by hotel id. Cached profiles are read with a single
+// GetMulti and the missing ones with a single query for the profiles and
+// another for their localizations, after which they are all written back
+// to memcached. Hotels without a profile are left out.
+func (s *Server) getProfiles(hotelIds []string, locale string) (map[string]*pb.Hotel, error) {
+	// first check memcached
+	keys := make([]string, 0, len(hotelIds))
+	for _, i := range hotelIds {
+		keys = append(keys, profileKey(i, locale))
+	}
+	items, err := s.MemcClient.GetMulti(keys)
+	if err != nil {
+		// memcached unavailable, read through to mongo
+		if err != tune.ErrMemCUnavailable {
+			log.Warn().Msgf("Tried to get hotelIds %v, but got memmcached error = %s", hotelIds, err)
+		}
+	}
 
-			if err != nil {
-				log.Error().Msgf("Failed get hotels data: ", err)
+	profiles := make(map[string]*pb.Hotel)
+	missing := make([]string, 0)
+	seen := make(map[string]bool)
+	for _, i := range hotelIds {
+		if seen[i] {
+			continue
+		}
+		seen[i] = true
+		if item, ok := items[profileKey(i, locale)]; ok {
+			// memcached hit
+			hotel_prof, err := decodeProfile(item.Value)
+			if err == nil {
+				log.Trace().Msgf("memc hit with %v", hotel_prof)
+				profiles[i] = hotel_prof
+				continue
 			}
+			log.Warn().Msgf("Dropping cached profile [%v]: %s", item.Key, err)
+		}
+		missing = append(missing, i)
+	}
+	if len(missing) == 0 {
+		return profiles, nil
+	}
 
-			// for _, h := range hotels {
-			// 	res.Hotels = append(res.Hotels, h)
-			// }
-			hotels = append(hotels, hotel_prof)
-
-			prof_json, err := json.Marshal(hotel_prof)
-			if err != nil {
-				log.Error().Msgf("Failed to marshal hotel [id: %v] with err:", hotel_prof.Id, err)
-			}
-			memc_str := string(prof_json)
+	// memcached miss
+	found, err := s.Store.GetProfiles(missing)
+	if err != nil {
+		log.Error().Msgf("Failed get hotels data: %s", err)
+		return nil, rpcerr.Mongo(err)
+	}
+	chain := localeChain(locale)
+	localizations, err := s.Store.GetLocalizations(missing, chain)
+	if err != nil {
+		log.Error().Msgf("Failed get hotels localizations for locale [%v]: %s", locale, err)
+		return nil, rpcerr.Mongo(err)
+	}
+	localized := make(map[string]map[string]*Localization)
+	for _, l := range localizations {
+		if localized[l.HotelId] == nil {
+			localized[l.HotelId] = make(map[string]*Localization)
+		}
+		localized[l.HotelId][l.Locale] = l
+	}
 
-			// write to memcached
-			s.MemcClient.Set(&memcache.Item{Key: i, Value: []byte(memc_str)})
+	backfill := make([]*memcache.Item, 0, len(missing))
+	for _, i := range missing {
+		hotel_prof, ok := found[i]
+		if !ok {
+			log.Warn().Msgf("Skipping hotel [%v] without a profile", i)
+			continue
+		}
+		localize(hotel_prof, chain, localized[i])
+		profiles[i] = hotel_prof
 
-		} else {
-			log.Panic().Msgf("Tried to get hotelId [%v], but got memmcached error = %s", i, err)
+		value, err := profileCodec.Encode(hotel_prof)
+		if err != nil {
+			log.Error().Msgf("Failed to encode hotel [id: %v] with err: %s", hotel_prof.Id, err)
+			continue
 		}
+		backfill = append(backfill, &memcache.Item{Key: profileKey(i, locale), Value: value})
 	}
 
-	res.Hotels = hotels
-	log.Trace().Msgf("In GetProfiles after getting resp")
+	// write to memcached
+	s.MemcClient.SetMulti(backfill)
+	return profiles, nil
+}
+
+// profileCodec encodes cached profiles. Profiles are cached by locale since
+// version 2, and those cached before under their hotel id alone are not
+// read any more. Version 3 caches their images too.
+var profileCodec = cachecodec.Codec{Version: 3}
+
+// decodeProfile decodes a profile encoded by profileCodec
+func decodeProfile(value []byte) (*pb.Hotel, error) {
+	hotel_prof := new(pb.Hotel)
+	if err := profileCodec.Decode(value, hotel_prof); err != nil {
+		return nil, err
+	}
+	if hotel_prof.Id == "" || hotel_prof.Locale == "" {
+		return nil, fmt.Errorf("%w: profile without id or locale", cachecodec.ErrUndecodable)
+	}
+	return hotel_prof, nil
+}
+
+// ResolveCity returns the hotels located in a city, state or country
+func (s *Server) ResolveCity(ctx context.Context, req *pb.CityRequest) (*pb.CityResult, error) {
+	if strings.TrimSpace(req.City) == "" {
+		return nil, rpcerr.InvalidArgument("city", "no city given")
+	}
+
+	res := new(pb.CityResult)
+	res.HotelIds = s.cities.Lookup(req.City)
+	log.Trace().Msgf("city %q has %d hotels", req.City, len(res.HotelIds))
+	return res, nil
+}
+
+// FilterHotels returns the given hotels that have all of the amenities
+func (s *Server) FilterHotels(ctx context.Context, req *pb.FilterRequest) (*pb.FilterResult, error) {
+	res := new(pb.FilterResult)
+	res.HotelIds = s.amenities.Filter(req.HotelIds, req.Amenities)
+	log.Trace().Msgf("%d of %d hotels have amenities %v", len(res.HotelIds), len(req.HotelIds), req.Amenities)
 	return res, nil
 }
@@ -0,0 +1,24 @@
+package profile
+
+import (
+	"testing"
+
+	pb "github.com/harlow/go-micro-services/services/profile/proto"
+	"golang.org/x/net/context"
+)
+
+func TestGetProfilesSkipsHotelsWithoutProfile(t *testing.T) {
+	s := &Server{Store: NewMemoryStore()}
+
+	res, err := s.GetProfiles(context.Background(), &pb.Request{HotelIds: []string{"1", "no-profile", "2"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ids := make([]string, 0)
+	for _, hotel := range res.Hotels {
+		ids = append(ids, hotel.Id)
+	}
+	if len(ids) != 2 || ids[0] != "1" || ids[1] != "2" {
+		t.Errorf("got profiles of %v, want 1 and 2", ids)
+	}
+}
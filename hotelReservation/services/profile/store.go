@@ -0,0 +1,220 @@
+package profile
+
+import (
+	"encoding/json"
+	"sync"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/harlow/go-micro-services/data"
+	pb "github.com/harlow/go-micro-services/services/profile/proto"
+	"gopkg.in/mgo.v2"
+	"gopkg.in/mgo.v2/bson"
+)
+
+// ProfileStore stores hotel profiles
+type ProfileStore interface {
+	// GetProfiles returns the profiles of several hotels by hotel id in a
+	// single query. Unknown hotels are left out.
+	GetProfiles(hotelIds []string) (map[string]*pb.Hotel, error)
+	// GetLocalizations returns the localizations of several hotels in any
+	// of locales in a single query.
+	GetLocalizations(hotelIds []string, locales []string) ([]*Localization, error)
+	// Addresses returns the address of every hotel by hotel id.
+	Addresses() (map[string]*pb.Address, error)
+	// Amenities returns the amenity tags of every hotel by hotel id.
+	Amenities() (map[string][]string, error)
+	// SetImages replaces the images of a hotel, it returns false if there
+	// is no such hotel.
+	SetImages(hotelId string, images []*pb.Image) (bool, error)
+}
+
+type mongoStore struct {
+	session *mgo.Session
+}
+
+// NewMongoStore returns a ProfileStore backed by the profile-db database
+func NewMongoStore(session *mgo.Session) ProfileStore {
+	return &mongoStore{session: session}
+}
+
+func (m *mongoStore) GetProfiles(hotelIds []string) (map[string]*pb.Hotel, error) {
+	s := m.session.Copy()
+	defer s.Close()
+
+	hotels := make([]*pb.Hotel, 0)
+	err := s.DB("profile-db").C("hotels").Find(bson.M{"id": bson.M{"$in": hotelIds}}).All(&hotels)
+	if err != nil {
+		return nil, err
+	}
+
+	profiles := make(map[string]*pb.Hotel)
+	for _, hotel := range hotels {
+		profiles[hotel.Id] = hotel
+	}
+	return profiles, nil
+}
+
+func (m *mongoStore) GetLocalizations(hotelIds []string, locales []string) ([]*Localization, error) {
+	s := m.session.Copy()
+	defer s.Close()
+
+	localizations := make([]*Localization, 0)
+	err := s.DB("profile-db").C("locales").Find(bson.M{
+		"hotelId": bson.M{"$in": hotelIds},
+		"locale":  bson.M{"$in": locales},
+	}).All(&localizations)
+	if err != nil {
+		return nil, err
+	}
+	return localizations, nil
+}
+
+func (m *mongoStore) Addresses() (map[string]*pb.Address, error) {
+	s := m.session.Copy()
+	defer s.Close()
+
+	hotels := make([]*pb.Hotel, 0)
+	err := s.DB("profile-db").C("hotels").Find(nil).Select(bson.M{"id": 1, "address": 1}).All(&hotels)
+	if err != nil {
+		return nil, err
+	}
+
+	addresses := make(map[string]*pb.Address)
+	for _, hotel := range hotels {
+		if hotel.Address != nil {
+			addresses[hotel.Id] = hotel.Address
+		}
+	}
+	return addresses, nil
+}
+
+func (m *mongoStore) Amenities() (map[string][]string, error) {
+	s := m.session.Copy()
+	defer s.Close()
+
+	hotels := make([]*pb.Hotel, 0)
+	err := s.DB("profile-db").C("hotels").Find(nil).Select(bson.M{"id": 1, "amenities": 1}).All(&hotels)
+	if err != nil {
+		return nil, err
+	}
+
+	amenities := make(map[string][]string)
+	for _, hotel := range hotels {
+		amenities[hotel.Id] = hotel.Amenities
+	}
+	return amenities, nil
+}
+
+func (m *mongoStore) SetImages(hotelId string, images []*pb.Image) (bool, error) {
+	s := m.session.Copy()
+	defer s.Close()
+
+	err := s.DB("profile-db").C("hotels").Update(bson.M{"id": hotelId}, bson.M{"$set": bson.M{"images": images}})
+	if err == mgo.ErrNotFound {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+type memoryStore struct {
+	mu            sync.RWMutex
+	hotels        map[string]*pb.Hotel
+	localizations map[string][]*Localization
+}
+
+// NewMemoryStore returns a ProfileStore kept in memory and seeded from
+// data/hotels.json and data/locales.json
+func NewMemoryStore() ProfileStore {
+	hotels := make([]*pb.Hotel, 0)
+	if err := json.Unmarshal(data.MustAsset("data/hotels.json"), &hotels); err != nil {
+		panic(err)
+	}
+	localizations := make([]*Localization, 0)
+	if err := json.Unmarshal(data.MustAsset("data/locales.json"), &localizations); err != nil {
+		panic(err)
+	}
+
+	m := &memoryStore{
+		hotels:        make(map[string]*pb.Hotel),
+		localizations: make(map[string][]*Localization),
+	}
+	for _, hotel := range hotels {
+		m.hotels[hotel.Id] = hotel
+	}
+	for _, l := range localizations {
+		m.localizations[l.HotelId] = append(m.localizations[l.HotelId], l)
+	}
+	return m
+}
+
+func (m *memoryStore) GetProfiles(hotelIds []string) (map[string]*pb.Hotel, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	profiles := make(map[string]*pb.Hotel)
+	for _, hotelId := range hotelIds {
+		if hotel, ok := m.hotels[hotelId]; ok {
+			profiles[hotelId] = proto.Clone(hotel).(*pb.Hotel)
+		}
+	}
+	return profiles, nil
+}
+
+func (m *memoryStore) GetLocalizations(hotelIds []string, locales []string) ([]*Localization, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	wanted := make(map[string]bool)
+	for _, locale := range locales {
+		wanted[locale] = true
+	}
+	localizations := make([]*Localization, 0)
+	for _, hotelId := range hotelIds {
+		for _, l := range m.localizations[hotelId] {
+			if wanted[l.Locale] {
+				copied := *l
+				localizations = append(localizations, &copied)
+			}
+		}
+	}
+	return localizations, nil
+}
+
+func (m *memoryStore) Addresses() (map[string]*pb.Address, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	addresses := make(map[string]*pb.Address)
+	for id, hotel := range m.hotels {
+		if hotel.Address != nil {
+			addresses[id] = proto.Clone(hotel.Address).(*pb.Address)
+		}
+	}
+	return addresses, nil
+}
+
+func (m *memoryStore) Amenities() (map[string][]string, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	amenities := make(map[string][]string)
+	for id, hotel := range m.hotels {
+		amenities[id] = append([]string(nil), hotel.Amenities...)
+	}
+	return amenities, nil
+}
+
+func (m *memoryStore) SetImages(hotelId string, images []*pb.Image) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	hotel, ok := m.hotels[hotelId]
+	if !ok {
+		return false, nil
+	}
+	hotel.Images = make([]*pb.Image, 0, len(images))
+	for _, image := range images {
+		hotel.Images = append(hotel.Images, proto.Clone(image).(*pb.Image))
+	}
+	return true, nil
+}
@@ -0,0 +1,369 @@
+package rate
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bradfitz/gomemcache/memcache"
+	"github.com/golang/protobuf/proto"
+	"github.com/harlow/go-micro-services/cachecodec"
+	"github.com/harlow/go-micro-services/rpcerr"
+	pb "github.com/harlow/go-micro-services/services/rate/proto"
+	"github.com/harlow/go-micro-services/tune"
+	"github.com/rs/zerolog/log"
+)
+
+// maxStayNights bounds the date range rates are looked up for.
+const maxStayNights = 366
+
+// checkStay validates the dates of a stay and returns its nights
+func checkStay(inDate, outDate string) ([]string, error) {
+	in, err := time.Parse("2006-01-02", inDate)
+	if err != nil {
+		return nil, rpcerr.InvalidArgument("inDate", "%s", err)
+	}
+	out, err := time.Parse("2006-01-02", outDate)
+	if err != nil {
+		return nil, rpcerr.InvalidArgument("outDate", "%s", err)
+	}
+	if !out.After(in) {
+		return nil, rpcerr.InvalidArgument("outDate", "%s is not after inDate %s", outDate, inDate)
+	}
+	if out.Sub(in) > maxStayNights*24*time.Hour {
+		return nil, rpcerr.InvalidArgument("outDate", "the stay spans more than %d nights", maxStayNights)
+	}
+
+	nights := make([]string, 0)
+	for d := in; d.Before(out); d = d.AddDate(0, 0, 1) {
+		nights = append(nights, d.Format("2006-01-02"))
+	}
+	return nights, nil
+}
+
+const (
+	// nightTTL is how long the rates of a night stay cached. It bounds how
+	// long readers see old rates if invalidating them failed.
+	nightTTL = 10 * time.Minute
+	// generationTTL is how long a cache generation lives. A generation
+	// that expired starts over like one that went missing.
+	generationTTL = 24 * time.Hour
+)
+
+// nightKey is the memcached key of the rate plans of a hotel that cover a
+// night, in a generation of its cache. Rates are cached per night rather
+// than per stay, so stays that overlap share their cached nights.
+func nightKey(hotelId, generation, date string) string {
+	return hotelId + "_rates_" + generation + "_" + date
+}
+
+func generationKey(hotelId string) string {
+	return hotelId + "_rates_gen"
+}
+
+// generations returns the cache generation of the rates of several hotels
+// by hotel id. Hotels whose rates can't be cached are left out. Changing
+// the rate plans of a hotel bumps its generation, which invalidates all of
+// its cached nights at once and for good. A generation that went missing
+// starts over at the current time, so it never returns to an old
+// generation with nights still cached.
+func (s *Server) generations(hotelIds []string) map[string]string {
+	keys := make([]string, 0, len(hotelIds))
+	for _, hotelId := range hotelIds {
+		keys = append(keys, generationKey(hotelId))
+	}
+	items, err := s.MemcClient.GetMulti(keys)
+	if err != nil {
+		if err != tune.ErrMemCUnavailable {
+			log.Warn().Msgf("Memmcached error while trying to get rates generations of hotels %v= %s", hotelIds, err)
+		}
+		return map[string]string{}
+	}
+
+	start := []byte(strconv.FormatInt(time.Now().UnixNano(), 10))
+	missing := make([]*memcache.Item, 0)
+	for _, memc_key := range keys {
+		if _, ok := items[memc_key]; !ok {
+			missing = append(missing, &memcache.Item{
+				Key:        memc_key,
+				Value:      start,
+				Expiration: int32(generationTTL / time.Second),
+			})
+		}
+	}
+	if len(missing) > 0 {
+		// Add never overwrites, so concurrent readers agree on one start
+		s.MemcClient.AddMulti(missing)
+		started := make([]string, 0, len(missing))
+		for _, item := range missing {
+			started = append(started, item.Key)
+		}
+		added, err := s.MemcClient.GetMulti(started)
+		if err != nil {
+			if err != tune.ErrMemCUnavailable {
+				log.Warn().Msgf("Memmcached error while trying to get rates generations of hotels %v= %s", hotelIds, err)
+			}
+		}
+		for memc_key, item := range added {
+			items[memc_key] = item
+		}
+	}
+
+	generations := make(map[string]string)
+	for _, hotelId := range hotelIds {
+		if item, ok := items[generationKey(hotelId)]; ok {
+			generations[hotelId] = string(item.Value)
+		}
+	}
+	return generations
+}
+
+// invalidate bumps the cache generation of the rates of a hotel, after its
+// rate plans have been changed. Readers that got the old generation before
+// may still cache what they read under it, but no one reads it any more.
+func (s *Server) invalidate(hotelId string) error {
+	_, err := s.MemcClient.Increment(generationKey(hotelId), 1)
+	if err == memcache.ErrCacheMiss {
+		// nothing is cached under the next generation to start
+		return nil
+	}
+	return err
+}
+
+// getNightlyPlans returns the rate plans of several hotels that cover each
+// of nights, by hotel id and night. Cached nights are read with a single
+// GetMulti and the missing ones with a single query over the hotels and
+// nights missing, after which they are all written back to memcached.
+func (s *Server) getNightlyPlans(hotelIds []string, nights []string) (map[string]map[string][]*pb.RatePlan, error) {
+	generations := s.generations(hotelIds)
+	keys := make([]string, 0, len(generations)*len(nights))
+	for hotelId, generation := range generations {
+		for _, date := range nights {
+			keys = append(keys, nightKey(hotelId, generation, date))
+		}
+	}
+	items := map[string]*memcache.Item{}
+	if len(keys) > 0 {
+		var err error
+		items, err = s.MemcClient.GetMulti(keys)
+		if err != nil {
+			// memcached unavailable, read through to mongo
+			if err != tune.ErrMemCUnavailable {
+				log.Warn().Msgf("Memmcached error while trying to get rates of hotels %v= %s", hotelIds, err)
+			}
+		}
+	}
+
+	nightly := make(map[string]map[string][]*pb.RatePlan)
+	missing := make(map[string][]string)
+	missingHotels := make([]string, 0)
+	fromDate, toDate := "", ""
+	for _, hotelId := range hotelIds {
+		if _, ok := nightly[hotelId]; ok {
+			continue
+		}
+		nightly[hotelId] = make(map[string][]*pb.RatePlan)
+		generation, cached := generations[hotelId]
+		for _, date := range nights {
+			if cached {
+				memc_key := nightKey(hotelId, generation, date)
+				if item, ok := items[memc_key]; ok {
+					// memcached hit
+					ratePlans, err := decodeRatePlans(item.Value)
+					if err == nil {
+						nightly[hotelId][date] = ratePlans
+						continue
+					}
+					log.Warn().Msgf("Dropping cached rates [%v]: %s", memc_key, err)
+					s.MemcClient.Delete(memc_key)
+				}
+			}
+			if len(missing[hotelId]) == 0 {
+				missingHotels = append(missingHotels, hotelId)
+			}
+			missing[hotelId] = append(missing[hotelId], date)
+			if fromDate == "" || date < fromDate {
+				fromDate = date
+			}
+			if date > toDate {
+				toDate = date
+			}
+		}
+	}
+	if len(missingHotels) == 0 {
+		return nightly, nil
+	}
+
+	// memcached miss
+	log.Trace().Msgf("memc miss, hotelIds = %v", missingHotels)
+	inDate, outDate := fromDate, nextDay(toDate)
+	ratePlans, err := s.Store.GetRatePlans(missingHotels, inDate, outDate)
+	if err != nil {
+		log.Error().Msgf("Tried to find hotelIds %v from [%v] to [%v], but got error = %s", missingHotels, inDate, outDate, err)
+		return nil, rpcerr.Mongo(err)
+	}
+	byHotel := make(map[string][]*pb.RatePlan)
+	for _, r := range ratePlans {
+		byHotel[r.HotelId] = append(byHotel[r.HotelId], r)
+	}
+
+	backfill := make([]*memcache.Item, 0)
+	for _, hotelId := range missingHotels {
+		generation, cached := generations[hotelId]
+		for _, date := range missing[hotelId] {
+			nightly[hotelId][date] = plansCovering(byHotel[hotelId], date)
+			if !cached {
+				continue
+			}
+			value, err := encodeRatePlans(nightly[hotelId][date])
+			if err != nil {
+				log.Error().Msgf("Failed to encode rates of hotel [id: %v] on [%v] with error: %s", hotelId, date, err)
+				continue
+			}
+			backfill = append(backfill, &memcache.Item{
+				Key:        nightKey(hotelId, generation, date),
+				Value:      value,
+				Expiration: int32(nightTTL / time.Second),
+			})
+		}
+	}
+
+	// write to memcached
+	s.MemcClient.SetMulti(backfill)
+	return nightly, nil
+}
+
+// plansCovering returns the rate plans that cover the night of date. If
+// plans of the same code and room type overlap, the one starting last
+// covers the night.
+func plansCovering(ratePlans []*pb.RatePlan, date string) []*pb.RatePlan {
+	covering := make([]*pb.RatePlan, 0)
+	index := make(map[planKey]int)
+	for _, r := range ratePlans {
+		if r.RoomType == nil || r.InDate > date || r.OutDate <= date {
+			continue
+		}
+		key := planKeyOf(r)
+		if i, ok := index[key]; ok {
+			if r.InDate > covering[i].InDate {
+				covering[i] = r
+			}
+			continue
+		}
+		index[key] = len(covering)
+		covering = append(covering, r)
+	}
+	return covering
+}
+
+// planKey identifies a rate plan across the nights it covers
+type planKey struct {
+	Code     string
+	RoomType string
+}
+
+func planKeyOf(r *pb.RatePlan) planKey {
+	return planKey{Code: r.Code, RoomType: r.RoomType.Code}
+}
+
+// stayPlans prices the rate plans of a hotel that cover every night of a
+// stay. Rates are summed over the nights of the stay, which may differ from
+// night to night; plans whose currency changes during the stay can't be
+// summed and are left out.
+func stayPlans(hotelId, inDate, outDate string, nights []string, nightly map[string][]*pb.RatePlan) []*pb.RatePlan {
+	byNight := make([]map[planKey]*pb.RatePlan, len(nights))
+	for i, date := range nights {
+		byNight[i] = make(map[planKey]*pb.RatePlan)
+		for _, r := range nightly[date] {
+			byNight[i][planKeyOf(r)] = r
+		}
+	}
+
+	ratePlans := make([]*pb.RatePlan, 0)
+next:
+	for _, first := range nightly[nights[0]] {
+		key := planKeyOf(first)
+		stay := &pb.RatePlan{
+			HotelId: hotelId,
+			Code:    first.Code,
+			InDate:  inDate,
+			OutDate: outDate,
+			RoomType: &pb.RoomType{
+				Code:            first.RoomType.Code,
+				Currency:        first.RoomType.Currency,
+				RoomDescription: first.RoomType.RoomDescription,
+			},
+			NightlyRates: make([]*pb.NightlyRate, 0, len(nights)),
+		}
+		for i, date := range nights {
+			r, ok := byNight[i][key]
+			if !ok || !strings.EqualFold(r.RoomType.Currency, first.RoomType.Currency) {
+				continue next
+			}
+			stay.NightlyRates = append(stay.NightlyRates, &pb.NightlyRate{
+				Date:               date,
+				BookableRate:       r.RoomType.BookableRate,
+				TotalRate:          r.RoomType.TotalRate,
+				TotalRateInclusive: r.RoomType.TotalRateInclusive,
+			})
+		}
+		sumNights(stay)
+		ratePlans = append(ratePlans, stay)
+	}
+	return ratePlans
+}
+
+func roundCents(amount float64) float64 {
+	return math.Round(amount*100) / 100
+}
+
+func nextDay(date string) string {
+	d, _ := time.Parse("2006-01-02", date)
+	return d.AddDate(0, 0, 1).Format("2006-01-02")
+}
+
+// nightCodec encodes the rate plans cached for a night as a Result. Nights
+// cached before were encoded as one JSON plan per line.
+var nightCodec = cachecodec.Codec{Version: 1, Legacy: decodeLegacyRatePlans}
+
+// encodeRatePlans encodes rate plans for memcached
+func encodeRatePlans(ratePlans []*pb.RatePlan) ([]byte, error) {
+	return nightCodec.Encode(&pb.Result{RatePlans: ratePlans})
+}
+
+// decodeRatePlans decodes rate plans encoded by encodeRatePlans
+func decodeRatePlans(value []byte) ([]*pb.RatePlan, error) {
+	res := new(pb.Result)
+	if err := nightCodec.Decode(value, res); err != nil {
+		return nil, err
+	}
+	for _, r := range res.RatePlans {
+		if r.RoomType == nil {
+			return nil, fmt.Errorf("%w: rate plan without room type", cachecodec.ErrUndecodable)
+		}
+	}
+	if res.RatePlans == nil {
+		res.RatePlans = make([]*pb.RatePlan, 0)
+	}
+	return res.RatePlans, nil
+}
+
+// decodeLegacyRatePlans decodes rate plans cached as one JSON plan per line
+// into a Result.
+func decodeLegacyRatePlans(value []byte, msg proto.Message) error {
+	res := msg.(*pb.Result)
+	for _, rate_str := range strings.Split(string(value), "\n") {
+		if len(rate_str) == 0 {
+			continue
+		}
+		rate_p := new(pb.RatePlan)
+		if err := json.Unmarshal([]byte(rate_str), rate_p); err != nil {
+			return err
+		}
+		res.RatePlans = append(res.RatePlans, rate_p)
+	}
+	return nil
+}
@@ -0,0 +1,149 @@
+package rate
+
+import (
+	"time"
+
+	"github.com/harlow/go-micro-services/rpcerr"
+	pb "github.com/harlow/go-micro-services/services/rate/proto"
+	"github.com/rs/zerolog/log"
+	"golang.org/x/net/context"
+)
+
+// CreateRatePlan stores a new rate plan
+func (s *Server) CreateRatePlan(ctx context.Context, req *pb.RatePlan) (*pb.RatePlan, error) {
+	ratePlan, err := checkRatePlan(req)
+	if err != nil {
+		return nil, err
+	}
+
+	ok, err := s.Store.CreateRatePlan(ratePlan)
+	if err != nil {
+		log.Error().Msgf("Tried to create plan [Code: %v] of hotelId [%v], but got error = %s", ratePlan.Code, ratePlan.HotelId, err)
+		return nil, rpcerr.Mongo(err)
+	}
+	if !ok {
+		return nil, rpcerr.AlreadyExists("rate plan", planName(ratePlan))
+	}
+
+	s.invalidateRates(ratePlan.HotelId)
+	return ratePlan, nil
+}
+
+// UpdateRatePlan replaces a stored rate plan
+func (s *Server) UpdateRatePlan(ctx context.Context, req *pb.RatePlan) (*pb.RatePlan, error) {
+	ratePlan, err := checkRatePlan(req)
+	if err != nil {
+		return nil, err
+	}
+
+	ok, err := s.Store.UpdateRatePlan(ratePlan)
+	if err != nil {
+		log.Error().Msgf("Tried to update plan [Code: %v] of hotelId [%v], but got error = %s", ratePlan.Code, ratePlan.HotelId, err)
+		return nil, rpcerr.Mongo(err)
+	}
+	if !ok {
+		return nil, rpcerr.NotFound("rate plan", planName(ratePlan))
+	}
+
+	s.invalidateRates(ratePlan.HotelId)
+	return ratePlan, nil
+}
+
+// DeleteRatePlan removes a stored rate plan
+func (s *Server) DeleteRatePlan(ctx context.Context, req *pb.RatePlan) (*pb.RatePlan, error) {
+	if err := checkPlanIdentity(req); err != nil {
+		return nil, err
+	}
+	ratePlan := &pb.RatePlan{
+		HotelId:  req.HotelId,
+		Code:     req.Code,
+		InDate:   req.InDate,
+		RoomType: &pb.RoomType{Code: req.RoomType.Code},
+	}
+
+	ok, err := s.Store.DeleteRatePlan(ratePlan)
+	if err != nil {
+		log.Error().Msgf("Tried to delete plan [Code: %v] of hotelId [%v], but got error = %s", ratePlan.Code, ratePlan.HotelId, err)
+		return nil, rpcerr.Mongo(err)
+	}
+	if !ok {
+		return nil, rpcerr.NotFound("rate plan", planName(ratePlan))
+	}
+
+	s.invalidateRates(ratePlan.HotelId)
+	return ratePlan, nil
+}
+
+// invalidateRates invalidates the cached rates of a hotel whose plans have
+// been changed. The change is stored already, so it isn't failed if
+// memcached can't be reached; readers may see the old rates until their
+// nights expire after nightTTL.
+func (s *Server) invalidateRates(hotelId string) {
+	if err := s.invalidate(hotelId); err != nil {
+		log.Error().Msgf("Tried to invalidate rates of hotelId [%v], but got memmcached error = %s", hotelId, err)
+	}
+}
+
+// checkPlanIdentity validates the fields a rate plan is identified by
+func checkPlanIdentity(req *pb.RatePlan) error {
+	if req.HotelId == "" {
+		return rpcerr.InvalidArgument("hotelId", "no hotel given")
+	}
+	if req.Code == "" {
+		return rpcerr.InvalidArgument("code", "no rate plan code given")
+	}
+	if req.RoomType == nil || req.RoomType.Code == "" {
+		return rpcerr.InvalidArgument("roomType.code", "no room type given")
+	}
+	if _, err := time.Parse("2006-01-02", req.InDate); err != nil {
+		return rpcerr.InvalidArgument("inDate", "%s", err)
+	}
+	return nil
+}
+
+// checkRatePlan validates a rate plan to be stored and returns it without
+// the fields that aren't stored.
+func checkRatePlan(req *pb.RatePlan) (*pb.RatePlan, error) {
+	if err := checkPlanIdentity(req); err != nil {
+		return nil, err
+	}
+	if _, err := time.Parse("2006-01-02", req.OutDate); err != nil {
+		return nil, rpcerr.InvalidArgument("outDate", "%s", err)
+	}
+	if req.OutDate <= req.InDate {
+		return nil, rpcerr.InvalidArgument("outDate", "%s is not after inDate %s", req.OutDate, req.InDate)
+	}
+	rt := req.RoomType
+	if rt.BookableRate <= 0 {
+		return nil, rpcerr.InvalidArgument("roomType.bookableRate", "%v is not positive", rt.BookableRate)
+	}
+	if rt.TotalRate < rt.BookableRate {
+		return nil, rpcerr.InvalidArgument("roomType.totalRate", "%v is below bookableRate %v", rt.TotalRate, rt.BookableRate)
+	}
+	if rt.TotalRateInclusive < rt.TotalRate {
+		return nil, rpcerr.InvalidArgument("roomType.totalRateInclusive", "%v is below totalRate %v", rt.TotalRateInclusive, rt.TotalRate)
+	}
+	if len(rt.Currency) != 3 {
+		return nil, rpcerr.InvalidArgument("roomType.currency", "%q is not a currency code", rt.Currency)
+	}
+
+	return &pb.RatePlan{
+		HotelId: req.HotelId,
+		Code:    req.Code,
+		InDate:  req.InDate,
+		OutDate: req.OutDate,
+		RoomType: &pb.RoomType{
+			BookableRate:       rt.BookableRate,
+			TotalRate:          rt.TotalRate,
+			TotalRateInclusive: rt.TotalRateInclusive,
+			Code:               rt.Code,
+			Currency:           rt.Currency,
+			RoomDescription:    rt.RoomDescription,
+		},
+	}, nil
+}
+
+// planName names a rate plan in errors
+func planName(ratePlan *pb.RatePlan) string {
+	return ratePlan.HotelId + "/" + ratePlan.Code + "/" + ratePlan.RoomType.Code + "/" + ratePlan.InDate
+}
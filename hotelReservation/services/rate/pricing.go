@@ -0,0 +1,150 @@
+package rate
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/harlow/go-micro-services/data"
+	pb "github.com/harlow/go-micro-services/services/rate/proto"
+	reservation "github.com/harlow/go-micro-services/services/reservation/proto"
+	"github.com/rs/zerolog/log"
+	"golang.org/x/net/context"
+)
+
+// PricingRule adjusts the rates of a night whose occupancy is at least
+// MinOccupancy and, if MaxOccupancy is not 0, below MaxOccupancy.
+// Occupancy is the fraction of the rooms of a room type that are booked.
+type PricingRule struct {
+	Name         string  `json:"name"`
+	MinOccupancy float64 `json:"minOccupancy"`
+	MaxOccupancy float64 `json:"maxOccupancy"`
+	// Multiplier is what the stored rates of the night are multiplied by
+	Multiplier float64 `json:"multiplier"`
+}
+
+// PricingRules are tried in order, the first one that matches the
+// occupancy of a night adjusts its rates.
+type PricingRules []PricingRule
+
+// LoadPricingRules reads pricing rules from a JSON file, or the default
+// rules of data/pricing.json if path is empty.
+func LoadPricingRules(path string) (PricingRules, error) {
+	var (
+		content []byte
+		err     error
+	)
+	if path == "" {
+		content, err = data.Asset("data/pricing.json")
+	} else {
+		content, err = ioutil.ReadFile(path)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	rules := make(PricingRules, 0)
+	if err := json.Unmarshal(content, &rules); err != nil {
+		return nil, err
+	}
+	for _, rule := range rules {
+		if rule.MinOccupancy < 0 || rule.MinOccupancy > 1 {
+			return nil, fmt.Errorf("pricing rule %q: minOccupancy %v is not between 0 and 1", rule.Name, rule.MinOccupancy)
+		}
+		if rule.MaxOccupancy != 0 && rule.MaxOccupancy <= rule.MinOccupancy {
+			return nil, fmt.Errorf("pricing rule %q: maxOccupancy %v is not above minOccupancy", rule.Name, rule.MaxOccupancy)
+		}
+		if rule.Multiplier <= 0 {
+			return nil, fmt.Errorf("pricing rule %q: multiplier %v is not positive", rule.Name, rule.Multiplier)
+		}
+	}
+	return rules, nil
+}
+
+// match returns the rule for a night of occupancy, nil if none matches
+func (rules PricingRules) match(occupancy float64) *PricingRule {
+	for i, rule := range rules {
+		if occupancy >= rule.MinOccupancy && (rule.MaxOccupancy == 0 || occupancy < rule.MaxOccupancy) {
+			return &rules[i]
+		}
+	}
+	return nil
+}
+
+// priceDynamically adjusts the nightly rates of stay plans by the occupancy
+// of their room type, as reported by the reservation service. Nights whose
+// occupancy can't be had keep their stored rates.
+func (s *Server) priceDynamically(ctx context.Context, ratePlans RatePlans, inDate, outDate string) {
+	if len(ratePlans) == 0 {
+		return
+	}
+
+	hotelIds := make([]string, 0)
+	seen := make(map[string]bool)
+	for _, r := range ratePlans {
+		if !seen[r.HotelId] {
+			seen[r.HotelId] = true
+			hotelIds = append(hotelIds, r.HotelId)
+		}
+	}
+
+	calendar, err := s.reservationClient.GetAvailabilityCalendar(ctx, &reservation.CalendarRequest{
+		HotelId: hotelIds,
+		InDate:  inDate,
+		OutDate: outDate,
+	})
+	if err != nil {
+		log.Warn().Msgf("Tried to get occupancy of hotels %v, but got error = %s, keeping stored rates", hotelIds, err)
+		return
+	}
+
+	occupancy := make(map[string]float64)
+	for _, hotel := range calendar.Hotels {
+		for _, night := range hotel.Nights {
+			for _, rt := range night.RoomTypes {
+				if rt.Capacity > 0 {
+					occupancy[occupancyKey(hotel.HotelId, rt.RoomType, night.Date)] = float64(rt.Capacity-rt.RoomsLeft) / float64(rt.Capacity)
+				}
+			}
+		}
+	}
+
+	for _, r := range ratePlans {
+		for _, night := range r.NightlyRates {
+			occ, ok := occupancy[occupancyKey(r.HotelId, r.RoomType.Code, night.Date)]
+			if !ok {
+				continue
+			}
+			night.Occupancy = occ
+			night.Multiplier = 1
+			if rule := s.PricingRules.match(occ); rule != nil {
+				night.Rule = rule.Name
+				night.Multiplier = rule.Multiplier
+			}
+			night.BaseBookableRate = night.BookableRate
+			night.BaseTotalRate = night.TotalRate
+			night.BookableRate = roundCents(night.BookableRate * night.Multiplier)
+			night.TotalRate = roundCents(night.TotalRate * night.Multiplier)
+			night.TotalRateInclusive = roundCents(night.TotalRateInclusive * night.Multiplier)
+		}
+		sumNights(r)
+	}
+}
+
+func occupancyKey(hotelId, roomType, date string) string {
+	return hotelId + "_" + roomType + "_" + date
+}
+
+// sumNights sets the rates of the room type of a stay plan to the sum of
+// its nightly rates.
+func sumNights(r *pb.RatePlan) {
+	r.RoomType.BookableRate, r.RoomType.TotalRate, r.RoomType.TotalRateInclusive = 0, 0, 0
+	for _, night := range r.NightlyRates {
+		r.RoomType.BookableRate += night.BookableRate
+		r.RoomType.TotalRate += night.TotalRate
+		r.RoomType.TotalRateInclusive += night.TotalRateInclusive
+	}
+	r.RoomType.BookableRate = roundCents(r.RoomType.BookableRate)
+	r.RoomType.TotalRate = roundCents(r.RoomType.TotalRate)
+	r.RoomType.TotalRateInclusive = roundCents(r.RoomType.TotalRateInclusive)
+}
@@ -11,6 +11,7 @@ It has these top-level messages:
 	Request
 	Result
 	RatePlan
+	NightlyRate
 	RoomType
 */
 package rate
@@ -37,8 +38,17 @@ const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
 
 type Request struct {
 	HotelIds []string `protobuf:"bytes,1,rep,name=hotelIds" json:"hotelIds,omitempty"`
-	InDate   string   `protobuf:"bytes,2,opt,name=inDate" json:"inDate,omitempty"`
-	OutDate  string   `protobuf:"bytes,3,opt,name=outDate" json:"outDate,omitempty"`
+	// the stay, from the night of inDate up to the night before outDate
+	InDate  string `protobuf:"bytes,2,opt,name=inDate" json:"inDate,omitempty"`
+	OutDate string `protobuf:"bytes,3,opt,name=outDate" json:"outDate,omitempty"`
+	// only return plans with an average nightly bookable rate of at least
+	// minPrice and, if not 0, at most maxPrice
+	MinPrice float64 `protobuf:"fixed64,4,opt,name=minPrice" json:"minPrice,omitempty"`
+	MaxPrice float64 `protobuf:"fixed64,5,opt,name=maxPrice" json:"maxPrice,omitempty"`
+	// only return plans in this currency, if given
+	Currency string `protobuf:"bytes,6,opt,name=currency" json:"currency,omitempty"`
+	// only return plans of these room types, if given
+	RoomTypes []string `protobuf:"bytes,7,rep,name=roomTypes" json:"roomTypes,omitempty"`
 }
 
 func (m *Request) Reset()                    { *m = Request{} }
@@ -67,6 +77,34 @@ func (m *Request) GetOutDate() string {
 	return ""
 }
 
+func (m *Request) GetMinPrice() float64 {
+	if m != nil {
+		return m.MinPrice
+	}
+	return 0
+}
+
+func (m *Request) GetMaxPrice() float64 {
+	if m != nil {
+		return m.MaxPrice
+	}
+	return 0
+}
+
+func (m *Request) GetCurrency() string {
+	if m != nil {
+		return m.Currency
+	}
+	return ""
+}
+
+func (m *Request) GetRoomTypes() []string {
+	if m != nil {
+		return m.RoomTypes
+	}
+	return nil
+}
+
 type Result struct {
 	RatePlans []*RatePlan `protobuf:"bytes,1,rep,name=ratePlans" bson:"ratePlans,omitempty"`
 }
@@ -83,12 +121,18 @@ func (m *Result) GetRatePlans() []*RatePlan {
 	return nil
 }
 
+// A rate plan as stored covers the nights from inDate up to outDate, and
+// its room type has the rates of each of those nights. As returned by
+// GetRates it covers the requested stay, and its room type has the rates of
+// the whole stay.
 type RatePlan struct {
 	HotelId  string    `protobuf:"bytes,1,opt,name=hotelId" bson:"hotelId,omitempty"`
 	Code     string    `protobuf:"bytes,2,opt,name=code" bson:"code,omitempty"`
 	InDate   string    `protobuf:"bytes,3,opt,name=inDate" bson:"inDate,omitempty"`
 	OutDate  string    `protobuf:"bytes,4,opt,name=outDate" bson:"outDate,omitempty"`
 	RoomType *RoomType `protobuf:"bytes,5,opt,name=roomType" bson:"roomType,omitempty"`
+	// rates of each night of the stay, only set by GetRates
+	NightlyRates []*NightlyRate `protobuf:"bytes,6,rep,name=nightlyRates" bson:"nightlyRates,omitempty"`
 }
 
 func (m *RatePlan) Reset()                    { *m = RatePlan{} }
@@ -131,6 +175,97 @@ func (m *RatePlan) GetRoomType() *RoomType {
 	return nil
 }
 
+func (m *RatePlan) GetNightlyRates() []*NightlyRate {
+	if m != nil {
+		return m.NightlyRates
+	}
+	return nil
+}
+
+type NightlyRate struct {
+	Date               string  `protobuf:"bytes,1,opt,name=date" json:"date,omitempty"`
+	BookableRate       float64 `protobuf:"fixed64,2,opt,name=bookableRate" json:"bookableRate,omitempty"`
+	TotalRate          float64 `protobuf:"fixed64,3,opt,name=totalRate" json:"totalRate,omitempty"`
+	TotalRateInclusive float64 `protobuf:"fixed64,4,opt,name=totalRateInclusive" json:"totalRateInclusive,omitempty"`
+	// The below are only set if the rate service prices dynamically. The
+	// rates above are then the stored rates of the night times multiplier,
+	// the multiplier of the pricing rule that matched the occupancy of the
+	// room type that night, or 1 if none did.
+	Occupancy        float64 `protobuf:"fixed64,5,opt,name=occupancy" json:"occupancy,omitempty"`
+	Rule             string  `protobuf:"bytes,6,opt,name=rule" json:"rule,omitempty"`
+	Multiplier       float64 `protobuf:"fixed64,7,opt,name=multiplier" json:"multiplier,omitempty"`
+	BaseBookableRate float64 `protobuf:"fixed64,8,opt,name=baseBookableRate" json:"baseBookableRate,omitempty"`
+	BaseTotalRate    float64 `protobuf:"fixed64,9,opt,name=baseTotalRate" json:"baseTotalRate,omitempty"`
+}
+
+func (m *NightlyRate) Reset()                    { *m = NightlyRate{} }
+func (m *NightlyRate) String() string            { return proto.CompactTextString(m) }
+func (*NightlyRate) ProtoMessage()               {}
+func (*NightlyRate) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{3} }
+
+func (m *NightlyRate) GetDate() string {
+	if m != nil {
+		return m.Date
+	}
+	return ""
+}
+
+func (m *NightlyRate) GetBookableRate() float64 {
+	if m != nil {
+		return m.BookableRate
+	}
+	return 0
+}
+
+func (m *NightlyRate) GetTotalRate() float64 {
+	if m != nil {
+		return m.TotalRate
+	}
+	return 0
+}
+
+func (m *NightlyRate) GetTotalRateInclusive() float64 {
+	if m != nil {
+		return m.TotalRateInclusive
+	}
+	return 0
+}
+
+func (m *NightlyRate) GetOccupancy() float64 {
+	if m != nil {
+		return m.Occupancy
+	}
+	return 0
+}
+
+func (m *NightlyRate) GetRule() string {
+	if m != nil {
+		return m.Rule
+	}
+	return ""
+}
+
+func (m *NightlyRate) GetMultiplier() float64 {
+	if m != nil {
+		return m.Multiplier
+	}
+	return 0
+}
+
+func (m *NightlyRate) GetBaseBookableRate() float64 {
+	if m != nil {
+		return m.BaseBookableRate
+	}
+	return 0
+}
+
+func (m *NightlyRate) GetBaseTotalRate() float64 {
+	if m != nil {
+		return m.BaseTotalRate
+	}
+	return 0
+}
+
 type RoomType struct {
 	BookableRate       float64 `protobuf:"fixed64,1,opt,name=bookableRate" bson:"bookableRate,omitempty"`
 	TotalRate          float64 `protobuf:"fixed64,2,opt,name=totalRate" bson:"totalRate,omitempty"`
@@ -143,7 +278,7 @@ type RoomType struct {
 func (m *RoomType) Reset()                    { *m = RoomType{} }
 func (m *RoomType) String() string            { return proto.CompactTextString(m) }
 func (*RoomType) ProtoMessage()               {}
-func (*RoomType) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{3} }
+func (*RoomType) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{4} }
 
 func (m *RoomType) GetBookableRate() float64 {
 	if m != nil {
@@ -191,6 +326,7 @@ func init() {
 	proto.RegisterType((*Request)(nil), "rate.Request")
 	proto.RegisterType((*Result)(nil), "rate.Result")
 	proto.RegisterType((*RatePlan)(nil), "rate.RatePlan")
+	proto.RegisterType((*NightlyRate)(nil), "rate.NightlyRate")
 	proto.RegisterType((*RoomType)(nil), "rate.RoomType")
 }
 
@@ -205,8 +341,16 @@ const _ = grpc.SupportPackageIsVersion4
 // Client API for Rate service
 
 type RateClient interface {
-	// GetRates returns rate codes for hotels for a given date range
+	// GetRates returns the rate plans of hotels that cover every night of a
+	// stay, priced for the stay
 	GetRates(ctx context.Context, in *Request, opts ...grpc.CallOption) (*Result, error)
+	// CreateRatePlan stores a new rate plan. A plan is identified by its
+	// hotelId, code, roomType code and inDate.
+	CreateRatePlan(ctx context.Context, in *RatePlan, opts ...grpc.CallOption) (*RatePlan, error)
+	// UpdateRatePlan replaces the stored rate plan of the same identity.
+	UpdateRatePlan(ctx context.Context, in *RatePlan, opts ...grpc.CallOption) (*RatePlan, error)
+	// DeleteRatePlan removes a rate plan, only its identity is looked at.
+	DeleteRatePlan(ctx context.Context, in *RatePlan, opts ...grpc.CallOption) (*RatePlan, error)
 }
 
 type rateClient struct {
@@ -226,11 +370,46 @@ func (c *rateClient) GetRates(ctx context.Context, in *Request, opts ...grpc.Cal
 	return out, nil
 }
 
+func (c *rateClient) CreateRatePlan(ctx context.Context, in *RatePlan, opts ...grpc.CallOption) (*RatePlan, error) {
+	out := new(RatePlan)
+	err := grpc.Invoke(ctx, "/rate.Rate/CreateRatePlan", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rateClient) UpdateRatePlan(ctx context.Context, in *RatePlan, opts ...grpc.CallOption) (*RatePlan, error) {
+	out := new(RatePlan)
+	err := grpc.Invoke(ctx, "/rate.Rate/UpdateRatePlan", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rateClient) DeleteRatePlan(ctx context.Context, in *RatePlan, opts ...grpc.CallOption) (*RatePlan, error) {
+	out := new(RatePlan)
+	err := grpc.Invoke(ctx, "/rate.Rate/DeleteRatePlan", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for Rate service
 
 type RateServer interface {
-	// GetRates returns rate codes for hotels for a given date range
+	// GetRates returns the rate plans of hotels that cover every night of a
+	// stay, priced for the stay
 	GetRates(context.Context, *Request) (*Result, error)
+	// CreateRatePlan stores a new rate plan. A plan is identified by its
+	// hotelId, code, roomType code and inDate.
+	CreateRatePlan(context.Context, *RatePlan) (*RatePlan, error)
+	// UpdateRatePlan replaces the stored rate plan of the same identity.
+	UpdateRatePlan(context.Context, *RatePlan) (*RatePlan, error)
+	// DeleteRatePlan removes a rate plan, only its identity is looked at.
+	DeleteRatePlan(context.Context, *RatePlan) (*RatePlan, error)
 }
 
 func RegisterRateServer(s *grpc.Server, srv RateServer) {
@@ -255,6 +434,60 @@ func _Rate_GetRates_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Rate_CreateRatePlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RatePlan)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RateServer).CreateRatePlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rate.Rate/CreateRatePlan",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RateServer).CreateRatePlan(ctx, req.(*RatePlan))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Rate_UpdateRatePlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RatePlan)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RateServer).UpdateRatePlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rate.Rate/UpdateRatePlan",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RateServer).UpdateRatePlan(ctx, req.(*RatePlan))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Rate_DeleteRatePlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RatePlan)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RateServer).DeleteRatePlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rate.Rate/DeleteRatePlan",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RateServer).DeleteRatePlan(ctx, req.(*RatePlan))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Rate_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "rate.Rate",
 	HandlerType: (*RateServer)(nil),
@@ -263,6 +496,18 @@ var _Rate_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetRates",
 			Handler:    _Rate_GetRates_Handler,
 		},
+		{
+			MethodName: "CreateRatePlan",
+			Handler:    _Rate_CreateRatePlan_Handler,
+		},
+		{
+			MethodName: "UpdateRatePlan",
+			Handler:    _Rate_UpdateRatePlan_Handler,
+		},
+		{
+			MethodName: "DeleteRatePlan",
+			Handler:    _Rate_DeleteRatePlan_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "services/rate/proto/rate.proto",
@@ -271,26 +516,38 @@ var _Rate_serviceDesc = grpc.ServiceDesc{
 func init() { proto.RegisterFile("services/rate/proto/rate.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 327 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0xdd, 0x4a, 0xc3, 0x30,
-	0x14, 0xc7, 0x89, 0xab, 0x5d, 0x7b, 0x9c, 0x0a, 0xe7, 0x42, 0xca, 0x10, 0x19, 0xbd, 0x71, 0x88,
-	0x6c, 0x30, 0xc1, 0x27, 0x18, 0xc8, 0xee, 0xe4, 0x20, 0x78, 0xdd, 0x75, 0x07, 0x2c, 0xd6, 0x66,
-	0x26, 0xe9, 0x60, 0x2f, 0xe2, 0xa3, 0xf9, 0x3c, 0x92, 0x34, 0xed, 0x3a, 0xd1, 0xbb, 0xff, 0x47,
-	0x38, 0xfd, 0x25, 0x3d, 0x70, 0xa3, 0x59, 0xed, 0x8a, 0x9c, 0xf5, 0x5c, 0x65, 0x86, 0xe7, 0x5b,
-	0x25, 0x8d, 0x74, 0x72, 0xe6, 0x24, 0x06, 0x56, 0xa7, 0xaf, 0x30, 0x24, 0xfe, 0xac, 0x59, 0x1b,
-	0x1c, 0x43, 0xf4, 0x26, 0x0d, 0x97, 0xab, 0x8d, 0x4e, 0xc4, 0x64, 0x30, 0x8d, 0xa9, 0xf3, 0x78,
-	0x05, 0x61, 0x51, 0x2d, 0x33, 0xc3, 0xc9, 0xc9, 0x44, 0x4c, 0x63, 0xf2, 0x0e, 0x13, 0x18, 0xca,
-	0xda, 0xb8, 0x62, 0xe0, 0x8a, 0xd6, 0xa6, 0x8f, 0x10, 0x12, 0xeb, 0xba, 0x34, 0x78, 0x0f, 0xb1,
-	0xfd, 0xd4, 0x73, 0x99, 0x55, 0xcd, 0xe0, 0xb3, 0xc5, 0xc5, 0xcc, 0x81, 0x90, 0x8f, 0xe9, 0x70,
-	0x20, 0xfd, 0x12, 0x10, 0xb5, 0xb9, 0x1d, 0xef, 0x11, 0x12, 0xd1, 0x8c, 0xf7, 0x16, 0x11, 0x82,
-	0x5c, 0x6e, 0x5a, 0x1c, 0xa7, 0x7b, 0x90, 0x83, 0xff, 0x20, 0x83, 0x23, 0x48, 0xbc, 0x83, 0x48,
-	0x49, 0xf9, 0xf1, 0xb2, 0xdf, 0x72, 0x72, 0x3a, 0x11, 0x3d, 0x32, 0x9f, 0x52, 0xd7, 0xa7, 0xdf,
-	0x16, 0xcc, 0x1b, 0x4c, 0x61, 0xb4, 0x96, 0xf2, 0x3d, 0x5b, 0x97, 0x6c, 0x61, 0x1d, 0x9d, 0xa0,
-	0xa3, 0x0c, 0xaf, 0x21, 0x36, 0xd2, 0x64, 0x25, 0xb5, 0xcf, 0x26, 0xe8, 0x10, 0xe0, 0x0c, 0xb0,
-	0x33, 0xab, 0x2a, 0x2f, 0x6b, 0x5d, 0xec, 0x1a, 0x70, 0x41, 0x7f, 0x34, 0xdd, 0x85, 0x83, 0xde,
-	0x85, 0xc7, 0x10, 0xe5, 0xb5, 0x52, 0x5c, 0xe5, 0x7b, 0x87, 0x1f, 0x53, 0xe7, 0x71, 0x0a, 0x97,
-	0x16, 0x7d, 0xc9, 0x3a, 0x57, 0xc5, 0xd6, 0x14, 0xb2, 0x4a, 0x42, 0x77, 0xe4, 0x77, 0xbc, 0x98,
-	0x43, 0xe0, 0x88, 0x6e, 0x21, 0x7a, 0x62, 0x63, 0xa5, 0xc6, 0x73, 0xff, 0x0c, 0xcd, 0x6a, 0x8c,
-	0x47, 0xad, 0xb5, 0x3f, 0x74, 0x1d, 0xba, 0x05, 0x7a, 0xf8, 0x09, 0x00, 0x00, 0xff, 0xff, 0x71,
-	0x7f, 0xc7, 0x74, 0x62, 0x02, 0x00, 0x00,
+	// 517 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xc1, 0x6e, 0xd3, 0x40,
+	0x10, 0xd5, 0x36, 0x6e, 0x62, 0x4f, 0xd3, 0x02, 0x7b, 0x40, 0x56, 0x84, 0xaa, 0xc8, 0x42, 0x22,
+	0xaa, 0x50, 0x8a, 0x8a, 0xe0, 0x03, 0x20, 0x12, 0xea, 0x05, 0x55, 0xab, 0xf2, 0x01, 0x1b, 0x67,
+	0x44, 0x57, 0x6c, 0xbc, 0x66, 0x77, 0x5d, 0x35, 0x5f, 0xc6, 0x17, 0x70, 0xe0, 0xc0, 0x95, 0xef,
+	0x41, 0xbb, 0xf6, 0xda, 0x8e, 0xa1, 0xa2, 0xdc, 0x66, 0xde, 0x9b, 0xb5, 0xe6, 0xbd, 0x99, 0x31,
+	0x9c, 0x1a, 0xd4, 0xb7, 0x22, 0x47, 0x73, 0xae, 0xb9, 0xc5, 0xf3, 0x52, 0x2b, 0xab, 0x7c, 0xb8,
+	0xf4, 0x21, 0x8d, 0x5c, 0x9c, 0xfd, 0x20, 0x30, 0x61, 0xf8, 0xb5, 0x42, 0x63, 0xe9, 0x0c, 0xe2,
+	0x1b, 0x65, 0x51, 0x5e, 0x6e, 0x4c, 0x4a, 0xe6, 0xa3, 0x45, 0xc2, 0xda, 0x9c, 0x3e, 0x85, 0xb1,
+	0x28, 0x56, 0xdc, 0x62, 0x7a, 0x30, 0x27, 0x8b, 0x84, 0x35, 0x19, 0x4d, 0x61, 0xa2, 0x2a, 0xeb,
+	0x89, 0x91, 0x27, 0x42, 0xea, 0xbe, 0xb6, 0x15, 0xc5, 0x95, 0x16, 0x39, 0xa6, 0xd1, 0x9c, 0x2c,
+	0x08, 0x6b, 0x73, 0xcf, 0xf1, 0xbb, 0x9a, 0x3b, 0x6c, 0x38, 0x7e, 0xd7, 0x72, 0x79, 0xa5, 0x35,
+	0x16, 0xf9, 0x2e, 0x1d, 0xfb, 0x4f, 0xb6, 0x39, 0x7d, 0x06, 0x89, 0x56, 0x6a, 0x7b, 0xbd, 0x2b,
+	0xd1, 0xa4, 0x13, 0xdf, 0x62, 0x07, 0x64, 0x6f, 0x61, 0xcc, 0xd0, 0x54, 0xd2, 0xd2, 0x97, 0x90,
+	0x38, 0x75, 0x57, 0x92, 0x17, 0xb5, 0x94, 0xa3, 0x8b, 0x93, 0xa5, 0xd7, 0xce, 0x1a, 0x98, 0x75,
+	0x05, 0xd9, 0x4f, 0x02, 0x71, 0xc0, 0x9d, 0xa0, 0x46, 0x74, 0x4a, 0x6a, 0x41, 0x4d, 0x4a, 0x29,
+	0x44, 0xb9, 0xda, 0x04, 0x03, 0x7c, 0xdc, 0xb3, 0x65, 0x74, 0x9f, 0x2d, 0xd1, 0xbe, 0x2d, 0x67,
+	0x10, 0x87, 0x8e, 0xbd, 0xf4, 0xae, 0xb3, 0x06, 0x65, 0x2d, 0x4f, 0xdf, 0xc0, 0xb4, 0x10, 0x9f,
+	0x6f, 0xac, 0xdc, 0xb9, 0xf6, 0x4c, 0x3a, 0xf6, 0x4a, 0x9e, 0xd4, 0xf5, 0x1f, 0x3b, 0x86, 0xed,
+	0x95, 0x65, 0xdf, 0x0e, 0xe0, 0xa8, 0xc7, 0xba, 0xc6, 0x37, 0xae, 0x93, 0x5a, 0x8f, 0x8f, 0x69,
+	0x06, 0xd3, 0xb5, 0x52, 0x5f, 0xf8, 0x5a, 0x22, 0x0b, 0x53, 0x25, 0x6c, 0x0f, 0x73, 0x6e, 0x5b,
+	0x65, 0xb9, 0x64, 0x41, 0x1f, 0x61, 0x1d, 0x40, 0x97, 0x40, 0xdb, 0xe4, 0xb2, 0xc8, 0x65, 0x65,
+	0xc4, 0x6d, 0x98, 0xf4, 0x5f, 0x18, 0xf7, 0x35, 0x95, 0xe7, 0x55, 0xc9, 0xdd, 0x60, 0xeb, 0xa1,
+	0x77, 0x80, 0xeb, 0x51, 0x57, 0x12, 0x9b, 0x89, 0xfb, 0x98, 0x9e, 0x02, 0x6c, 0x2b, 0x69, 0x45,
+	0x29, 0x05, 0xea, 0x74, 0xe2, 0x9f, 0xf4, 0x10, 0x7a, 0x06, 0x8f, 0xd7, 0xdc, 0xe0, 0xbb, 0xbe,
+	0x8e, 0xd8, 0x57, 0xfd, 0x81, 0xd3, 0xe7, 0x70, 0xec, 0xb0, 0xeb, 0x56, 0x4f, 0xe2, 0x0b, 0xf7,
+	0xc1, 0xec, 0x97, 0xdb, 0x84, 0xe0, 0xfe, 0xd0, 0x22, 0xf2, 0x2f, 0x8b, 0x0e, 0x1e, 0x66, 0xd1,
+	0xe8, 0x5e, 0x8b, 0xc2, 0x86, 0x45, 0xbd, 0x0d, 0xeb, 0x9f, 0xc3, 0xe1, 0xe0, 0x1c, 0x16, 0xf0,
+	0xc8, 0xed, 0xca, 0x0a, 0x4d, 0xae, 0x45, 0x69, 0x85, 0x2a, 0x1a, 0xff, 0x86, 0xf0, 0xc5, 0x77,
+	0x02, 0x91, 0x6f, 0xe9, 0x05, 0xc4, 0x1f, 0xd0, 0xba, 0xd0, 0xd0, 0xe3, 0x66, 0xf1, 0xea, 0xf3,
+	0x9f, 0x4d, 0x43, 0xea, 0x4f, 0xe8, 0x15, 0x9c, 0xbc, 0xd7, 0xc8, 0x2d, 0xb6, 0x97, 0x31, 0xb8,
+	0xa0, 0xd9, 0x20, 0x77, 0x2f, 0x3e, 0x95, 0x9b, 0xff, 0x7c, 0xb1, 0x42, 0x89, 0x0f, 0x7f, 0xb1,
+	0x1e, 0xfb, 0x7f, 0xd7, 0xeb, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x03, 0x00, 0x6f, 0x9e, 0xd5,
+	0xa6, 0xdd, 0x04, 0x00, 0x00,
 }
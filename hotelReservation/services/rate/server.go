@@ -1,12 +1,8 @@
 package rate
 
 import (
-	"encoding/json"
 	"fmt"
 
-	"gopkg.in/mgo.v2"
-	"gopkg.in/mgo.v2/bson"
-
 	// "io/ioutil"
 	"net"
 	// "os"
@@ -17,30 +13,37 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/grpc-ecosystem/grpc-opentracing/go/otgrpc"
+	"github.com/harlow/go-micro-services/dialer"
 	"github.com/harlow/go-micro-services/registry"
+	"github.com/harlow/go-micro-services/rpcerr"
 	pb "github.com/harlow/go-micro-services/services/rate/proto"
+	reservation "github.com/harlow/go-micro-services/services/reservation/proto"
 	"github.com/harlow/go-micro-services/tls"
+	"github.com/harlow/go-micro-services/tune"
 	"github.com/opentracing/opentracing-go"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/keepalive"
 
 	"strings"
-
-	"github.com/bradfitz/gomemcache/memcache"
 )
 
 const name = "srv-rate"
 
 // Server implements the rate service
 type Server struct {
-	Tracer       opentracing.Tracer
-	Port         int
-	IpAddr       string
-	MongoSession *mgo.Session
-	Registry     *registry.Client
-	MemcClient   *memcache.Client
+	Tracer     opentracing.Tracer
+	Port       int
+	IpAddr     string
+	Store      RatePlanStore
+	Registry   *registry.Client
+	MemcClient *tune.MemCClient
+	// PricingRules turns on dynamic pricing if set: the stored rates of a
+	// night are adjusted by the rule matching its occupancy.
+	PricingRules PricingRules
 	uuid         string
+
+	reservationClient reservation.ReservationClient
 }
 
 // Run starts the server
@@ -51,6 +54,13 @@ func (s *Server) Run() error {
 
 	s.uuid = uuid.New().String()
 
+	if len(s.PricingRules) > 0 {
+		log.Info().Msg("Pricing rates dynamically by occupancy")
+		if err := s.initReservationClient("srv-reservation"); err != nil {
+			return err
+		}
+	}
+
 	opts := []grpc.ServerOption{
 		grpc.KeepaliveParams(keepalive.ServerParameters{
 			Timeout: 120 * time.Second,
@@ -103,6 +113,19 @@ func (s *Server) Shutdown() {
 	s.Registry.Deregister(s.uuid)
 }
 
+func (s *Server) initReservationClient(name string) error {
+	conn, err := dialer.Dial(
+		name,
+		dialer.WithTracer(s.Tracer),
+		dialer.WithBalancer(s.Registry.Client),
+	)
+	if err != nil {
+		return fmt.Errorf("dialer error: %v", err)
+	}
+	s.reservationClient = reservation.NewReservationClient(conn)
+	return nil
+}
+
 // GetRates gets rates for hotels for specific date range.
 func (s *Server) GetRates(ctx context.Context, req *pb.Request) (*pb.Result, error) {
 	res := new(pb.Result)
@@ -112,65 +135,70 @@ func (s *Server) GetRates(ctx context.Context, req *pb.Request) (*pb.Result, err
 	// }
 	// defer session.Close()
 
+	if req.MinPrice < 0 {
+		return nil, rpcerr.InvalidArgument("minPrice", "%v is negative", req.MinPrice)
+	}
+	if req.MaxPrice < 0 || (req.MaxPrice > 0 && req.MaxPrice < req.MinPrice) {
+		return nil, rpcerr.InvalidArgument("maxPrice", "%v is negative or below minPrice", req.MaxPrice)
+	}
+
+	nights, err := checkStay(req.InDate, req.OutDate)
+	if err != nil {
+		return nil, err
+	}
+
 	ratePlans := make(RatePlans, 0)
 
+	nightly, err := s.getNightlyPlans(req.HotelIds, nights)
+	if err != nil {
+		return nil, err
+	}
 	for _, hotelID := range req.HotelIds {
-		// first check memcached
-		item, err := s.MemcClient.Get(hotelID)
-		if err == nil {
-			// memcached hit
-			rate_strs := strings.Split(string(item.Value), "\n")
-
-			log.Trace().Msgf("memc hit, hotelId = %s,rate strings: %v", hotelID, rate_strs)
-
-			for _, rate_str := range rate_strs {
-				if len(rate_str) != 0 {
-					rate_p := new(pb.RatePlan)
-					json.Unmarshal([]byte(rate_str), rate_p)
-					ratePlans = append(ratePlans, rate_p)
-				}
-			}
-		} else if err == memcache.ErrCacheMiss {
-
-			log.Trace().Msgf("memc miss, hotelId = %s", hotelID)
-
-			log.Trace().Msg("memcached miss, set up mongo connection")
-			// memcached miss, set up mongo connection
-			session := s.MongoSession.Copy()
-			defer session.Close()
-			c := session.DB("rate-db").C("inventory")
-
-			memc_str := ""
-
-			tmpRatePlans := make(RatePlans, 0)
-			err := c.Find(&bson.M{"hotelId": hotelID}).All(&tmpRatePlans)
-			if err != nil {
-				log.Panic().Msgf("Tried to find hotelId [%v], but got error", hotelID, err.Error())
-			} else {
-				for _, r := range tmpRatePlans {
-					ratePlans = append(ratePlans, r)
-					rate_json, err := json.Marshal(r)
-					if err != nil {
-						log.Error().Msgf("Failed to marshal plan [Code: %v] with error: %s", r.Code, err)
-					}
-					memc_str = memc_str + string(rate_json) + "\n"
-				}
-			}
-
-			// write to memcached
-			s.MemcClient.Set(&memcache.Item{Key: hotelID, Value: []byte(memc_str)})
-
-		} else {
-			log.Panic().Msgf("Memmcached error while trying to get hotel [id: %v]= %s", hotelID, err)
-		}
+		ratePlans = append(ratePlans, stayPlans(hotelID, req.InDate, req.OutDate, nights, nightly[hotelID])...)
+	}
+
+	if len(s.PricingRules) > 0 {
+		s.priceDynamically(ctx, ratePlans, req.InDate, req.OutDate)
 	}
 
 	sort.Sort(ratePlans)
-	res.RatePlans = ratePlans
+	res.RatePlans = filterRatePlans(ratePlans, req, len(nights))
 
 	return res, nil
 }
 
+// filterRatePlans returns the plans of a stay of nights that match the
+// price, currency and room type filters of req.
+func filterRatePlans(ratePlans RatePlans, req *pb.Request, nights int) RatePlans {
+	filtered := make(RatePlans, 0, len(ratePlans))
+	for _, r := range ratePlans {
+		if r.RoomType == nil {
+			continue
+		}
+		nightly := r.RoomType.BookableRate / float64(nights)
+		if nightly < req.MinPrice || (req.MaxPrice > 0 && nightly > req.MaxPrice) {
+			continue
+		}
+		if req.Currency != "" && !strings.EqualFold(r.RoomType.Currency, req.Currency) {
+			continue
+		}
+		if len(req.RoomTypes) > 0 && !containsString(req.RoomTypes, r.RoomType.Code) {
+			continue
+		}
+		filtered = append(filtered, r)
+	}
+	return filtered
+}
+
+func containsString(list []string, s string) bool {
+	for _, l := range list {
+		if l == s {
+			return true
+		}
+	}
+	return false
+}
+
 type RatePlans []*pb.RatePlan
 
 func (r RatePlans) Len() int {
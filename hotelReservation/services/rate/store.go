@@ -0,0 +1,179 @@
+package rate
+
+import (
+	"encoding/json"
+	"sync"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/harlow/go-micro-services/data"
+	pb "github.com/harlow/go-micro-services/services/rate/proto"
+	"gopkg.in/mgo.v2"
+	"gopkg.in/mgo.v2/bson"
+)
+
+// RatePlanStore stores the rate plans of hotels
+type RatePlanStore interface {
+	// GetRatePlans returns the rate plans of several hotels that cover any
+	// of the nights from inDate up to outDate, in a single query.
+	GetRatePlans(hotelIds []string, inDate, outDate string) ([]*pb.RatePlan, error)
+	// CreateRatePlan stores a new rate plan, it returns false if a plan of
+	// the same identity exists.
+	CreateRatePlan(ratePlan *pb.RatePlan) (bool, error)
+	// UpdateRatePlan replaces the plan of the same identity, it returns
+	// false if there is none.
+	UpdateRatePlan(ratePlan *pb.RatePlan) (bool, error)
+	// DeleteRatePlan removes the plan of the same identity, it returns
+	// false if there is none.
+	DeleteRatePlan(ratePlan *pb.RatePlan) (bool, error)
+}
+
+// planSelector selects the stored plan of the identity of ratePlan
+func planSelector(ratePlan *pb.RatePlan) bson.M {
+	return bson.M{
+		"hotelId":       ratePlan.HotelId,
+		"code":          ratePlan.Code,
+		"roomType.code": ratePlan.RoomType.Code,
+		"inDate":        ratePlan.InDate,
+	}
+}
+
+// samePlan tells whether two plans have the same identity
+func samePlan(a, b *pb.RatePlan) bool {
+	return a.HotelId == b.HotelId && a.Code == b.Code && a.RoomType.Code == b.RoomType.Code && a.InDate == b.InDate
+}
+
+type mongoStore struct {
+	session *mgo.Session
+}
+
+// NewMongoStore returns a RatePlanStore backed by the rate-db database
+func NewMongoStore(session *mgo.Session) RatePlanStore {
+	return &mongoStore{session: session}
+}
+
+func (m *mongoStore) GetRatePlans(hotelIds []string, inDate, outDate string) ([]*pb.RatePlan, error) {
+	s := m.session.Copy()
+	defer s.Close()
+
+	ratePlans := make([]*pb.RatePlan, 0)
+	err := s.DB("rate-db").C("inventory").Find(&bson.M{
+		"hotelId": bson.M{"$in": hotelIds},
+		"inDate":  bson.M{"$lt": outDate},
+		"outDate": bson.M{"$gt": inDate},
+	}).All(&ratePlans)
+	return ratePlans, err
+}
+
+func (m *mongoStore) CreateRatePlan(ratePlan *pb.RatePlan) (bool, error) {
+	s := m.session.Copy()
+	defer s.Close()
+
+	err := s.DB("rate-db").C("inventory").Insert(ratePlan)
+	if mgo.IsDup(err) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+func (m *mongoStore) UpdateRatePlan(ratePlan *pb.RatePlan) (bool, error) {
+	s := m.session.Copy()
+	defer s.Close()
+
+	err := s.DB("rate-db").C("inventory").Update(planSelector(ratePlan), ratePlan)
+	if err == mgo.ErrNotFound {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+func (m *mongoStore) DeleteRatePlan(ratePlan *pb.RatePlan) (bool, error) {
+	s := m.session.Copy()
+	defer s.Close()
+
+	err := s.DB("rate-db").C("inventory").Remove(planSelector(ratePlan))
+	if err == mgo.ErrNotFound {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+type memoryStore struct {
+	mu        sync.RWMutex
+	ratePlans map[string][]*pb.RatePlan
+}
+
+// NewMemoryStore returns a RatePlanStore kept in memory and seeded from
+// data/rates.json
+func NewMemoryStore() RatePlanStore {
+	ratePlans := make([]*pb.RatePlan, 0)
+	if err := json.Unmarshal(data.MustAsset("data/rates.json"), &ratePlans); err != nil {
+		panic(err)
+	}
+
+	m := &memoryStore{ratePlans: make(map[string][]*pb.RatePlan)}
+	for _, r := range ratePlans {
+		m.ratePlans[r.HotelId] = append(m.ratePlans[r.HotelId], r)
+	}
+	return m
+}
+
+func (m *memoryStore) GetRatePlans(hotelIds []string, inDate, outDate string) ([]*pb.RatePlan, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	ratePlans := make([]*pb.RatePlan, 0)
+	seen := make(map[string]bool)
+	for _, hotelId := range hotelIds {
+		if seen[hotelId] {
+			continue
+		}
+		seen[hotelId] = true
+		for _, r := range m.ratePlans[hotelId] {
+			if r.InDate >= outDate || r.OutDate <= inDate {
+				continue
+			}
+			ratePlans = append(ratePlans, proto.Clone(r).(*pb.RatePlan))
+		}
+	}
+	return ratePlans, nil
+}
+
+func (m *memoryStore) CreateRatePlan(ratePlan *pb.RatePlan) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, r := range m.ratePlans[ratePlan.HotelId] {
+		if samePlan(r, ratePlan) {
+			return false, nil
+		}
+	}
+	m.ratePlans[ratePlan.HotelId] = append(m.ratePlans[ratePlan.HotelId], proto.Clone(ratePlan).(*pb.RatePlan))
+	return true, nil
+}
+
+func (m *memoryStore) UpdateRatePlan(ratePlan *pb.RatePlan) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for i, r := range m.ratePlans[ratePlan.HotelId] {
+		if samePlan(r, ratePlan) {
+			m.ratePlans[ratePlan.HotelId][i] = proto.Clone(ratePlan).(*pb.RatePlan)
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (m *memoryStore) DeleteRatePlan(ratePlan *pb.RatePlan) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	ratePlans := m.ratePlans[ratePlan.HotelId]
+	for i, r := range ratePlans {
+		if samePlan(r, ratePlan) {
+			m.ratePlans[ratePlan.HotelId] = append(ratePlans[:i:i], ratePlans[i+1:]...)
+			return true, nil
+		}
+	}
+	return false, nil
+}
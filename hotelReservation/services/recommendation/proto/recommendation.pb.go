@@ -24,9 +24,9 @@ const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
 
 // The requirement of the recommendation.
 type Request struct {
-	Require string   `protobuf:"bytes,1,opt,name=require" json:"require,omitempty"`
-	Lat     float64  `protobuf:"fixed64,2,opt,name=lat" json:"lat,omitempty"`
-	Lon     float64  `protobuf:"fixed64,3,opt,name=lon" json:"lon,omitempty"`
+	Require string  `protobuf:"bytes,1,opt,name=require" json:"require,omitempty"`
+	Lat     float64 `protobuf:"fixed64,2,opt,name=lat" json:"lat,omitempty"`
+	Lon     float64 `protobuf:"fixed64,3,opt,name=lon" json:"lon,omitempty"`
 }
 
 func (m *Request) Reset()                    { *m = Request{} }
@@ -71,9 +71,68 @@ func (m *Result) GetHotelIds() []string {
 	return nil
 }
 
+type RatingRequest struct {
+	HotelIds []string `protobuf:"bytes,1,rep,name=hotelIds" json:"hotelIds,omitempty"`
+}
+
+func (m *RatingRequest) Reset()                    { *m = RatingRequest{} }
+func (m *RatingRequest) String() string            { return proto.CompactTextString(m) }
+func (*RatingRequest) ProtoMessage()               {}
+func (*RatingRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{2} }
+
+func (m *RatingRequest) GetHotelIds() []string {
+	if m != nil {
+		return m.HotelIds
+	}
+	return nil
+}
+
+type RatingResult struct {
+	Ratings []*Rating `protobuf:"bytes,1,rep,name=ratings" json:"ratings,omitempty"`
+}
+
+func (m *RatingResult) Reset()                    { *m = RatingResult{} }
+func (m *RatingResult) String() string            { return proto.CompactTextString(m) }
+func (*RatingResult) ProtoMessage()               {}
+func (*RatingResult) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{3} }
+
+func (m *RatingResult) GetRatings() []*Rating {
+	if m != nil {
+		return m.Ratings
+	}
+	return nil
+}
+
+type Rating struct {
+	HotelId string  `protobuf:"bytes,1,opt,name=hotelId" json:"hotelId,omitempty"`
+	Rate    float64 `protobuf:"fixed64,2,opt,name=rate" json:"rate,omitempty"`
+}
+
+func (m *Rating) Reset()                    { *m = Rating{} }
+func (m *Rating) String() string            { return proto.CompactTextString(m) }
+func (*Rating) ProtoMessage()               {}
+func (*Rating) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{4} }
+
+func (m *Rating) GetHotelId() string {
+	if m != nil {
+		return m.HotelId
+	}
+	return ""
+}
+
+func (m *Rating) GetRate() float64 {
+	if m != nil {
+		return m.Rate
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Request)(nil), "recommendation.Request")
 	proto.RegisterType((*Result)(nil), "recommendation.Result")
+	proto.RegisterType((*RatingRequest)(nil), "recommendation.RatingRequest")
+	proto.RegisterType((*RatingResult)(nil), "recommendation.RatingResult")
+	proto.RegisterType((*Rating)(nil), "recommendation.Rating")
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -90,6 +149,8 @@ const _ = grpc.SupportPackageIsVersion4
 type RecommendationClient interface {
 	// GetRecommendations returns recommended hotels for a given requirement
 	GetRecommendations(ctx context.Context, in *Request, opts ...grpc.CallOption) (*Result, error)
+	// GetRatings returns the review rating of the given hotels
+	GetRatings(ctx context.Context, in *RatingRequest, opts ...grpc.CallOption) (*RatingResult, error)
 }
 
 type recommendationClient struct {
@@ -109,10 +170,21 @@ func (c *recommendationClient) GetRecommendations(ctx context.Context, in *Reque
 	return out, nil
 }
 
+func (c *recommendationClient) GetRatings(ctx context.Context, in *RatingRequest, opts ...grpc.CallOption) (*RatingResult, error) {
+	out := new(RatingResult)
+	err := c.cc.Invoke(ctx, "/recommendation.Recommendation/GetRatings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // RecommendationServer is the server API for Recommendation service.
 type RecommendationServer interface {
 	// GetRecommendations returns recommended hotels for a given requirement
 	GetRecommendations(context.Context, *Request) (*Result, error)
+	// GetRatings returns the review rating of the given hotels
+	GetRatings(context.Context, *RatingRequest) (*RatingResult, error)
 }
 
 func RegisterRecommendationServer(s *grpc.Server, srv RecommendationServer) {
@@ -137,6 +209,24 @@ func _Recommendation_GetRecommendations_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Recommendation_GetRatings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RatingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RecommendationServer).GetRatings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/recommendation.Recommendation/GetRatings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RecommendationServer).GetRatings(ctx, req.(*RatingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Recommendation_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "recommendation.Recommendation",
 	HandlerType: (*RecommendationServer)(nil),
@@ -145,6 +235,10 @@ var _Recommendation_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetRecommendations",
 			Handler:    _Recommendation_GetRecommendations_Handler,
 		},
+		{
+			MethodName: "GetRatings",
+			Handler:    _Recommendation_GetRatings_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "recommendation.proto",
@@ -153,16 +247,22 @@ var _Recommendation_serviceDesc = grpc.ServiceDesc{
 func init() { proto.RegisterFile("recommendation.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 173 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x8f, 0x31, 0xcf, 0x82, 0x40,
-	0x0c, 0x40, 0x73, 0x1f, 0x09, 0x7c, 0x74, 0x20, 0xa6, 0x31, 0x7a, 0x61, 0x22, 0xc4, 0x81, 0x89,
-	0x41, 0x7f, 0x83, 0x41, 0xd7, 0x5b, 0x9c, 0x51, 0x3a, 0x90, 0xc0, 0x55, 0xee, 0xca, 0xff, 0x37,
-	0x10, 0x34, 0x41, 0xb7, 0xbe, 0xd7, 0xa1, 0xaf, 0xb0, 0x75, 0xf4, 0xe0, 0xbe, 0x27, 0xdb, 0xd4,
-	0xd2, 0xb2, 0x2d, 0x9f, 0x8e, 0x85, 0x31, 0x59, 0xdb, 0xbc, 0x82, 0xc8, 0xd0, 0x30, 0x92, 0x17,
-	0xd4, 0x10, 0x39, 0x1a, 0xc6, 0xd6, 0x91, 0x56, 0x99, 0x2a, 0x62, 0xf3, 0x46, 0xdc, 0x40, 0xd0,
-	0xd5, 0xa2, 0xff, 0x32, 0x55, 0x28, 0x33, 0x8d, 0xb3, 0x61, 0xab, 0x83, 0xc5, 0xb0, 0xcd, 0x0f,
-	0x10, 0x1a, 0xf2, 0x63, 0x27, 0x98, 0xc2, 0xff, 0x85, 0x85, 0xba, 0x6b, 0xe3, 0xb5, 0xca, 0x82,
-	0x22, 0x36, 0x1f, 0x3e, 0xde, 0x20, 0x31, 0xab, 0x00, 0x3c, 0x03, 0x56, 0x24, 0x6b, 0xe9, 0x71,
-	0x5f, 0x7e, 0xd5, 0x2f, 0x91, 0xe9, 0xee, 0x77, 0x31, 0x1d, 0xbd, 0x87, 0xf3, 0x7b, 0xa7, 0x57,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0x11, 0x5f, 0xf9, 0xa7, 0xf6, 0x00, 0x00, 0x00,
+	// 258 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0xcf, 0x4a, 0xf3, 0x40,
+	0x14, 0xc5, 0x99, 0x2f, 0x1f, 0x49, 0x7b, 0xd5, 0x22, 0x17, 0xd1, 0x21, 0x28, 0x84, 0xc1, 0x45,
+	0x40, 0x28, 0x52, 0xc1, 0xb5, 0x1b, 0xa9, 0xdd, 0xce, 0x1b, 0x44, 0x7b, 0xd1, 0x40, 0x3a, 0x63,
+	0x27, 0x37, 0x2f, 0xe4, 0x93, 0x4a, 0xe6, 0x8f, 0x90, 0x96, 0xee, 0xee, 0x39, 0x39, 0x9c, 0x9c,
+	0x1f, 0x03, 0x57, 0x8e, 0x3e, 0xec, 0x6e, 0x47, 0x66, 0xdb, 0x70, 0x6b, 0xcd, 0xf2, 0xdb, 0x59,
+	0xb6, 0xb8, 0x98, 0xba, 0x6a, 0x0d, 0x85, 0xa6, 0xfd, 0x40, 0x3d, 0xa3, 0x84, 0xc2, 0xd1, 0x7e,
+	0x68, 0x1d, 0x49, 0x51, 0x89, 0x7a, 0xae, 0x93, 0xc4, 0x4b, 0xc8, 0xba, 0x86, 0xe5, 0xbf, 0x4a,
+	0xd4, 0x42, 0x8f, 0xa7, 0x77, 0xac, 0x91, 0x59, 0x74, 0xac, 0x51, 0xf7, 0x90, 0x6b, 0xea, 0x87,
+	0x8e, 0xb1, 0x84, 0xd9, 0x9b, 0x65, 0xea, 0x36, 0xdb, 0x5e, 0x8a, 0x2a, 0xab, 0xe7, 0xfa, 0x4f,
+	0xab, 0x07, 0xb8, 0xd0, 0x0d, 0xb7, 0xe6, 0x33, 0xfd, 0xb4, 0x84, 0xd9, 0xd7, 0x41, 0x38, 0x69,
+	0xf5, 0x02, 0xe7, 0x29, 0xec, 0x8b, 0x1f, 0xa1, 0x70, 0x5e, 0x87, 0xe8, 0xd9, 0xea, 0x7a, 0x79,
+	0xc0, 0x18, 0xe3, 0x29, 0xa6, 0x9e, 0x21, 0x0f, 0xd6, 0x08, 0x17, 0x7b, 0x13, 0x5c, 0x94, 0x88,
+	0xf0, 0xdf, 0x35, 0x4c, 0x91, 0xce, 0xdf, 0xab, 0x1f, 0x01, 0x0b, 0x3d, 0xa9, 0xc6, 0x57, 0xc0,
+	0x35, 0xf1, 0xd4, 0xec, 0xf1, 0xe6, 0x68, 0x41, 0xe0, 0x2a, 0x8f, 0xa7, 0x05, 0x86, 0x0d, 0xc0,
+	0x58, 0x13, 0xf6, 0xe1, 0xdd, 0x09, 0x80, 0x58, 0x72, 0x7b, 0xea, 0xf3, 0x58, 0xf5, 0x9e, 0xfb,
+	0x17, 0x7d, 0xfa, 0x05, 0x00, 0x00, 0xff, 0xff, 0x03, 0x00, 0x1e, 0x9a, 0x4b, 0x99, 0xe9, 0x01,
+	0x00, 0x00,
 }
\ No newline at end of file
@@ -15,7 +15,6 @@ import (
 	"golang.org/x/net/context"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/keepalive"
-	"gopkg.in/mgo.v2"
 	"gopkg.in/mgo.v2/bson"
 
 	// "io/ioutil"
@@ -31,13 +30,13 @@ const name = "srv-recommendation"
 
 // Server implements the recommendation service
 type Server struct {
-	hotels       map[string]Hotel
-	Tracer       opentracing.Tracer
-	Port         int
-	IpAddr       string
-	MongoSession *mgo.Session
-	Registry     *registry.Client
-	uuid         string
+	hotels   map[string]Hotel
+	Tracer   opentracing.Tracer
+	Port     int
+	IpAddr   string
+	Store    RecommendationStore
+	Registry *registry.Client
+	uuid     string
 }
 
 // Run starts the server
@@ -47,7 +46,7 @@ func (s *Server) Run() error {
 	}
 
 	if s.hotels == nil {
-		s.hotels = loadRecommendations(s.MongoSession)
+		s.hotels = loadRecommendations(s.Store)
 	}
 
 	s.uuid = uuid.New().String()
@@ -167,21 +166,21 @@ func (s *Server) GetRecommendations(ctx context.Context, req *pb.Request) (*pb.R
 	return res, nil
 }
 
-// loadRecommendations loads hotel recommendations from mongodb.
-func loadRecommendations(session *mgo.Session) map[string]Hotel {
-	// session, err := mgo.Dial("mongodb-recommendation")
-	// if err != nil {
-	// 	panic(err)
-	// }
-	// defer session.Close()
-	s := session.Copy()
-	defer s.Close()
-
-	c := s.DB("recommendation-db").C("recommendation")
+// GetRatings returns the review rating of the given hotels, leaving out
+// unknown ones
+func (s *Server) GetRatings(ctx context.Context, req *pb.RatingRequest) (*pb.RatingResult, error) {
+	res := new(pb.RatingResult)
+	for _, hotelId := range req.HotelIds {
+		if hotel, ok := s.hotels[hotelId]; ok {
+			res.Ratings = append(res.Ratings, &pb.Rating{HotelId: hotelId, Rate: hotel.HRate})
+		}
+	}
+	return res, nil
+}
 
-	// unmarshal json profiles
-	var hotels []Hotel
-	err := c.Find(bson.M{}).All(&hotels)
+// loadRecommendations loads hotel recommendations from the store.
+func loadRecommendations(store RecommendationStore) map[string]Hotel {
+	hotels, err := store.Hotels()
 	if err != nil {
 		log.Error().Msgf("Failed get hotels data: ", err)
 	}
@@ -195,10 +194,10 @@ func loadRecommendations(session *mgo.Session) map[string]Hotel {
 }
 
 type Hotel struct {
-	ID     bson.ObjectId `bson:"_id"`
-	HId    string        `bson:"hotelId"`
-	HLat   float64       `bson:"lat"`
-	HLon   float64       `bson:"lon"`
-	HRate  float64       `bson:"rate"`
-	HPrice float64       `bson:"price"`
+	ID     bson.ObjectId `bson:"_id" json:"-"`
+	HId    string        `bson:"hotelId" json:"hotelId"`
+	HLat   float64       `bson:"lat" json:"lat"`
+	HLon   float64       `bson:"lon" json:"lon"`
+	HRate  float64       `bson:"rate" json:"rate"`
+	HPrice float64       `bson:"price" json:"price"`
 }
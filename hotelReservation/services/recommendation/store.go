@@ -0,0 +1,59 @@
+package recommendation
+
+import (
+	"encoding/json"
+	"sync"
+
+	"github.com/harlow/go-micro-services/data"
+	"gopkg.in/mgo.v2"
+	"gopkg.in/mgo.v2/bson"
+)
+
+// RecommendationStore stores what hotels are recommended by
+type RecommendationStore interface {
+	// Hotels returns the location, rate and price of every hotel.
+	Hotels() ([]Hotel, error)
+}
+
+type mongoStore struct {
+	session *mgo.Session
+}
+
+// NewMongoStore returns a RecommendationStore backed by the
+// recommendation-db database
+func NewMongoStore(session *mgo.Session) RecommendationStore {
+	return &mongoStore{session: session}
+}
+
+func (m *mongoStore) Hotels() ([]Hotel, error) {
+	s := m.session.Copy()
+	defer s.Close()
+
+	hotels := make([]Hotel, 0)
+	err := s.DB("recommendation-db").C("recommendation").Find(bson.M{}).All(&hotels)
+	return hotels, err
+}
+
+type memoryStore struct {
+	mu     sync.RWMutex
+	hotels []Hotel
+}
+
+// NewMemoryStore returns a RecommendationStore kept in memory and seeded
+// from data/recommendations.json
+func NewMemoryStore() RecommendationStore {
+	hotels := make([]Hotel, 0)
+	if err := json.Unmarshal(data.MustAsset("data/recommendations.json"), &hotels); err != nil {
+		panic(err)
+	}
+	return &memoryStore{hotels: hotels}
+}
+
+func (m *memoryStore) Hotels() ([]Hotel, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	hotels := make([]Hotel, len(m.hotels))
+	copy(hotels, m.hotels)
+	return hotels, nil
+}
@@ -0,0 +1,204 @@
+package reservation
+
+import (
+	"github.com/bradfitz/gomemcache/memcache"
+	"github.com/harlow/go-micro-services/rpcerr"
+	pb "github.com/harlow/go-micro-services/services/reservation/proto"
+	"github.com/harlow/go-micro-services/tune"
+	"github.com/rs/zerolog/log"
+	"golang.org/x/net/context"
+)
+
+// maxCalendarNights bounds the date range of an availability calendar,
+// and maxCalendarHotels the hotels it covers.
+const (
+	maxCalendarNights = 366
+	maxCalendarHotels = 50
+)
+
+// GetAvailabilityCalendar returns the rooms left per hotel and night
+func (s *Server) GetAvailabilityCalendar(ctx context.Context, req *pb.CalendarRequest) (*pb.Calendar, error) {
+	res := new(pb.Calendar)
+	res.Hotels = make([]*pb.HotelCalendar, 0)
+
+	nights, err := checkDates(req.InDate, req.OutDate)
+	if err != nil {
+		return nil, err
+	}
+	if len(nights) > maxCalendarNights {
+		return nil, rpcerr.InvalidArgument("outDate", "the calendar spans more than %d nights", maxCalendarNights)
+	}
+	if len(req.HotelId) > maxCalendarHotels {
+		return nil, rpcerr.InvalidArgument("hotelId", "the calendar covers more than %d hotels", maxCalendarHotels)
+	}
+	if len(req.HotelId) == 0 {
+		return res, nil
+	}
+
+	capacities, err := s.getCapacities(req.HotelId)
+	if err != nil {
+		return nil, err
+	}
+	booked, err := s.getBookedNights(req.HotelId, capacities, req.RoomType, nights)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, hotelId := range req.HotelId {
+		hotel_cap, ok := capacities[hotelId]
+		if !ok {
+			continue
+		}
+		hotel := &pb.HotelCalendar{HotelId: hotelId, Nights: make([]*pb.NightAvailability, 0, len(nights))}
+		for _, date := range nights {
+			night := &pb.NightAvailability{Date: date, RoomTypes: make([]*pb.RoomTypeAvailability, 0)}
+			for _, code := range roomTypes(hotel_cap, req.RoomType) {
+				left := hotel_cap[code] - booked[nightKey(hotelId, code, date)]
+				if left < 0 {
+					// capacity has been lowered below the rooms booked already
+					left = 0
+				}
+				night.RoomsLeft += int32(left)
+				night.RoomTypes = append(night.RoomTypes, &pb.RoomTypeAvailability{
+					RoomType:  code,
+					RoomsLeft: int32(left),
+					Capacity:  int32(hotel_cap[code]),
+				})
+			}
+			hotel.Nights = append(hotel.Nights, night)
+		}
+		res.Hotels = append(res.Hotels, hotel)
+	}
+	return res, nil
+}
+
+// getCapacities is getCapacity for several hotels. Cached capacities are
+// read with a single GetMulti and the missing ones with a single query.
+// Unknown hotels are left out.
+func (s *Server) getCapacities(hotelIds []string) (map[string]map[string]int, error) {
+	keys := make([]string, 0, len(hotelIds))
+	for _, hotelId := range hotelIds {
+		keys = append(keys, hotelId+"_cap")
+	}
+	items, err := s.MemcClient.GetMulti(keys)
+	if err != nil {
+		// memcached unavailable, read through to mongo
+		if err != tune.ErrMemCUnavailable {
+			log.Warn().Msgf("Tried to get %d capacities, but got memmcached error = %s", len(keys), err)
+		}
+	}
+
+	capacities := make(map[string]map[string]int)
+	missing := make([]string, 0)
+	for _, hotelId := range hotelIds {
+		memc_cap_key := hotelId + "_cap"
+		if item, ok := items[memc_cap_key]; ok {
+			// memcached hit
+			hotel_cap, err := decodeCapacity(item.Value)
+			if err == nil {
+				capacities[hotelId] = hotel_cap
+				continue
+			}
+			log.Warn().Msgf("Dropping cached capacity [%v]: %s", memc_cap_key, err)
+			s.MemcClient.Delete(memc_cap_key)
+		}
+		missing = append(missing, hotelId)
+	}
+	if len(missing) == 0 {
+		return capacities, nil
+	}
+
+	// memcached miss
+	found, err := s.Store.Capacities(missing)
+	if err != nil {
+		log.Error().Msgf("Tried to find hotelIds %v, but got error = %s", missing, err)
+		return nil, rpcerr.Mongo(err)
+	}
+	backfill := make([]*memcache.Item, 0, len(missing))
+	for _, hotelId := range missing {
+		hotel_cap := found[hotelId]
+		if len(hotel_cap) == 0 {
+			log.Trace().Msgf("no capacity of hotelId [%v]", hotelId)
+			continue
+		}
+		capacities[hotelId] = hotel_cap
+		backfill = append(backfill, &memcache.Item{Key: hotelId + "_cap", Value: encodeCapacity(hotel_cap)})
+	}
+
+	// write to memcache
+	s.MemcClient.SetMulti(backfill)
+	return capacities, nil
+}
+
+// getBookedNights returns the number of rooms booked for every night and
+// room type of several hotels, keyed by nightKey. Cached counts are read
+// with a single GetMulti and the missing ones with a single query over the
+// whole date range.
+func (s *Server) getBookedNights(hotelIds []string, capacities map[string]map[string]int, roomType string, nights []string) (map[string]int, error) {
+	// keyHotels is the hotel of each of keys
+	keys, keyHotels := make([]string, 0), make([]string, 0)
+	for _, hotelId := range hotelIds {
+		for _, code := range roomTypes(capacities[hotelId], roomType) {
+			for _, date := range nights {
+				keys = append(keys, nightKey(hotelId, code, date))
+				keyHotels = append(keyHotels, hotelId)
+			}
+		}
+	}
+	if len(keys) == 0 {
+		return map[string]int{}, nil
+	}
+	items, err := s.MemcClient.GetMulti(keys)
+	if err != nil {
+		// memcached unavailable, read through to mongo
+		if err != tune.ErrMemCUnavailable {
+			log.Warn().Msgf("Tried to get %d night counts, but got memmcached error = %s", len(keys), err)
+		}
+	}
+
+	booked := make(map[string]int)
+	missing := make([]string, 0)
+	missingHotels := make([]string, 0)
+	for i, memc_key := range keys {
+		if item, ok := items[memc_key]; ok {
+			// memcached hit
+			count, _, err := decodeCount(item.Value)
+			if err == nil {
+				booked[memc_key] = count
+				continue
+			}
+			log.Warn().Msgf("Dropping cached night count [%v]: %s", memc_key, err)
+			s.MemcClient.Delete(memc_key)
+		}
+		missing = append(missing, memc_key)
+		if hotelId := keyHotels[i]; len(missingHotels) == 0 || missingHotels[len(missingHotels)-1] != hotelId {
+			missingHotels = append(missingHotels, hotelId)
+		}
+	}
+	if len(missing) == 0 {
+		return booked, nil
+	}
+
+	// memcached miss, only of the hotels with nights missing
+	log.Trace().Msgf("memcached miss on %d night counts", len(missing))
+	invs, err := s.Store.Nights(missingHotels, nights[0], nights[len(nights)-1])
+	if err != nil {
+		log.Error().Msgf("Tried to find hotelIds %v from [%v] to [%v], but got error = %s", missingHotels, nights[0], nights[len(nights)-1], err)
+		return nil, rpcerr.Mongo(err)
+	}
+	found := make(map[string]inventory)
+	for _, inv := range invs {
+		found[nightKey(inv.HotelId, inv.RoomType, inv.Date)] = inv
+	}
+	backfill := make([]*memcache.Item, 0, len(missing))
+	for _, memc_key := range missing {
+		inv := found[memc_key]
+		booked[memc_key] = inv.Booked
+		backfill = append(backfill, &memcache.Item{Key: memc_key, Value: encodeCount(inv.Booked, inv.Version)})
+	}
+
+	// Add never overwrites, so a count read here can't replace a newer one
+	// written by a concurrent reservation.
+	s.MemcClient.AddMulti(backfill)
+	return booked, nil
+}
@@ -0,0 +1,192 @@
+package reservation
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/harlow/go-micro-services/rpcerr"
+	pb "github.com/harlow/go-micro-services/services/reservation/proto"
+	"github.com/rs/zerolog/log"
+	"golang.org/x/net/context"
+)
+
+const (
+	// holdTimeout is how long rooms stay held before they are given back.
+	holdTimeout = 15 * time.Minute
+	// reapInterval is how often expired holds are looked for.
+	reapInterval = 30 * time.Second
+)
+
+// hold is a booking that has not been confirmed yet. Its rooms are taken
+// from the inventory like those of a reservation, but are given back if it
+// is not confirmed before ExpiresAt.
+type hold struct {
+	HoldId       string    `bson:"holdId"`
+	HotelId      string    `bson:"hotelId"`
+	RoomType     string    `bson:"roomType"`
+	CustomerName string    `bson:"customerName"`
+	InDate       string    `bson:"inDate"`
+	OutDate      string    `bson:"outDate"`
+	Number       int       `bson:"number"`
+	ExpiresAt    time.Time `bson:"expiresAt"`
+}
+
+// HoldRooms reserves rooms for a limited time and returns a hold token
+func (s *Server) HoldRooms(ctx context.Context, req *pb.Request) (*pb.HoldResult, error) {
+	res := new(pb.HoldResult)
+
+	if len(req.HotelId) == 0 {
+		return nil, rpcerr.InvalidArgument("hotelId", "no hotel given")
+	}
+
+	hotelId := req.HotelId[0]
+	rooms := int(req.RoomNumber)
+
+	nights, err := checkStay(req.InDate, req.OutDate, req.RoomNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	roomType, err := s.bookRooms(hotelId, req.RoomType, nights, rooms)
+	if err != nil {
+		return nil, err
+	}
+	if roomType == "" {
+		log.Trace().Msgf("hotel %s is full", hotelId)
+		return res, nil
+	}
+
+	h := hold{
+		HoldId:       uuid.New().String(),
+		HotelId:      hotelId,
+		RoomType:     roomType,
+		CustomerName: req.CustomerName,
+		InDate:       req.InDate,
+		OutDate:      req.OutDate,
+		Number:       rooms,
+		ExpiresAt:    time.Now().Add(holdTimeout),
+	}
+	err = s.Store.InsertHold(&h)
+	if err != nil {
+		log.Error().Msgf("Tried to insert hold [hotelId %v], but got error = %s", hotelId, err)
+		s.releaseHold(&h)
+		return nil, rpcerr.Mongo(err)
+	}
+
+	res.HoldId = h.HoldId
+	res.HotelId = h.HotelId
+	res.RoomType = h.RoomType
+	res.ExpiresAt = h.ExpiresAt.Unix()
+
+	return res, nil
+}
+
+// ConfirmHold turns a hold into a reservation
+func (s *Server) ConfirmHold(ctx context.Context, req *pb.HoldRequest) (*pb.Result, error) {
+	res := new(pb.Result)
+	res.HotelId = make([]string, 0)
+
+	// taking the hold away first makes sure it is either confirmed or
+	// reaped, never both
+	f, err := holdFilterOf(req)
+	if err != nil {
+		return nil, err
+	}
+	f.LiveAt = time.Now()
+	h, err := s.Store.TakeHold(f)
+	if err != nil {
+		log.Error().Msgf("Tried to confirm hold [holdId %v], but got error = %s", req.HoldId, err)
+		return nil, rpcerr.Mongo(err)
+	}
+	if h == nil {
+		log.Trace().Msgf("no hold %s to confirm", req.HoldId)
+		return nil, rpcerr.NotFound("hold", req.HoldId)
+	}
+
+	reservationId := uuid.New().String()
+	err = s.Store.InsertReservation(&reservation{
+		ReservationId: reservationId,
+		HotelId:       h.HotelId,
+		RoomType:      h.RoomType,
+		CustomerName:  h.CustomerName,
+		InDate:        h.InDate,
+		OutDate:       h.OutDate,
+		Number:        h.Number})
+	if err != nil {
+		log.Error().Msgf("Tried to insert hotel [hotelId %v], but got error = %s", h.HotelId, err)
+		s.releaseHold(h)
+		return nil, rpcerr.Mongo(err)
+	}
+
+	res.HotelId = append(res.HotelId, h.HotelId)
+	res.ReservationId = reservationId
+	res.RoomType = h.RoomType
+
+	return res, nil
+}
+
+// ReleaseHold gives the rooms of a hold back
+func (s *Server) ReleaseHold(ctx context.Context, req *pb.HoldRequest) (*pb.Result, error) {
+	res := new(pb.Result)
+	res.HotelId = make([]string, 0)
+
+	f, err := holdFilterOf(req)
+	if err != nil {
+		return nil, err
+	}
+	h, err := s.Store.TakeHold(f)
+	if err != nil {
+		log.Error().Msgf("Tried to release hold [holdId %v], but got error = %s", req.HoldId, err)
+		return nil, rpcerr.Mongo(err)
+	}
+	if h == nil {
+		log.Trace().Msgf("no hold %s to release", req.HoldId)
+		return nil, rpcerr.NotFound("hold", req.HoldId)
+	}
+	s.releaseHold(h)
+
+	res.HotelId = append(res.HotelId, h.HotelId)
+	res.RoomType = h.RoomType
+
+	return res, nil
+}
+
+// reapHolds periodically gives back the rooms of expired holds. Every
+// replica runs it; TakeHold removes a hold atomically, so only one of them
+// ever gets to release it.
+func (s *Server) reapHolds() {
+	ticker := time.NewTicker(reapInterval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		for {
+			h, err := s.Store.TakeHold(holdFilter{Any: true, ExpiredAt: time.Now()})
+			if err != nil {
+				log.Error().Msgf("Tried to reap expired holds, but got error = %s", err)
+				break
+			}
+			if h == nil {
+				break
+			}
+			log.Trace().Msgf("hold %s expired", h.HoldId)
+			s.releaseHold(h)
+		}
+	}
+}
+
+// releaseHold gives back the rooms of a hold taken from the store.
+func (s *Server) releaseHold(h *hold) {
+	nights, _ := stayNights(h.InDate, h.OutDate)
+	for _, date := range nights {
+		s.releaseNight(h.HotelId, h.RoomType, date, h.Number)
+	}
+}
+
+// holdFilterOf matches the hold of req, and only if it belongs to the
+// customer when one is given.
+func holdFilterOf(req *pb.HoldRequest) (holdFilter, error) {
+	if req.HoldId == "" {
+		return holdFilter{}, rpcerr.InvalidArgument("holdId", "no hold given")
+	}
+	return holdFilter{HoldId: req.HoldId, CustomerName: req.CustomerName}, nil
+}
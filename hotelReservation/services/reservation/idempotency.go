@@ -0,0 +1,160 @@
+package reservation
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/harlow/go-micro-services/rpcerr"
+	pb "github.com/harlow/go-micro-services/services/reservation/proto"
+	"github.com/rs/zerolog/log"
+	"golang.org/x/net/context"
+)
+
+const (
+	// idempotencyRetention is how long the result of a request is replayed
+	// to retries with the same key. The TTL index on the idempotency
+	// collection removes records after the same time.
+	idempotencyRetention = 24 * time.Hour
+	// idempotencyLease is how long a retry waits for the first request to
+	// finish before taking its key over.
+	idempotencyLease = 30 * time.Second
+	// idempotencyPoll is how often a retry checks whether the first request
+	// has finished.
+	idempotencyPoll = 100 * time.Millisecond
+)
+
+// idempotencyKey is the idempotency key of a request. Keys are chosen by
+// clients, so they are scoped to the customer: the same key of two
+// customers names two requests.
+type idempotencyKey struct {
+	CustomerName string
+	Key          string
+}
+
+func (k idempotencyKey) String() string {
+	return fmt.Sprintf("%s of %s", k.Key, k.CustomerName)
+}
+
+// idempotencyRecord is the result of a request stored under its idempotency
+// key. It is claimed before the request is served and Done once the result
+// is stored. RequestHash tells the request that claimed the key from
+// another one using the same key.
+type idempotencyRecord struct {
+	CustomerName  string    `bson:"customerName"`
+	Key           string    `bson:"key"`
+	RequestHash   string    `bson:"requestHash"`
+	Done          bool      `bson:"done"`
+	HotelId       []string  `bson:"hotelId"`
+	ReservationId string    `bson:"reservationId"`
+	RoomType      string    `bson:"roomType"`
+	CreatedAt     time.Time `bson:"createdAt"`
+}
+
+func (r *idempotencyRecord) key() idempotencyKey {
+	return idempotencyKey{CustomerName: r.CustomerName, Key: r.Key}
+}
+
+func (r *idempotencyRecord) result() *pb.Result {
+	res := new(pb.Result)
+	res.HotelId = make([]string, 0)
+	res.HotelId = append(res.HotelId, r.HotelId...)
+	res.ReservationId = r.ReservationId
+	res.RoomType = r.RoomType
+	return res
+}
+
+// keyOf returns the idempotency key of a request
+func keyOf(req *pb.Request) idempotencyKey {
+	return idempotencyKey{CustomerName: req.CustomerName, Key: req.IdempotencyKey}
+}
+
+// requestHash identifies the reservation a request asks for, apart from
+// its customer who is part of the key
+func requestHash(req *pb.Request) string {
+	h := sha256.New()
+	fmt.Fprintf(h, "%q %q %q %d %q", req.HotelId, req.InDate, req.OutDate, req.RoomNumber, req.RoomType)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// claimKey claims the idempotency key of a new request and returns true, or
+// returns the result of an earlier request with the same key. If the earlier
+// request is still running it waits for it to finish, or for ctx to be
+// done; a request that holds its key for longer than idempotencyLease is
+// assumed to have failed and the key is taken over. A key can't be used
+// for a different request while it is held or its result is kept.
+func (s *Server) claimKey(ctx context.Context, req *pb.Request) (*pb.Result, bool, error) {
+	key, hash := keyOf(req), requestHash(req)
+	for {
+		now := time.Now()
+		claim := &idempotencyRecord{CustomerName: key.CustomerName, Key: key.Key, RequestHash: hash, CreatedAt: now}
+		claimed, err := s.Store.InsertRecord(claim)
+		if err != nil {
+			log.Error().Msgf("Tried to claim idempotency key [%v], but got error = %s", key, err)
+			return nil, false, rpcerr.Mongo(err)
+		}
+		if claimed {
+			return nil, true, nil
+		}
+
+		rec, err := s.Store.FindRecord(key)
+		if err != nil {
+			log.Error().Msgf("Tried to find idempotency key [%v], but got error = %s", key, err)
+			return nil, false, rpcerr.Mongo(err)
+		}
+		if rec == nil {
+			// expired in the meantime
+			continue
+		}
+
+		age := now.Sub(rec.CreatedAt)
+		held := (rec.Done && age < idempotencyRetention) || (!rec.Done && age < idempotencyLease)
+		if held && rec.RequestHash != hash {
+			log.Trace().Msgf("idempotency key %s reused for a different request", key)
+			return nil, false, rpcerr.InvalidArgument("idempotencyKey", "%s was used for a different reservation", key.Key)
+		}
+		if rec.Done && held {
+			log.Trace().Msgf("replaying result of idempotency key %s", key)
+			return rec.result(), false, nil
+		}
+		if held {
+			select {
+			case <-ctx.Done():
+				return nil, false, rpcerr.Context(ctx.Err())
+			case <-time.After(idempotencyPoll):
+			}
+			continue
+		}
+
+		// the record outlived its retention or lease, take it over unless
+		// another retry got there first
+		claimed, err = s.Store.RenewRecord(rec, claim)
+		if err != nil {
+			log.Error().Msgf("Tried to take over idempotency key [%v], but got error = %s", key, err)
+			return nil, false, rpcerr.Mongo(err)
+		}
+		if claimed {
+			return nil, true, nil
+		}
+	}
+}
+
+// storeResult stores the result of the request that claimed key. The
+// request has already been served, so failures are only logged; retries
+// wait for the lease to run out then.
+func (s *Server) storeResult(key idempotencyKey, res *pb.Result) {
+	err := s.Store.CompleteRecord(key, res)
+	if err != nil {
+		log.Error().Msgf("Tried to store result of idempotency key [%v], but got error = %s", key, err)
+	}
+}
+
+// releaseKey gives up a claimed key after its request failed, so that a
+// retry is served instead of waiting for the lease to run out.
+func (s *Server) releaseKey(key idempotencyKey) {
+	err := s.Store.RemoveRecord(key)
+	if err != nil {
+		log.Error().Msgf("Tried to release idempotency key [%v], but got error = %s", key, err)
+	}
+}
@@ -0,0 +1,77 @@
+package reservation
+
+import (
+	"testing"
+	"time"
+
+	pb "github.com/harlow/go-micro-services/services/reservation/proto"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func stayOf(customerName, roomType string) *pb.Request {
+	return &pb.Request{
+		CustomerName:   customerName,
+		HotelId:        []string{"1"},
+		InDate:         "2015-04-09",
+		OutDate:        "2015-04-10",
+		RoomNumber:     1,
+		RoomType:       roomType,
+		IdempotencyKey: "retry-me",
+	}
+}
+
+func TestIdempotencyKeysAreScopedToCustomers(t *testing.T) {
+	s, _ := newTestServer(t)
+	ctx := context.Background()
+
+	first, err := s.MakeReservation(ctx, stayOf("Cornell_1", "KNG"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	retried, err := s.MakeReservation(ctx, stayOf("Cornell_1", "KNG"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if retried.ReservationId != first.ReservationId {
+		t.Errorf("retry reserved %s, want the result of the first request %s", retried.ReservationId, first.ReservationId)
+	}
+
+	other, err := s.MakeReservation(ctx, stayOf("Cornell_2", "KNG"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if other.ReservationId == "" || other.ReservationId == first.ReservationId {
+		t.Errorf("another customer with the same key got reservation %q", other.ReservationId)
+	}
+	if got := booked(t, s, []string{"2015-04-09"})["2015-04-09"]; got != 2 {
+		t.Errorf("%d rooms booked, want 2", got)
+	}
+
+	_, err = s.MakeReservation(ctx, stayOf("Cornell_1", "QN"))
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("reusing a key for another room type: err = %v, want InvalidArgument", err)
+	}
+}
+
+func TestClaimKeyStopsWaitingWhenCallIsDone(t *testing.T) {
+	s, _ := newTestServer(t)
+	req := stayOf("Cornell_1", "KNG")
+
+	// the first request holds the key and never finishes
+	if _, claimed, err := s.claimKey(context.Background(), req); err != nil || !claimed {
+		t.Fatalf("first claim: claimed = %v, err = %v", claimed, err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 3*idempotencyPoll)
+	defer cancel()
+	start := time.Now()
+	_, claimed, err := s.claimKey(ctx, req)
+	if claimed || status.Code(err) != codes.DeadlineExceeded {
+		t.Errorf("claimed = %v, err = %v, want DeadlineExceeded", claimed, err)
+	}
+	if waited := time.Since(start); waited > idempotencyLease/2 {
+		t.Errorf("waited %v for the key", waited)
+	}
+}
@@ -0,0 +1,260 @@
+package reservation
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bradfitz/gomemcache/memcache"
+	"github.com/harlow/go-micro-services/rpcerr"
+	"github.com/harlow/go-micro-services/tune"
+	"github.com/rs/zerolog/log"
+)
+
+// maxCasRetries bounds how often a cached night count is re-read when
+// another replica updates the same key concurrently.
+const maxCasRetries = 5
+
+// inventory is the per-night booking counter of a room type of a hotel.
+// Booked is only ever changed by the store, which checks the capacity and
+// updates the counter in a single atomic operation; Version is bumped on
+// every change and lets the cache tell newer counts from older ones.
+type inventory struct {
+	HotelId  string `bson:"hotelId"`
+	RoomType string `bson:"roomType"`
+	Date     string `bson:"date"`
+	Booked   int    `bson:"booked"`
+	Version  int    `bson:"version"`
+}
+
+// stayNights returns the date of every night between inDate and outDate.
+func stayNights(inDate, outDate string) ([]string, error) {
+	in, err := time.Parse("2006-01-02", inDate)
+	if err != nil {
+		return nil, err
+	}
+	out, err := time.Parse("2006-01-02", outDate)
+	if err != nil {
+		return nil, err
+	}
+
+	nights := make([]string, 0)
+	for d := in; d.Before(out); d = d.AddDate(0, 0, 1) {
+		nights = append(nights, d.Format("2006-01-02"))
+	}
+	return nights, nil
+}
+
+func nightKey(hotelId, roomType, date string) string {
+	return hotelId + "_" + roomType + "_" + date
+}
+
+// roomTypes returns the room types of a hotel a request may be booked in:
+// the requested one if the hotel has it, or all of them in a fixed order.
+func roomTypes(capacity map[string]int, roomType string) []string {
+	if roomType != "" {
+		if _, ok := capacity[roomType]; !ok {
+			return nil
+		}
+		return []string{roomType}
+	}
+
+	codes := make([]string, 0, len(capacity))
+	for code := range capacity {
+		codes = append(codes, code)
+	}
+	sort.Strings(codes)
+	return codes
+}
+
+func encodeCapacity(capacity map[string]int) []byte {
+	entries := make([]string, 0, len(capacity))
+	for _, code := range roomTypes(capacity, "") {
+		entries = append(entries, fmt.Sprintf("%s:%d", code, capacity[code]))
+	}
+	return []byte(strings.Join(entries, ","))
+}
+
+func decodeCapacity(value []byte) (map[string]int, error) {
+	capacity := make(map[string]int)
+	if len(value) == 0 {
+		return capacity, nil
+	}
+	for _, entry := range strings.Split(string(value), ",") {
+		parts := strings.SplitN(entry, ":", 2)
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("malformed capacity %q", value)
+		}
+		rooms, err := strconv.Atoi(parts[1])
+		if err != nil {
+			return nil, err
+		}
+		capacity[parts[0]] = rooms
+	}
+	return capacity, nil
+}
+
+func encodeCount(booked, version int) []byte {
+	return []byte(fmt.Sprintf("%d:%d", booked, version))
+}
+
+func decodeCount(value []byte) (booked, version int, err error) {
+	parts := strings.SplitN(string(value), ":", 2)
+	if len(parts) != 2 {
+		return 0, 0, fmt.Errorf("malformed night count %q", value)
+	}
+	if booked, err = strconv.Atoi(parts[0]); err != nil {
+		return 0, 0, err
+	}
+	if version, err = strconv.Atoi(parts[1]); err != nil {
+		return 0, 0, err
+	}
+	return booked, version, nil
+}
+
+// getCapacity returns the number of rooms of every room type of a hotel.
+func (s *Server) getCapacity(hotelId string) (map[string]int, error) {
+	memc_cap_key := hotelId + "_cap"
+	item, err := s.MemcClient.Get(memc_cap_key)
+	if err == nil {
+		// memcached hit
+		hotel_cap, err := decodeCapacity(item.Value)
+		if err == nil {
+			log.Trace().Msgf("memcached hit %s = %v", memc_cap_key, hotel_cap)
+			return hotel_cap, nil
+		}
+		log.Warn().Msgf("Dropping cached capacity [%v]: %s", memc_cap_key, err)
+		s.MemcClient.Delete(memc_cap_key)
+	} else if err != memcache.ErrCacheMiss {
+		// memcached unavailable, read through to mongo
+		if err != tune.ErrMemCUnavailable {
+			log.Warn().Msgf("Tried to get memc_cap_key [%v], but got memmcached error = %s", memc_cap_key, err)
+		}
+	}
+
+	// memcached miss
+	hotel_cap, err := s.Store.Capacity(hotelId)
+	if err != nil {
+		log.Error().Msgf("Tried to find hotelId [%v], but got error = %s", hotelId, err)
+		return nil, rpcerr.Mongo(err)
+	}
+	if len(hotel_cap) == 0 {
+		return nil, rpcerr.NotFound("hotel", hotelId)
+	}
+
+	// write to memcache
+	s.MemcClient.Set(&memcache.Item{Key: memc_cap_key, Value: encodeCapacity(hotel_cap)})
+	return hotel_cap, nil
+}
+
+// bookRooms books rooms of a hotel for every night of a stay and returns
+// the room type they were booked in. Without a requested room type the
+// first one that is free for the whole stay is used. It returns an empty
+// string if no rooms could be booked.
+func (s *Server) bookRooms(hotelId, roomType string, nights []string, rooms int) (string, error) {
+	hotel_cap, err := s.getCapacity(hotelId)
+	if err != nil {
+		return "", err
+	}
+	if roomType != "" {
+		if _, ok := hotel_cap[roomType]; !ok {
+			return "", rpcerr.NotFound("room type", roomType)
+		}
+	}
+
+	for _, code := range roomTypes(hotel_cap, roomType) {
+		ok, err := s.bookStay(hotelId, code, nights, rooms, hotel_cap[code])
+		if err != nil {
+			return "", err
+		}
+		if ok {
+			return code, nil
+		}
+	}
+	return "", nil
+}
+
+// bookStay books rooms of a room type for every night of a stay. Either all
+// nights are booked or, if one of them is full or fails, none of them is.
+func (s *Server) bookStay(hotelId, roomType string, nights []string, rooms, capacity int) (bool, error) {
+	for i, date := range nights {
+		ok, err := s.bookNight(hotelId, roomType, date, rooms, capacity)
+		if ok && err == nil {
+			continue
+		}
+		if err == nil {
+			log.Trace().Msgf("hotel %s has no %s room left on %s", hotelId, roomType, date)
+		}
+		for _, booked := range nights[:i] {
+			s.releaseNight(hotelId, roomType, booked, rooms)
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+// bookNight books rooms of a room type for one night as long as the room
+// type stays within its capacity. It returns false if there are not enough
+// rooms left.
+func (s *Server) bookNight(hotelId, roomType, date string, rooms, capacity int) (bool, error) {
+	inv, ok, err := s.Store.BookNight(hotelId, roomType, date, rooms, capacity)
+	if err != nil {
+		log.Error().Msgf("Tried to book hotelId [%v] roomType [%v] on date [%v], but got error = %s", hotelId, roomType, date, err)
+		return false, rpcerr.Mongo(err)
+	}
+	if ok {
+		s.storeCount(inv)
+	}
+	return ok, nil
+}
+
+// releaseNight gives back rooms booked by bookNight. Failures are logged,
+// the rooms stay booked then.
+func (s *Server) releaseNight(hotelId, roomType, date string, rooms int) {
+	inv, err := s.Store.ReleaseNight(hotelId, roomType, date, rooms)
+	if err != nil {
+		log.Error().Msgf("Tried to release hotelId [%v] roomType [%v] on date [%v], but got error = %s", hotelId, roomType, date, err)
+		return
+	}
+	s.storeCount(inv)
+}
+
+// storeCount writes a night count read back from mongo to memcached. The
+// entry is only replaced through CAS and only by a newer version, so
+// concurrent writers can't leave an older count in the cache.
+func (s *Server) storeCount(inv inventory) {
+	memc_key := nightKey(inv.HotelId, inv.RoomType, inv.Date)
+	value := encodeCount(inv.Booked, inv.Version)
+
+	for i := 0; i < maxCasRetries; i++ {
+		item, err := s.MemcClient.Get(memc_key)
+		if err == memcache.ErrCacheMiss {
+			err = s.MemcClient.Add(&memcache.Item{Key: memc_key, Value: value})
+			if err == memcache.ErrNotStored {
+				continue
+			}
+			return
+		} else if err == tune.ErrMemCUnavailable {
+			// nothing to drop while memcached is bypassed
+			return
+		} else if err != nil {
+			break
+		}
+
+		if _, version, err := decodeCount(item.Value); err == nil && version >= inv.Version {
+			return
+		}
+		item.Value = value
+		err = s.MemcClient.CompareAndSwap(item)
+		if err == memcache.ErrCASConflict || err == memcache.ErrNotStored {
+			continue
+		}
+		return
+	}
+
+	// Give up on the entry; the next read repopulates it from mongo.
+	log.Warn().Msgf("Failed to update memc_key [%v], dropping it", memc_key)
+	s.MemcClient.Delete(memc_key)
+}
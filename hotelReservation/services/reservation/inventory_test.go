@@ -0,0 +1,253 @@
+package reservation
+
+import (
+	"math/rand"
+	"os"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/bradfitz/gomemcache/memcache"
+	"github.com/google/uuid"
+	"github.com/harlow/go-micro-services/tune"
+	"gopkg.in/mgo.v2"
+	"gopkg.in/mgo.v2/bson"
+)
+
+const parallelBookings = 500
+
+// mongoCapacity is the KNG capacity the concurrency tests book against mongo
+const mongoCapacity = 50
+
+func newTestServer(t *testing.T) (*Server, int) {
+	s := &Server{Store: NewMemoryStore()}
+	hotel_cap, err := s.Store.Capacity("1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	capacity := hotel_cap["KNG"]
+	if capacity == 0 || capacity >= parallelBookings {
+		t.Fatalf("hotel 1 has %d KNG rooms, want between 1 and %d", capacity, parallelBookings)
+	}
+	return s, capacity
+}
+
+// booked returns the rooms booked of KNG rooms of hotel 1 by night
+func booked(t *testing.T, s *Server, nights []string) map[string]int {
+	return bookedOf(t, s, "1", nights)
+}
+
+// bookedOf returns the rooms booked of KNG rooms of a hotel by night
+func bookedOf(t *testing.T, s *Server, hotelId string, nights []string) map[string]int {
+	invs, err := s.Store.Nights([]string{hotelId}, nights[0], nights[len(nights)-1])
+	if err != nil {
+		t.Fatal(err)
+	}
+	booked := make(map[string]int)
+	for _, inv := range invs {
+		if inv.RoomType == "KNG" {
+			booked[inv.Date] = inv.Booked
+		}
+	}
+	return booked
+}
+
+// backend is a store and cache the concurrency tests book KNG rooms of
+// hotelId in
+type backend struct {
+	name     string
+	server   *Server
+	hotelId  string
+	capacity int
+	memc     *fakeMemcached
+}
+
+// backends returns the memory store without and with memcached in front,
+// and the mongo store with memcached in front if TEST_MONGODB_URL and
+// TEST_MEMCACHED_ADDR name servers to test against.
+func backends(t *testing.T) []backend {
+	s, capacity := newTestServer(t)
+	cached, _ := newTestServer(t)
+	memc, addr := startFakeMemcached(t)
+	cached.MemcClient = tune.NewMemCClient(addr)
+
+	backends := []backend{
+		{name: "memory", server: s, hotelId: "1", capacity: capacity},
+		{name: "memory+memcached", server: cached, hotelId: "1", capacity: capacity, memc: memc},
+	}
+
+	url, memcAddr := os.Getenv("TEST_MONGODB_URL"), os.Getenv("TEST_MEMCACHED_ADDR")
+	if url == "" || memcAddr == "" {
+		t.Log("TEST_MONGODB_URL or TEST_MEMCACHED_ADDR not set, skipping mongo")
+		return backends
+	}
+	session, err := mgo.Dial(url)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// a hotel of its own, so that nothing else books it
+	hotelId := "test-" + uuid.New().String()
+	t.Cleanup(func() {
+		session.DB("reservation-db").C("inventory").RemoveAll(&bson.M{"hotelId": hotelId})
+		session.Close()
+	})
+	return append(backends, backend{
+		name:     "mongo+memcached",
+		server:   &Server{Store: NewMongoStore(session), MemcClient: tune.NewMemCClient(memcAddr)},
+		hotelId:  hotelId,
+		capacity: mongoCapacity,
+	})
+}
+
+// checkCached checks that memcached holds the booked count of every night,
+// if it holds one at all. Writers that keep losing the CAS race drop the
+// entry rather than risk leaving an older count.
+func (b *backend) checkCached(t *testing.T, nights []string, want int) {
+	for _, date := range nights {
+		var value []byte
+		if b.memc != nil {
+			value = b.memc.value(nightKey(b.hotelId, "KNG", date))
+		} else if b.server.MemcClient != nil {
+			item, err := b.server.MemcClient.Get(nightKey(b.hotelId, "KNG", date))
+			if err != nil && err != memcache.ErrCacheMiss {
+				t.Fatal(err)
+			}
+			if item != nil {
+				value = item.Value
+			}
+		} else {
+			return
+		}
+		if value == nil {
+			continue
+		}
+		booked, _, err := decodeCount(value)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if booked != want {
+			t.Errorf("%d rooms cached as booked on %s, want %d", booked, date, want)
+		}
+	}
+}
+
+func TestBookNightConcurrently(t *testing.T) {
+	for _, b := range backends(t) {
+		b := b
+		t.Run(b.name, func(t *testing.T) {
+			s, capacity := b.server, b.capacity
+
+			var successes int64
+			var wg sync.WaitGroup
+			for i := 0; i < parallelBookings; i++ {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					ok, err := s.bookNight(b.hotelId, "KNG", "2015-04-09", 1, capacity)
+					if err != nil {
+						t.Error(err)
+					}
+					if ok {
+						atomic.AddInt64(&successes, 1)
+					}
+				}()
+			}
+			wg.Wait()
+
+			if successes != int64(capacity) {
+				t.Errorf("%d bookings succeeded, want %d", successes, capacity)
+			}
+			if got := bookedOf(t, s, b.hotelId, []string{"2015-04-09"})["2015-04-09"]; got != capacity {
+				t.Errorf("%d rooms booked, want %d", got, capacity)
+			}
+			b.checkCached(t, []string{"2015-04-09"}, capacity)
+		})
+	}
+}
+
+func TestBookStayConcurrently(t *testing.T) {
+	nights := []string{"2015-04-09", "2015-04-10", "2015-04-11"}
+
+	for _, b := range backends(t) {
+		b := b
+		t.Run(b.name, func(t *testing.T) {
+			s, capacity := b.server, b.capacity
+
+			var successes int64
+			var wg sync.WaitGroup
+			for i := 0; i < parallelBookings; i++ {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					ok, err := s.bookStay(b.hotelId, "KNG", nights, 1, capacity)
+					if err != nil {
+						t.Error(err)
+					}
+					if ok {
+						atomic.AddInt64(&successes, 1)
+					}
+				}()
+			}
+			wg.Wait()
+
+			// stays that failed on a later night give back the nights before,
+			// and may make others fail meanwhile, so fewer than capacity may
+			// succeed
+			if successes == 0 || successes > int64(capacity) {
+				t.Errorf("%d stays succeeded, want between 1 and %d", successes, capacity)
+			}
+			for _, date := range nights {
+				if got := bookedOf(t, s, b.hotelId, nights)[date]; got != int(successes) {
+					t.Errorf("%d rooms booked on %s, want %d", got, date, successes)
+				}
+			}
+			b.checkCached(t, nights, int(successes))
+
+			// nothing is left once the whole capacity is booked
+			for {
+				ok, err := s.bookStay(b.hotelId, "KNG", nights, 1, capacity)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if !ok {
+					break
+				}
+				successes++
+			}
+			if successes != int64(capacity) {
+				t.Errorf("%d stays booked in all, want %d", successes, capacity)
+			}
+			b.checkCached(t, nights, capacity)
+		})
+	}
+}
+
+func TestStoreCountKeepsNewestVersion(t *testing.T) {
+	memc, addr := startFakeMemcached(t)
+	s := &Server{Store: NewMemoryStore(), MemcClient: tune.NewMemCClient(addr)}
+	memc_key := nightKey("1", "KNG", "2015-04-09")
+
+	s.storeCount(inventory{HotelId: "1", RoomType: "KNG", Date: "2015-04-09", Booked: 5, Version: 5})
+	s.storeCount(inventory{HotelId: "1", RoomType: "KNG", Date: "2015-04-09", Booked: 3, Version: 3})
+	if got := string(memc.value(memc_key)); got != "5:5" {
+		t.Errorf("cached %q after storing an older count, want 5:5", got)
+	}
+
+	// counts read back from mongo reach memcached in any order
+	versions := rand.Perm(parallelBookings)
+	var wg sync.WaitGroup
+	for _, version := range versions {
+		wg.Add(1)
+		go func(version int) {
+			defer wg.Done()
+			s.storeCount(inventory{HotelId: "1", RoomType: "KNG", Date: "2015-04-09", Booked: version, Version: version})
+		}(version + 10)
+	}
+	wg.Wait()
+
+	// a writer that keeps losing the CAS race drops the entry instead
+	want := string(encodeCount(parallelBookings+9, parallelBookings+9))
+	if got := memc.value(memc_key); got != nil && string(got) != want {
+		t.Errorf("cached %q, want the newest count %s or none", got, want)
+	}
+}
@@ -0,0 +1,122 @@
+package reservation
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// fakeMemcached serves the get, gets, set, add, cas and delete commands of
+// the memcached text protocol from a map, enough for the memcache client.
+// Every write gives the item a new CAS id like memcached does.
+type fakeMemcached struct {
+	mu    sync.Mutex
+	items map[string]fakeItem
+	casId uint64
+}
+
+type fakeItem struct {
+	value []byte
+	casId uint64
+}
+
+// startFakeMemcached serves a fakeMemcached until the test ends and returns
+// its address
+func startFakeMemcached(t *testing.T) (*fakeMemcached, string) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { l.Close() })
+
+	m := &fakeMemcached{items: make(map[string]fakeItem)}
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			go m.serve(conn)
+		}
+	}()
+	return m, l.Addr().String()
+}
+
+func (m *fakeMemcached) serve(conn net.Conn) {
+	defer conn.Close()
+	rw := bufio.NewReadWriter(bufio.NewReader(conn), bufio.NewWriter(conn))
+	for {
+		line, err := rw.ReadString('\n')
+		if err != nil {
+			return
+		}
+		args := strings.Fields(line)
+		if len(args) < 2 {
+			return
+		}
+
+		var value []byte
+		switch args[0] {
+		case "set", "add", "cas":
+			size, err := strconv.Atoi(args[4])
+			if err != nil {
+				return
+			}
+			value = make([]byte, size+2)
+			if _, err := io.ReadFull(rw, value); err != nil {
+				return
+			}
+			value = value[:size]
+		}
+
+		m.mu.Lock()
+		switch args[0] {
+		case "get", "gets":
+			for _, key := range args[1:] {
+				if item, ok := m.items[key]; ok {
+					fmt.Fprintf(rw, "VALUE %s 0 %d %d\r\n%s\r\n", key, len(item.value), item.casId, item.value)
+				}
+			}
+			rw.WriteString("END\r\n")
+		case "set", "add", "cas":
+			item, ok := m.items[args[1]]
+			switch {
+			case args[0] == "add" && ok:
+				rw.WriteString("NOT_STORED\r\n")
+			case args[0] == "cas" && !ok:
+				rw.WriteString("NOT_FOUND\r\n")
+			case args[0] == "cas" && args[5] != strconv.FormatUint(item.casId, 10):
+				rw.WriteString("EXISTS\r\n")
+			default:
+				m.casId++
+				m.items[args[1]] = fakeItem{value: value, casId: m.casId}
+				rw.WriteString("STORED\r\n")
+			}
+		case "delete":
+			if _, ok := m.items[args[1]]; ok {
+				delete(m.items, args[1])
+				rw.WriteString("DELETED\r\n")
+			} else {
+				rw.WriteString("NOT_FOUND\r\n")
+			}
+		default:
+			rw.WriteString("ERROR\r\n")
+		}
+		m.mu.Unlock()
+		if err := rw.Flush(); err != nil {
+			return
+		}
+	}
+}
+
+// value returns the value stored under key, or nil
+func (m *fakeMemcached) value(key string) []byte {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.items[key].value
+}
@@ -0,0 +1,304 @@
+package reservation
+
+import (
+	"encoding/json"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/harlow/go-micro-services/data"
+	pb "github.com/harlow/go-micro-services/services/reservation/proto"
+)
+
+// memoryStore keeps everything in maps behind a single lock, which makes
+// every method trivially atomic.
+type memoryStore struct {
+	mu           sync.Mutex
+	capacity     map[string]map[string]int
+	inventory    map[string]*inventory
+	reservations map[string]*reservation
+	holds        map[string]*hold
+	records      map[idempotencyKey]*idempotencyRecord
+}
+
+// NewMemoryStore returns a ReservationStore kept in memory with the room
+// capacities of data/inventory.json
+func NewMemoryStore() ReservationStore {
+	var capacities []struct {
+		HotelId  string `json:"hotelId"`
+		RoomType struct {
+			Code   string `json:"code"`
+			Number int    `json:"numberOfRoom"`
+		} `json:"roomType"`
+	}
+	if err := json.Unmarshal(data.MustAsset("data/inventory.json"), &capacities); err != nil {
+		panic(err)
+	}
+
+	m := &memoryStore{
+		capacity:     make(map[string]map[string]int),
+		inventory:    make(map[string]*inventory),
+		reservations: make(map[string]*reservation),
+		holds:        make(map[string]*hold),
+		records:      make(map[idempotencyKey]*idempotencyRecord),
+	}
+	for _, c := range capacities {
+		if m.capacity[c.HotelId] == nil {
+			m.capacity[c.HotelId] = make(map[string]int)
+		}
+		m.capacity[c.HotelId][c.RoomType.Code] = c.RoomType.Number
+	}
+	return m
+}
+
+func (m *memoryStore) Capacity(hotelId string) (map[string]int, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	hotel_cap := make(map[string]int)
+	for code, rooms := range m.capacity[hotelId] {
+		hotel_cap[code] = rooms
+	}
+	return hotel_cap, nil
+}
+
+func (m *memoryStore) Capacities(hotelIds []string) (map[string]map[string]int, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	capacities := make(map[string]map[string]int)
+	for _, hotelId := range hotelIds {
+		if _, ok := m.capacity[hotelId]; !ok {
+			continue
+		}
+		hotel_cap := make(map[string]int)
+		for code, rooms := range m.capacity[hotelId] {
+			hotel_cap[code] = rooms
+		}
+		capacities[hotelId] = hotel_cap
+	}
+	return capacities, nil
+}
+
+func (m *memoryStore) Nights(hotelIds []string, fromDate, toDate string) ([]inventory, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	hotels := make(map[string]bool)
+	for _, hotelId := range hotelIds {
+		hotels[hotelId] = true
+	}
+
+	invs := make([]inventory, 0)
+	for _, inv := range m.inventory {
+		if hotels[inv.HotelId] && inv.Date >= fromDate && inv.Date <= toDate {
+			invs = append(invs, *inv)
+		}
+	}
+	return invs, nil
+}
+
+func (m *memoryStore) BookNight(hotelId, roomType, date string, rooms, capacity int) (inventory, bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	key := nightKey(hotelId, roomType, date)
+	inv, ok := m.inventory[key]
+	if !ok {
+		inv = &inventory{HotelId: hotelId, RoomType: roomType, Date: date}
+		m.inventory[key] = inv
+	}
+	if inv.Booked+rooms > capacity {
+		return *inv, false, nil
+	}
+	inv.Booked += rooms
+	inv.Version++
+	return *inv, true, nil
+}
+
+func (m *memoryStore) ReleaseNight(hotelId, roomType, date string, rooms int) (inventory, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	key := nightKey(hotelId, roomType, date)
+	inv, ok := m.inventory[key]
+	if !ok {
+		inv = &inventory{HotelId: hotelId, RoomType: roomType, Date: date}
+		m.inventory[key] = inv
+	}
+	inv.Booked -= rooms
+	inv.Version++
+	return *inv, nil
+}
+
+func (m *memoryStore) InsertReservation(r *reservation) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	cp := *r
+	m.reservations[r.ReservationId] = &cp
+	return nil
+}
+
+func (m *memoryStore) FindReservation(f reservationFilter) (*reservation, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if r := m.findReservation(f); r != nil {
+		cp := *r
+		return &cp, nil
+	}
+	return nil, nil
+}
+
+func (m *memoryStore) TakeReservation(f reservationFilter) (*reservation, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	r := m.findReservation(f)
+	if r != nil {
+		delete(m.reservations, r.ReservationId)
+	}
+	return r, nil
+}
+
+func (m *memoryStore) findReservation(f reservationFilter) *reservation {
+	if f.ReservationId != "" {
+		if r, ok := m.reservations[f.ReservationId]; ok && f.matches(r) {
+			return r
+		}
+		return nil
+	}
+	for _, r := range m.reservations {
+		if f.matches(r) {
+			return r
+		}
+	}
+	return nil
+}
+
+func (m *memoryStore) UpdateReservation(old, updated *reservation) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	r, ok := m.reservations[old.ReservationId]
+	if !ok || r.RoomType != old.RoomType || r.InDate != old.InDate || r.OutDate != old.OutDate || r.Number != old.Number {
+		return false, nil
+	}
+	r.RoomType = updated.RoomType
+	r.InDate = updated.InDate
+	r.OutDate = updated.OutDate
+	r.Number = updated.Number
+	return true, nil
+}
+
+func (m *memoryStore) ListReservations(customerName string) ([]reservation, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	reserve := make([]reservation, 0)
+	for _, r := range m.reservations {
+		if r.CustomerName == customerName {
+			reserve = append(reserve, *r)
+		}
+	}
+	sort.Slice(reserve, func(i, j int) bool {
+		return reserve[i].InDate < reserve[j].InDate
+	})
+	return reserve, nil
+}
+
+func (m *memoryStore) InsertHold(h *hold) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	cp := *h
+	m.holds[h.HoldId] = &cp
+	return nil
+}
+
+func (m *memoryStore) TakeHold(f holdFilter) (*hold, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for id, h := range m.holds {
+		if f.matches(h) {
+			delete(m.holds, id)
+			return h, nil
+		}
+	}
+	return nil, nil
+}
+
+func (m *memoryStore) InsertRecord(rec *idempotencyRecord) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.findRecord(rec.key()) != nil {
+		return false, nil
+	}
+	cp := *rec
+	m.records[rec.key()] = &cp
+	return true, nil
+}
+
+func (m *memoryStore) FindRecord(key idempotencyKey) (*idempotencyRecord, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if rec := m.findRecord(key); rec != nil {
+		cp := *rec
+		return &cp, nil
+	}
+	return nil, nil
+}
+
+// findRecord returns the record of a key. Records are dropped once they
+// are past idempotencyRetention, like the TTL index does in mongo.
+func (m *memoryStore) findRecord(key idempotencyKey) *idempotencyRecord {
+	rec, ok := m.records[key]
+	if !ok {
+		return nil
+	}
+	if time.Since(rec.CreatedAt) > idempotencyRetention {
+		delete(m.records, key)
+		return nil
+	}
+	return rec
+}
+
+func (m *memoryStore) RenewRecord(old, rec *idempotencyRecord) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	cur, ok := m.records[old.key()]
+	if !ok || cur.Done != old.Done || !cur.CreatedAt.Equal(old.CreatedAt) {
+		return false, nil
+	}
+	cp := *rec
+	m.records[old.key()] = &cp
+	return true, nil
+}
+
+func (m *memoryStore) CompleteRecord(key idempotencyKey, res *pb.Result) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if rec, ok := m.records[key]; ok {
+		rec.Done = true
+		rec.HotelId = append([]string(nil), res.HotelId...)
+		rec.ReservationId = res.ReservationId
+		rec.RoomType = res.RoomType
+	}
+	return nil
+}
+
+func (m *memoryStore) RemoveRecord(key idempotencyKey) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if rec, ok := m.records[key]; ok && !rec.Done {
+		delete(m.records, key)
+	}
+	return nil
+}
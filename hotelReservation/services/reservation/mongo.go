@@ -0,0 +1,333 @@
+package reservation
+
+import (
+	pb "github.com/harlow/go-micro-services/services/reservation/proto"
+	"gopkg.in/mgo.v2"
+	"gopkg.in/mgo.v2/bson"
+)
+
+type mongoStore struct {
+	session *mgo.Session
+}
+
+// NewMongoStore returns a ReservationStore backed by the reservation-db
+// database
+func NewMongoStore(session *mgo.Session) ReservationStore {
+	return &mongoStore{session: session}
+}
+
+// withRoomType matches the room types of capacities, leaving out those of
+// whole hotels that predate room types
+var withRoomType = bson.M{"$gt": ""}
+
+func (m *mongoStore) Capacity(hotelId string) (map[string]int, error) {
+	s := m.session.Copy()
+	defer s.Close()
+
+	nums := make([]number, 0)
+	err := s.DB("reservation-db").C("number").Find(&bson.M{"hotelId": hotelId, "roomType": withRoomType}).All(&nums)
+	if err != nil {
+		return nil, err
+	}
+
+	hotel_cap := make(map[string]int)
+	for _, num := range nums {
+		hotel_cap[num.RoomType] = num.Number
+	}
+	return hotel_cap, nil
+}
+
+func (m *mongoStore) Capacities(hotelIds []string) (map[string]map[string]int, error) {
+	s := m.session.Copy()
+	defer s.Close()
+
+	nums := make([]number, 0)
+	err := s.DB("reservation-db").C("number").Find(&bson.M{"hotelId": bson.M{"$in": hotelIds}, "roomType": withRoomType}).All(&nums)
+	if err != nil {
+		return nil, err
+	}
+
+	capacities := make(map[string]map[string]int)
+	for _, num := range nums {
+		if capacities[num.HotelId] == nil {
+			capacities[num.HotelId] = make(map[string]int)
+		}
+		capacities[num.HotelId][num.RoomType] = num.Number
+	}
+	return capacities, nil
+}
+
+func (m *mongoStore) Nights(hotelIds []string, fromDate, toDate string) ([]inventory, error) {
+	s := m.session.Copy()
+	defer s.Close()
+
+	invs := make([]inventory, 0)
+	err := s.DB("reservation-db").C("inventory").Find(&bson.M{
+		"hotelId": bson.M{"$in": hotelIds},
+		"date":    bson.M{"$gte": fromDate, "$lte": toDate},
+	}).All(&invs)
+	return invs, err
+}
+
+// BookNight checks the capacity and updates the counter in a single $inc,
+// so concurrent bookings of the same night can't overbook it.
+func (m *mongoStore) BookNight(hotelId, roomType, date string, rooms, capacity int) (inventory, bool, error) {
+	s := m.session.Copy()
+	defer s.Close()
+	c := s.DB("reservation-db").C("inventory")
+
+	change := mgo.Change{
+		Update:    bson.M{"$inc": bson.M{"booked": rooms, "version": 1}},
+		ReturnNew: true,
+	}
+	for {
+		var inv inventory
+		_, err := c.Find(&bson.M{
+			"hotelId":  hotelId,
+			"roomType": roomType,
+			"date":     date,
+			"booked":   bson.M{"$lte": capacity - rooms},
+		}).Apply(change, &inv)
+		if err == nil {
+			return inv, true, nil
+		}
+		if err != mgo.ErrNotFound {
+			return inv, false, err
+		}
+
+		// Either the night is full or nobody has booked it yet. Create the
+		// counter if it is missing and retry; a night that already had a
+		// counter is full.
+		info, err := c.Upsert(
+			&bson.M{"hotelId": hotelId, "roomType": roomType, "date": date},
+			&bson.M{"$setOnInsert": bson.M{"booked": 0, "version": 0}})
+		if err != nil && !mgo.IsDup(err) {
+			return inv, false, err
+		}
+		if err == nil && info.UpsertedId == nil {
+			return inv, false, nil
+		}
+	}
+}
+
+func (m *mongoStore) ReleaseNight(hotelId, roomType, date string, rooms int) (inventory, error) {
+	s := m.session.Copy()
+	defer s.Close()
+
+	var inv inventory
+	_, err := s.DB("reservation-db").C("inventory").Find(&bson.M{"hotelId": hotelId, "roomType": roomType, "date": date}).Apply(mgo.Change{
+		Update:    bson.M{"$inc": bson.M{"booked": -rooms, "version": 1}},
+		ReturnNew: true,
+	}, &inv)
+	return inv, err
+}
+
+func (m *mongoStore) InsertReservation(r *reservation) error {
+	s := m.session.Copy()
+	defer s.Close()
+
+	return s.DB("reservation-db").C("reservation").Insert(r)
+}
+
+func (m *mongoStore) FindReservation(f reservationFilter) (*reservation, error) {
+	s := m.session.Copy()
+	defer s.Close()
+
+	var r reservation
+	err := s.DB("reservation-db").C("reservation").Find(reservationSelector(f)).One(&r)
+	if err == mgo.ErrNotFound {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+func (m *mongoStore) TakeReservation(f reservationFilter) (*reservation, error) {
+	s := m.session.Copy()
+	defer s.Close()
+
+	var r reservation
+	_, err := s.DB("reservation-db").C("reservation").Find(reservationSelector(f)).Apply(mgo.Change{Remove: true}, &r)
+	if err == mgo.ErrNotFound {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+func (m *mongoStore) UpdateReservation(old, updated *reservation) (bool, error) {
+	s := m.session.Copy()
+	defer s.Close()
+
+	err := s.DB("reservation-db").C("reservation").Update(&bson.M{
+		"reservationId": old.ReservationId,
+		"roomType":      old.RoomType,
+		"inDate":        old.InDate,
+		"outDate":       old.OutDate,
+		"number":        old.Number,
+	}, &bson.M{"$set": bson.M{
+		"roomType": updated.RoomType,
+		"inDate":   updated.InDate,
+		"outDate":  updated.OutDate,
+		"number":   updated.Number,
+	}})
+	if err == mgo.ErrNotFound {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (m *mongoStore) ListReservations(customerName string) ([]reservation, error) {
+	s := m.session.Copy()
+	defer s.Close()
+
+	reserve := make([]reservation, 0)
+	err := s.DB("reservation-db").C("reservation").Find(&bson.M{"customerName": customerName}).Sort("inDate").All(&reserve)
+	return reserve, err
+}
+
+func (m *mongoStore) InsertHold(h *hold) error {
+	s := m.session.Copy()
+	defer s.Close()
+
+	return s.DB("reservation-db").C("hold").Insert(h)
+}
+
+// TakeHold removes the hold with findAndModify, so a hold is only ever
+// taken once even if replicas reap it concurrently.
+func (m *mongoStore) TakeHold(f holdFilter) (*hold, error) {
+	s := m.session.Copy()
+	defer s.Close()
+
+	var h hold
+	_, err := s.DB("reservation-db").C("hold").Find(holdSelector(f)).Apply(mgo.Change{Remove: true}, &h)
+	if err == mgo.ErrNotFound {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	return &h, nil
+}
+
+func (m *mongoStore) InsertRecord(rec *idempotencyRecord) (bool, error) {
+	s := m.session.Copy()
+	defer s.Close()
+
+	err := s.DB("reservation-db").C("idempotency").Insert(rec)
+	if mgo.IsDup(err) {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (m *mongoStore) FindRecord(key idempotencyKey) (*idempotencyRecord, error) {
+	s := m.session.Copy()
+	defer s.Close()
+
+	var rec idempotencyRecord
+	err := s.DB("reservation-db").C("idempotency").Find(keySelector(key)).One(&rec)
+	if err == mgo.ErrNotFound {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+	return &rec, nil
+}
+
+func (m *mongoStore) RenewRecord(old, rec *idempotencyRecord) (bool, error) {
+	s := m.session.Copy()
+	defer s.Close()
+
+	selector := keySelector(old.key())
+	selector["done"] = old.Done
+	selector["createdAt"] = old.CreatedAt
+	err := s.DB("reservation-db").C("idempotency").Update(selector, rec)
+	if err == mgo.ErrNotFound {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (m *mongoStore) CompleteRecord(key idempotencyKey, res *pb.Result) error {
+	s := m.session.Copy()
+	defer s.Close()
+
+	return s.DB("reservation-db").C("idempotency").Update(
+		keySelector(key),
+		&bson.M{"$set": bson.M{
+			"done":          true,
+			"hotelId":       res.HotelId,
+			"reservationId": res.ReservationId,
+			"roomType":      res.RoomType,
+		}})
+}
+
+func (m *mongoStore) RemoveRecord(key idempotencyKey) error {
+	s := m.session.Copy()
+	defer s.Close()
+
+	selector := keySelector(key)
+	selector["done"] = false
+	err := s.DB("reservation-db").C("idempotency").Remove(selector)
+	if err == mgo.ErrNotFound {
+		return nil
+	}
+	return err
+}
+
+// keySelector is the mongo query of the record of an idempotency key
+func keySelector(key idempotencyKey) bson.M {
+	return bson.M{"customerName": key.CustomerName, "key": key.Key}
+}
+
+// reservationSelector is the mongo query of a reservationFilter
+func reservationSelector(f reservationFilter) *bson.M {
+	if f.ReservationId != "" {
+		if f.CustomerName != "" {
+			return &bson.M{"reservationId": f.ReservationId, "customerName": f.CustomerName}
+		}
+		return &bson.M{"reservationId": f.ReservationId}
+	}
+	selector := bson.M{
+		"hotelId":      f.HotelId,
+		"customerName": f.CustomerName,
+		"inDate":       f.InDate,
+		"outDate":      f.OutDate,
+		"number":       f.Number,
+	}
+	if f.RoomType != "" {
+		selector["roomType"] = f.RoomType
+	}
+	return &selector
+}
+
+// holdSelector is the mongo query of a holdFilter
+func holdSelector(f holdFilter) bson.M {
+	selector := bson.M{}
+	if !f.Any {
+		// an empty HoldId matches no hold, holds all have one
+		selector["holdId"] = f.HoldId
+	}
+	if f.CustomerName != "" {
+		selector["customerName"] = f.CustomerName
+	}
+	expiresAt := bson.M{}
+	if !f.LiveAt.IsZero() {
+		expiresAt["$gt"] = f.LiveAt
+	}
+	if !f.ExpiredAt.IsZero() {
+		expiresAt["$lte"] = f.ExpiredAt
+	}
+	if len(expiresAt) > 0 {
+		selector["expiresAt"] = expiresAt
+	}
+	return selector
+}
@@ -23,11 +23,22 @@ var _ = math.Inf
 const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
 
 type Request struct {
-	CustomerName         string   `protobuf:"bytes,1,opt,name=customerName,proto3" json:"customerName,omitempty"`
-	HotelId              []string `protobuf:"bytes,2,rep,name=hotelId,proto3" json:"hotelId,omitempty"`
-	InDate               string   `protobuf:"bytes,3,opt,name=inDate,proto3" json:"inDate,omitempty"`
-	OutDate              string   `protobuf:"bytes,4,opt,name=outDate,proto3" json:"outDate,omitempty"`
-	RoomNumber           int32    `protobuf:"varint,5,opt,name=roomNumber,proto3" json:"roomNumber,omitempty"`
+	CustomerName string   `protobuf:"bytes,1,opt,name=customerName,proto3" json:"customerName,omitempty"`
+	HotelId      []string `protobuf:"bytes,2,rep,name=hotelId,proto3" json:"hotelId,omitempty"`
+	InDate       string   `protobuf:"bytes,3,opt,name=inDate,proto3" json:"inDate,omitempty"`
+	OutDate      string   `protobuf:"bytes,4,opt,name=outDate,proto3" json:"outDate,omitempty"`
+	RoomNumber   int32    `protobuf:"varint,5,opt,name=roomNumber,proto3" json:"roomNumber,omitempty"`
+	// reservationId identifies an existing reservation when cancelling or
+	// modifying, only customerName is matched along with it
+	ReservationId string `protobuf:"bytes,6,opt,name=reservationId,proto3" json:"reservationId,omitempty"`
+	// roomType is the room type code, such as KNG or QN. If empty any room
+	// type of the hotel is used
+	RoomType string `protobuf:"bytes,7,opt,name=roomType,proto3" json:"roomType,omitempty"`
+	// idempotencyKey identifies a reservation of the customer across
+	// retries, a repeated key returns the result of the first request
+	// instead of booking again. Reusing a key for a different reservation is
+	// an invalid argument.
+	IdempotencyKey       string   `protobuf:"bytes,8,opt,name=idempotencyKey,proto3" json:"idempotencyKey,omitempty"`
 }
 
 func (m *Request) Reset()                    { *m = Request{} }
@@ -70,14 +81,92 @@ func (m *Request) GetRoomNumber() int32 {
 	return 0
 }
 
+func (m *Request) GetReservationId() string {
+	if m != nil {
+		return m.ReservationId
+	}
+	return ""
+}
+
+func (m *Request) GetRoomType() string {
+	if m != nil {
+		return m.RoomType
+	}
+	return ""
+}
+
+func (m *Request) GetIdempotencyKey() string {
+	if m != nil {
+		return m.IdempotencyKey
+	}
+	return ""
+}
+
+type ModifyRequest struct {
+	// reservation identifies the reservation to modify
+	Reservation *Request `protobuf:"bytes,1,opt,name=reservation,proto3" json:"reservation,omitempty"`
+	// new dates, room number and room type, unset fields are left unchanged
+	InDate               string   `protobuf:"bytes,2,opt,name=inDate,proto3" json:"inDate,omitempty"`
+	OutDate              string   `protobuf:"bytes,3,opt,name=outDate,proto3" json:"outDate,omitempty"`
+	RoomNumber           int32    `protobuf:"varint,4,opt,name=roomNumber,proto3" json:"roomNumber,omitempty"`
+	RoomType             string   `protobuf:"bytes,5,opt,name=roomType,proto3" json:"roomType,omitempty"`
+}
+
+func (m *ModifyRequest) Reset()                    { *m = ModifyRequest{} }
+func (m *ModifyRequest) String() string            { return proto.CompactTextString(m) }
+func (*ModifyRequest) ProtoMessage()               {}
+func (*ModifyRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{1} }
+
+func (m *ModifyRequest) GetReservation() *Request {
+	if m != nil {
+		return m.Reservation
+	}
+	return nil
+}
+
+func (m *ModifyRequest) GetInDate() string {
+	if m != nil {
+		return m.InDate
+	}
+	return ""
+}
+
+func (m *ModifyRequest) GetOutDate() string {
+	if m != nil {
+		return m.OutDate
+	}
+	return ""
+}
+
+func (m *ModifyRequest) GetRoomNumber() int32 {
+	if m != nil {
+		return m.RoomNumber
+	}
+	return 0
+}
+
+func (m *ModifyRequest) GetRoomType() string {
+	if m != nil {
+		return m.RoomType
+	}
+	return ""
+}
+
 type Result struct {
-	HotelId              []string `protobuf:"bytes,1,rep,name=hotelId,proto3" json:"hotelId,omitempty"`
+	HotelId []string `protobuf:"bytes,1,rep,name=hotelId,proto3" json:"hotelId,omitempty"`
+	// reservationId is the confirmation id of the reservation
+	ReservationId string `protobuf:"bytes,2,opt,name=reservationId,proto3" json:"reservationId,omitempty"`
+	// roomType is the room type that was booked
+	RoomType string `protobuf:"bytes,3,opt,name=roomType,proto3" json:"roomType,omitempty"`
+	// availability holds the free room types of each of hotelId, set by
+	// CheckAvailability
+	Availability         []*HotelAvailability `protobuf:"bytes,4,rep,name=availability,proto3" json:"availability,omitempty"`
 }
 
 func (m *Result) Reset()                    { *m = Result{} }
 func (m *Result) String() string            { return proto.CompactTextString(m) }
 func (*Result) ProtoMessage()               {}
-func (*Result) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{1} }
+func (*Result) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{2} }
 
 func (m *Result) GetHotelId() []string {
 	if m != nil {
@@ -86,9 +175,397 @@ func (m *Result) GetHotelId() []string {
 	return nil
 }
 
+func (m *Result) GetReservationId() string {
+	if m != nil {
+		return m.ReservationId
+	}
+	return ""
+}
+
+func (m *Result) GetRoomType() string {
+	if m != nil {
+		return m.RoomType
+	}
+	return ""
+}
+
+func (m *Result) GetAvailability() []*HotelAvailability {
+	if m != nil {
+		return m.Availability
+	}
+	return nil
+}
+
+type HotelAvailability struct {
+	HotelId              string   `protobuf:"bytes,1,opt,name=hotelId,proto3" json:"hotelId,omitempty"`
+	RoomTypes            []string `protobuf:"bytes,2,rep,name=roomTypes,proto3" json:"roomTypes,omitempty"`
+}
+
+func (m *HotelAvailability) Reset()                    { *m = HotelAvailability{} }
+func (m *HotelAvailability) String() string            { return proto.CompactTextString(m) }
+func (*HotelAvailability) ProtoMessage()               {}
+func (*HotelAvailability) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{3} }
+
+func (m *HotelAvailability) GetHotelId() string {
+	if m != nil {
+		return m.HotelId
+	}
+	return ""
+}
+
+func (m *HotelAvailability) GetRoomTypes() []string {
+	if m != nil {
+		return m.RoomTypes
+	}
+	return nil
+}
+
+type GetRequest struct {
+	ReservationId        string   `protobuf:"bytes,1,opt,name=reservationId,proto3" json:"reservationId,omitempty"`
+}
+
+func (m *GetRequest) Reset()                    { *m = GetRequest{} }
+func (m *GetRequest) String() string            { return proto.CompactTextString(m) }
+func (*GetRequest) ProtoMessage()               {}
+func (*GetRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{4} }
+
+func (m *GetRequest) GetReservationId() string {
+	if m != nil {
+		return m.ReservationId
+	}
+	return ""
+}
+
+type CustomerRequest struct {
+	CustomerName         string   `protobuf:"bytes,1,opt,name=customerName,proto3" json:"customerName,omitempty"`
+}
+
+func (m *CustomerRequest) Reset()                    { *m = CustomerRequest{} }
+func (m *CustomerRequest) String() string            { return proto.CompactTextString(m) }
+func (*CustomerRequest) ProtoMessage()               {}
+func (*CustomerRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{5} }
+
+func (m *CustomerRequest) GetCustomerName() string {
+	if m != nil {
+		return m.CustomerName
+	}
+	return ""
+}
+
+type ReservationInfo struct {
+	ReservationId        string   `protobuf:"bytes,1,opt,name=reservationId,proto3" json:"reservationId,omitempty"`
+	CustomerName         string   `protobuf:"bytes,2,opt,name=customerName,proto3" json:"customerName,omitempty"`
+	HotelId              string   `protobuf:"bytes,3,opt,name=hotelId,proto3" json:"hotelId,omitempty"`
+	InDate               string   `protobuf:"bytes,4,opt,name=inDate,proto3" json:"inDate,omitempty"`
+	OutDate              string   `protobuf:"bytes,5,opt,name=outDate,proto3" json:"outDate,omitempty"`
+	RoomNumber           int32    `protobuf:"varint,6,opt,name=roomNumber,proto3" json:"roomNumber,omitempty"`
+	RoomType             string   `protobuf:"bytes,7,opt,name=roomType,proto3" json:"roomType,omitempty"`
+}
+
+func (m *ReservationInfo) Reset()                    { *m = ReservationInfo{} }
+func (m *ReservationInfo) String() string            { return proto.CompactTextString(m) }
+func (*ReservationInfo) ProtoMessage()               {}
+func (*ReservationInfo) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{6} }
+
+func (m *ReservationInfo) GetReservationId() string {
+	if m != nil {
+		return m.ReservationId
+	}
+	return ""
+}
+
+func (m *ReservationInfo) GetCustomerName() string {
+	if m != nil {
+		return m.CustomerName
+	}
+	return ""
+}
+
+func (m *ReservationInfo) GetHotelId() string {
+	if m != nil {
+		return m.HotelId
+	}
+	return ""
+}
+
+func (m *ReservationInfo) GetInDate() string {
+	if m != nil {
+		return m.InDate
+	}
+	return ""
+}
+
+func (m *ReservationInfo) GetOutDate() string {
+	if m != nil {
+		return m.OutDate
+	}
+	return ""
+}
+
+func (m *ReservationInfo) GetRoomNumber() int32 {
+	if m != nil {
+		return m.RoomNumber
+	}
+	return 0
+}
+
+func (m *ReservationInfo) GetRoomType() string {
+	if m != nil {
+		return m.RoomType
+	}
+	return ""
+}
+
+type ReservationList struct {
+	Reservations         []*ReservationInfo `protobuf:"bytes,1,rep,name=reservations,proto3" json:"reservations,omitempty"`
+}
+
+func (m *ReservationList) Reset()                    { *m = ReservationList{} }
+func (m *ReservationList) String() string            { return proto.CompactTextString(m) }
+func (*ReservationList) ProtoMessage()               {}
+func (*ReservationList) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{7} }
+
+func (m *ReservationList) GetReservations() []*ReservationInfo {
+	if m != nil {
+		return m.Reservations
+	}
+	return nil
+}
+
+type HoldRequest struct {
+	HoldId string `protobuf:"bytes,1,opt,name=holdId,proto3" json:"holdId,omitempty"`
+	// customerName, if given, has to match the customer of the hold
+	CustomerName         string   `protobuf:"bytes,2,opt,name=customerName,proto3" json:"customerName,omitempty"`
+}
+
+func (m *HoldRequest) Reset()                    { *m = HoldRequest{} }
+func (m *HoldRequest) String() string            { return proto.CompactTextString(m) }
+func (*HoldRequest) ProtoMessage()               {}
+func (*HoldRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{8} }
+
+func (m *HoldRequest) GetHoldId() string {
+	if m != nil {
+		return m.HoldId
+	}
+	return ""
+}
+
+func (m *HoldRequest) GetCustomerName() string {
+	if m != nil {
+		return m.CustomerName
+	}
+	return ""
+}
+
+type HoldResult struct {
+	// holdId is the hold token, empty if no rooms could be held
+	HoldId   string `protobuf:"bytes,1,opt,name=holdId,proto3" json:"holdId,omitempty"`
+	HotelId  string `protobuf:"bytes,2,opt,name=hotelId,proto3" json:"hotelId,omitempty"`
+	RoomType string `protobuf:"bytes,3,opt,name=roomType,proto3" json:"roomType,omitempty"`
+	// expiresAt is the unix time in seconds after which the hold is released
+	ExpiresAt            int64    `protobuf:"varint,4,opt,name=expiresAt,proto3" json:"expiresAt,omitempty"`
+}
+
+func (m *HoldResult) Reset()                    { *m = HoldResult{} }
+func (m *HoldResult) String() string            { return proto.CompactTextString(m) }
+func (*HoldResult) ProtoMessage()               {}
+func (*HoldResult) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{9} }
+
+func (m *HoldResult) GetHoldId() string {
+	if m != nil {
+		return m.HoldId
+	}
+	return ""
+}
+
+func (m *HoldResult) GetHotelId() string {
+	if m != nil {
+		return m.HotelId
+	}
+	return ""
+}
+
+func (m *HoldResult) GetRoomType() string {
+	if m != nil {
+		return m.RoomType
+	}
+	return ""
+}
+
+func (m *HoldResult) GetExpiresAt() int64 {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return 0
+}
+
+type CalendarRequest struct {
+	// hotelId lists up to 50 hotels
+	HotelId []string `protobuf:"bytes,1,rep,name=hotelId,proto3" json:"hotelId,omitempty"`
+	// the calendar covers the nights from inDate up to outDate, up to 366
+	InDate  string `protobuf:"bytes,2,opt,name=inDate,proto3" json:"inDate,omitempty"`
+	OutDate string `protobuf:"bytes,3,opt,name=outDate,proto3" json:"outDate,omitempty"`
+	// roomType restricts the calendar to one room type, if given
+	RoomType             string   `protobuf:"bytes,4,opt,name=roomType,proto3" json:"roomType,omitempty"`
+}
+
+func (m *CalendarRequest) Reset()                    { *m = CalendarRequest{} }
+func (m *CalendarRequest) String() string            { return proto.CompactTextString(m) }
+func (*CalendarRequest) ProtoMessage()               {}
+func (*CalendarRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{10} }
+
+func (m *CalendarRequest) GetHotelId() []string {
+	if m != nil {
+		return m.HotelId
+	}
+	return nil
+}
+
+func (m *CalendarRequest) GetInDate() string {
+	if m != nil {
+		return m.InDate
+	}
+	return ""
+}
+
+func (m *CalendarRequest) GetOutDate() string {
+	if m != nil {
+		return m.OutDate
+	}
+	return ""
+}
+
+func (m *CalendarRequest) GetRoomType() string {
+	if m != nil {
+		return m.RoomType
+	}
+	return ""
+}
+
+type Calendar struct {
+	// hotels are in the order of the request
+	Hotels               []*HotelCalendar `protobuf:"bytes,1,rep,name=hotels,proto3" json:"hotels,omitempty"`
+}
+
+func (m *Calendar) Reset()                    { *m = Calendar{} }
+func (m *Calendar) String() string            { return proto.CompactTextString(m) }
+func (*Calendar) ProtoMessage()               {}
+func (*Calendar) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{11} }
+
+func (m *Calendar) GetHotels() []*HotelCalendar {
+	if m != nil {
+		return m.Hotels
+	}
+	return nil
+}
+
+type HotelCalendar struct {
+	HotelId string `protobuf:"bytes,1,opt,name=hotelId,proto3" json:"hotelId,omitempty"`
+	// nights are in date order
+	Nights               []*NightAvailability `protobuf:"bytes,2,rep,name=nights,proto3" json:"nights,omitempty"`
+}
+
+func (m *HotelCalendar) Reset()                    { *m = HotelCalendar{} }
+func (m *HotelCalendar) String() string            { return proto.CompactTextString(m) }
+func (*HotelCalendar) ProtoMessage()               {}
+func (*HotelCalendar) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{12} }
+
+func (m *HotelCalendar) GetHotelId() string {
+	if m != nil {
+		return m.HotelId
+	}
+	return ""
+}
+
+func (m *HotelCalendar) GetNights() []*NightAvailability {
+	if m != nil {
+		return m.Nights
+	}
+	return nil
+}
+
+type NightAvailability struct {
+	Date string `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	// roomsLeft is the number of rooms left over all room types
+	RoomsLeft            int32                   `protobuf:"varint,2,opt,name=roomsLeft,proto3" json:"roomsLeft,omitempty"`
+	RoomTypes            []*RoomTypeAvailability `protobuf:"bytes,3,rep,name=roomTypes,proto3" json:"roomTypes,omitempty"`
+}
+
+func (m *NightAvailability) Reset()                    { *m = NightAvailability{} }
+func (m *NightAvailability) String() string            { return proto.CompactTextString(m) }
+func (*NightAvailability) ProtoMessage()               {}
+func (*NightAvailability) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{13} }
+
+func (m *NightAvailability) GetDate() string {
+	if m != nil {
+		return m.Date
+	}
+	return ""
+}
+
+func (m *NightAvailability) GetRoomsLeft() int32 {
+	if m != nil {
+		return m.RoomsLeft
+	}
+	return 0
+}
+
+func (m *NightAvailability) GetRoomTypes() []*RoomTypeAvailability {
+	if m != nil {
+		return m.RoomTypes
+	}
+	return nil
+}
+
+type RoomTypeAvailability struct {
+	RoomType  string `protobuf:"bytes,1,opt,name=roomType,proto3" json:"roomType,omitempty"`
+	RoomsLeft int32  `protobuf:"varint,2,opt,name=roomsLeft,proto3" json:"roomsLeft,omitempty"`
+	// number of rooms of the room type the hotel has
+	Capacity             int32    `protobuf:"varint,3,opt,name=capacity,proto3" json:"capacity,omitempty"`
+}
+
+func (m *RoomTypeAvailability) Reset()                    { *m = RoomTypeAvailability{} }
+func (m *RoomTypeAvailability) String() string            { return proto.CompactTextString(m) }
+func (*RoomTypeAvailability) ProtoMessage()               {}
+func (*RoomTypeAvailability) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{14} }
+
+func (m *RoomTypeAvailability) GetRoomType() string {
+	if m != nil {
+		return m.RoomType
+	}
+	return ""
+}
+
+func (m *RoomTypeAvailability) GetRoomsLeft() int32 {
+	if m != nil {
+		return m.RoomsLeft
+	}
+	return 0
+}
+
+func (m *RoomTypeAvailability) GetCapacity() int32 {
+	if m != nil {
+		return m.Capacity
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Request)(nil), "reservation.Request")
+	proto.RegisterType((*ModifyRequest)(nil), "reservation.ModifyRequest")
 	proto.RegisterType((*Result)(nil), "reservation.Result")
+	proto.RegisterType((*HotelAvailability)(nil), "reservation.HotelAvailability")
+	proto.RegisterType((*GetRequest)(nil), "reservation.GetRequest")
+	proto.RegisterType((*CustomerRequest)(nil), "reservation.CustomerRequest")
+	proto.RegisterType((*ReservationInfo)(nil), "reservation.ReservationInfo")
+	proto.RegisterType((*ReservationList)(nil), "reservation.ReservationList")
+	proto.RegisterType((*HoldRequest)(nil), "reservation.HoldRequest")
+	proto.RegisterType((*HoldResult)(nil), "reservation.HoldResult")
+	proto.RegisterType((*CalendarRequest)(nil), "reservation.CalendarRequest")
+	proto.RegisterType((*Calendar)(nil), "reservation.Calendar")
+	proto.RegisterType((*HotelCalendar)(nil), "reservation.HotelCalendar")
+	proto.RegisterType((*NightAvailability)(nil), "reservation.NightAvailability")
+	proto.RegisterType((*RoomTypeAvailability)(nil), "reservation.RoomTypeAvailability")
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -107,6 +584,22 @@ type ReservationClient interface {
 	MakeReservation(ctx context.Context, in *Request, opts ...grpc.CallOption) (*Result, error)
 	// CheckAvailability checks if given information is available
 	CheckAvailability(ctx context.Context, in *Request, opts ...grpc.CallOption) (*Result, error)
+	// CancelReservation cancels a reservation and releases its rooms
+	CancelReservation(ctx context.Context, in *Request, opts ...grpc.CallOption) (*Result, error)
+	// ModifyReservation moves a reservation to new dates, room number or room type
+	ModifyReservation(ctx context.Context, in *ModifyRequest, opts ...grpc.CallOption) (*Result, error)
+	// GetReservation returns the reservation with the given confirmation id
+	GetReservation(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*ReservationInfo, error)
+	// ListReservationsByCustomer returns all reservations of a customer
+	ListReservationsByCustomer(ctx context.Context, in *CustomerRequest, opts ...grpc.CallOption) (*ReservationList, error)
+	// HoldRooms reserves rooms for a limited time and returns a hold token
+	HoldRooms(ctx context.Context, in *Request, opts ...grpc.CallOption) (*HoldResult, error)
+	// ConfirmHold turns a hold into a reservation
+	ConfirmHold(ctx context.Context, in *HoldRequest, opts ...grpc.CallOption) (*Result, error)
+	// ReleaseHold gives the rooms of a hold back
+	ReleaseHold(ctx context.Context, in *HoldRequest, opts ...grpc.CallOption) (*Result, error)
+	// GetAvailabilityCalendar returns the rooms left per hotel and night
+	GetAvailabilityCalendar(ctx context.Context, in *CalendarRequest, opts ...grpc.CallOption) (*Calendar, error)
 }
 
 type reservationClient struct {
@@ -135,12 +628,100 @@ func (c *reservationClient) CheckAvailability(ctx context.Context, in *Request,
 	return out, nil
 }
 
+func (c *reservationClient) CancelReservation(ctx context.Context, in *Request, opts ...grpc.CallOption) (*Result, error) {
+	out := new(Result)
+	err := c.cc.Invoke(ctx, "/reservation.Reservation/CancelReservation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reservationClient) ModifyReservation(ctx context.Context, in *ModifyRequest, opts ...grpc.CallOption) (*Result, error) {
+	out := new(Result)
+	err := c.cc.Invoke(ctx, "/reservation.Reservation/ModifyReservation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reservationClient) GetReservation(ctx context.Context, in *GetRequest, opts ...grpc.CallOption) (*ReservationInfo, error) {
+	out := new(ReservationInfo)
+	err := c.cc.Invoke(ctx, "/reservation.Reservation/GetReservation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reservationClient) ListReservationsByCustomer(ctx context.Context, in *CustomerRequest, opts ...grpc.CallOption) (*ReservationList, error) {
+	out := new(ReservationList)
+	err := c.cc.Invoke(ctx, "/reservation.Reservation/ListReservationsByCustomer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reservationClient) HoldRooms(ctx context.Context, in *Request, opts ...grpc.CallOption) (*HoldResult, error) {
+	out := new(HoldResult)
+	err := c.cc.Invoke(ctx, "/reservation.Reservation/HoldRooms", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reservationClient) ConfirmHold(ctx context.Context, in *HoldRequest, opts ...grpc.CallOption) (*Result, error) {
+	out := new(Result)
+	err := c.cc.Invoke(ctx, "/reservation.Reservation/ConfirmHold", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reservationClient) ReleaseHold(ctx context.Context, in *HoldRequest, opts ...grpc.CallOption) (*Result, error) {
+	out := new(Result)
+	err := c.cc.Invoke(ctx, "/reservation.Reservation/ReleaseHold", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *reservationClient) GetAvailabilityCalendar(ctx context.Context, in *CalendarRequest, opts ...grpc.CallOption) (*Calendar, error) {
+	out := new(Calendar)
+	err := c.cc.Invoke(ctx, "/reservation.Reservation/GetAvailabilityCalendar", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ReservationServer is the server API for Reservation service.
 type ReservationServer interface {
 	// MakeReservation makes a reservation based on given information
 	MakeReservation(context.Context, *Request) (*Result, error)
 	// CheckAvailability checks if given information is available
 	CheckAvailability(context.Context, *Request) (*Result, error)
+	// CancelReservation cancels a reservation and releases its rooms
+	CancelReservation(context.Context, *Request) (*Result, error)
+	// ModifyReservation moves a reservation to new dates, room number or room type
+	ModifyReservation(context.Context, *ModifyRequest) (*Result, error)
+	// GetReservation returns the reservation with the given confirmation id
+	GetReservation(context.Context, *GetRequest) (*ReservationInfo, error)
+	// ListReservationsByCustomer returns all reservations of a customer
+	ListReservationsByCustomer(context.Context, *CustomerRequest) (*ReservationList, error)
+	// HoldRooms reserves rooms for a limited time and returns a hold token
+	HoldRooms(context.Context, *Request) (*HoldResult, error)
+	// ConfirmHold turns a hold into a reservation
+	ConfirmHold(context.Context, *HoldRequest) (*Result, error)
+	// ReleaseHold gives the rooms of a hold back
+	ReleaseHold(context.Context, *HoldRequest) (*Result, error)
+	// GetAvailabilityCalendar returns the rooms left per hotel and night
+	GetAvailabilityCalendar(context.Context, *CalendarRequest) (*Calendar, error)
 }
 
 func RegisterReservationServer(s *grpc.Server, srv ReservationServer) {
@@ -183,6 +764,150 @@ func _Reservation_CheckAvailability_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Reservation_CancelReservation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReservationServer).CancelReservation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/reservation.Reservation/CancelReservation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReservationServer).CancelReservation(ctx, req.(*Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Reservation_ModifyReservation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModifyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReservationServer).ModifyReservation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/reservation.Reservation/ModifyReservation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReservationServer).ModifyReservation(ctx, req.(*ModifyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Reservation_GetReservation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReservationServer).GetReservation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/reservation.Reservation/GetReservation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReservationServer).GetReservation(ctx, req.(*GetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Reservation_ListReservationsByCustomer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CustomerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReservationServer).ListReservationsByCustomer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/reservation.Reservation/ListReservationsByCustomer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReservationServer).ListReservationsByCustomer(ctx, req.(*CustomerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Reservation_HoldRooms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Request)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReservationServer).HoldRooms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/reservation.Reservation/HoldRooms",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReservationServer).HoldRooms(ctx, req.(*Request))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Reservation_ConfirmHold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HoldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReservationServer).ConfirmHold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/reservation.Reservation/ConfirmHold",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReservationServer).ConfirmHold(ctx, req.(*HoldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Reservation_ReleaseHold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HoldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReservationServer).ReleaseHold(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/reservation.Reservation/ReleaseHold",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReservationServer).ReleaseHold(ctx, req.(*HoldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Reservation_GetAvailabilityCalendar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CalendarRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ReservationServer).GetAvailabilityCalendar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/reservation.Reservation/GetAvailabilityCalendar",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ReservationServer).GetAvailabilityCalendar(ctx, req.(*CalendarRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Reservation_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "reservation.Reservation",
 	HandlerType: (*ReservationServer)(nil),
@@ -195,6 +920,38 @@ var _Reservation_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CheckAvailability",
 			Handler:    _Reservation_CheckAvailability_Handler,
 		},
+		{
+			MethodName: "CancelReservation",
+			Handler:    _Reservation_CancelReservation_Handler,
+		},
+		{
+			MethodName: "ModifyReservation",
+			Handler:    _Reservation_ModifyReservation_Handler,
+		},
+		{
+			MethodName: "GetReservation",
+			Handler:    _Reservation_GetReservation_Handler,
+		},
+		{
+			MethodName: "ListReservationsByCustomer",
+			Handler:    _Reservation_ListReservationsByCustomer_Handler,
+		},
+		{
+			MethodName: "HoldRooms",
+			Handler:    _Reservation_HoldRooms_Handler,
+		},
+		{
+			MethodName: "ConfirmHold",
+			Handler:    _Reservation_ConfirmHold_Handler,
+		},
+		{
+			MethodName: "ReleaseHold",
+			Handler:    _Reservation_ReleaseHold_Handler,
+		},
+		{
+			MethodName: "GetAvailabilityCalendar",
+			Handler:    _Reservation_GetAvailabilityCalendar_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "reservation.proto",
@@ -203,20 +960,53 @@ var _Reservation_serviceDesc = grpc.ServiceDesc{
 func init() { proto.RegisterFile("reservation.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 228 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x90, 0xc1, 0x4a, 0x03, 0x31,
-	0x18, 0x84, 0x89, 0xb5, 0x5b, 0xfa, 0x57, 0x90, 0x46, 0x91, 0xe0, 0x41, 0x96, 0x9c, 0xf6, 0xd4,
-	0x83, 0x5e, 0x45, 0x10, 0xbd, 0x78, 0xb0, 0x87, 0xbc, 0x41, 0xb6, 0xfe, 0xd0, 0xd0, 0xa4, 0xbf,
-	0x26, 0x7f, 0x0a, 0x3e, 0x83, 0x4f, 0xe0, 0xdb, 0x8a, 0xa1, 0xc5, 0xac, 0xb7, 0x1e, 0xe7, 0x1b,
-	0x86, 0x61, 0x06, 0xe6, 0x11, 0x13, 0xc6, 0x9d, 0x65, 0x47, 0xdb, 0xc5, 0x7b, 0x24, 0x26, 0x39,
-	0xab, 0x90, 0xfe, 0x16, 0x30, 0x31, 0xf8, 0x91, 0x31, 0xb1, 0xd4, 0x70, 0xb6, 0xca, 0x89, 0x29,
-	0x60, 0x5c, 0xda, 0x80, 0x4a, 0xb4, 0xa2, 0x9b, 0x9a, 0x01, 0x93, 0x0a, 0x26, 0x6b, 0x62, 0xf4,
-	0x2f, 0x6f, 0xea, 0xa4, 0x1d, 0x75, 0x53, 0x73, 0x90, 0xf2, 0x0a, 0x1a, 0xb7, 0x7d, 0xb6, 0x8c,
-	0x6a, 0x54, 0x72, 0x7b, 0xf5, 0x9b, 0xa0, 0xcc, 0xc5, 0x38, 0x2d, 0xc6, 0x41, 0xca, 0x1b, 0x80,
-	0x48, 0x14, 0x96, 0x39, 0xf4, 0x18, 0xd5, 0xb8, 0x15, 0xdd, 0xd8, 0x54, 0x44, 0x6b, 0x68, 0x0c,
-	0xa6, 0xec, 0xb9, 0x6e, 0x15, 0x83, 0xd6, 0xdb, 0x2f, 0x01, 0x33, 0xf3, 0xb7, 0x47, 0xde, 0xc3,
-	0xf9, 0xab, 0xdd, 0x60, 0x8d, 0x2e, 0x17, 0xf5, 0x07, 0xfb, 0xb1, 0xd7, 0x17, 0xff, 0x68, 0xe9,
-	0x79, 0x80, 0xf9, 0xd3, 0x1a, 0x57, 0x9b, 0xc7, 0x9d, 0x75, 0xde, 0xf6, 0xce, 0x3b, 0xfe, 0x3c,
-	0x22, 0xdf, 0x37, 0xe5, 0xe1, 0xbb, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xed, 0x89, 0xb3, 0x61,
-	0x76, 0x01, 0x00, 0x00,
+	// 766 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0xcd, 0x4e, 0xdb, 0x4a,
+	0x14, 0x96, 0xe3, 0xc4, 0x24, 0xc7, 0x40, 0x94, 0xb9, 0x5c, 0xb0, 0x2c, 0x84, 0x72, 0x47, 0x57,
+	0x57, 0x59, 0xb1, 0xc8, 0x55, 0x59, 0x54, 0x88, 0x16, 0x82, 0x04, 0x08, 0xc8, 0xc2, 0xed, 0xa2,
+	0xdb, 0x21, 0x9e, 0x34, 0x16, 0x8e, 0x27, 0xb5, 0x27, 0x08, 0xaf, 0x2b, 0xf5, 0x59, 0xfa, 0x02,
+	0xdd, 0xf5, 0x6d, 0xfa, 0x22, 0x95, 0x07, 0xff, 0xcc, 0xd8, 0x89, 0x0b, 0xec, 0x32, 0xe7, 0x67,
+	0xe6, 0x9c, 0xef, 0x7c, 0xdf, 0x71, 0xa0, 0x17, 0xd2, 0x88, 0x86, 0x0f, 0x84, 0x7b, 0x2c, 0x38,
+	0x5c, 0x84, 0x8c, 0x33, 0x64, 0x4a, 0x26, 0xfc, 0xb5, 0x01, 0x1b, 0x0e, 0xfd, 0xb2, 0xa4, 0x11,
+	0x47, 0x18, 0x36, 0x27, 0xcb, 0x88, 0xb3, 0x39, 0x0d, 0xc7, 0x64, 0x4e, 0x2d, 0xad, 0xaf, 0x0d,
+	0x3a, 0x8e, 0x62, 0x43, 0x16, 0x6c, 0xcc, 0x18, 0xa7, 0xfe, 0x95, 0x6b, 0x35, 0xfa, 0xfa, 0xa0,
+	0xe3, 0x64, 0x47, 0xb4, 0x0b, 0x86, 0x17, 0x9c, 0x13, 0x4e, 0x2d, 0x5d, 0xe4, 0xa5, 0xa7, 0x24,
+	0x83, 0x2d, 0xb9, 0x70, 0x34, 0x85, 0x23, 0x3b, 0xa2, 0x03, 0x80, 0x90, 0xb1, 0xf9, 0x78, 0x39,
+	0xbf, 0xa3, 0xa1, 0xd5, 0xea, 0x6b, 0x83, 0x96, 0x23, 0x59, 0xd0, 0xbf, 0xb0, 0x25, 0x95, 0x7a,
+	0xe5, 0x5a, 0x86, 0xc8, 0x57, 0x8d, 0xc8, 0x86, 0x76, 0x92, 0xf3, 0x31, 0x5e, 0x50, 0x6b, 0x43,
+	0x04, 0xe4, 0x67, 0xf4, 0x1f, 0x6c, 0x7b, 0x2e, 0x9d, 0x2f, 0x18, 0xa7, 0xc1, 0x24, 0xbe, 0xa6,
+	0xb1, 0xd5, 0x16, 0x11, 0x25, 0x2b, 0xfe, 0xa1, 0xc1, 0xd6, 0x2d, 0x73, 0xbd, 0x69, 0x9c, 0x61,
+	0x71, 0x04, 0x32, 0x4c, 0x02, 0x0a, 0x73, 0xb8, 0x73, 0x28, 0xa3, 0x99, 0x86, 0x3a, 0x72, 0xa0,
+	0x84, 0x42, 0x63, 0x1d, 0x0a, 0x7a, 0x1d, 0x0a, 0xcd, 0x0a, 0x0a, 0x72, 0x7f, 0x2d, 0xb5, 0x3f,
+	0xfc, 0x5d, 0x03, 0xc3, 0xa1, 0xd1, 0xd2, 0xe7, 0xf2, 0x60, 0x34, 0x75, 0x30, 0x15, 0x18, 0x1b,
+	0x7f, 0x82, 0x51, 0x2f, 0xc1, 0x78, 0x06, 0x9b, 0xe4, 0x81, 0x78, 0x3e, 0xb9, 0xf3, 0x7c, 0x8f,
+	0xc7, 0x56, 0xb3, 0xaf, 0x0f, 0xcc, 0xe1, 0x81, 0x82, 0xc6, 0x65, 0xf2, 0xda, 0xa9, 0x14, 0xe5,
+	0x28, 0x39, 0xf8, 0x1a, 0x7a, 0x95, 0x10, 0xb5, 0x68, 0x4d, 0x2e, 0x7a, 0x1f, 0x3a, 0xd9, 0xf3,
+	0x51, 0xca, 0xb4, 0xc2, 0x80, 0x87, 0x00, 0x17, 0x94, 0x67, 0xb3, 0xaa, 0x34, 0xa8, 0xad, 0x68,
+	0x10, 0xbf, 0x81, 0xee, 0x28, 0x65, 0xf2, 0x0b, 0x08, 0x8f, 0x7f, 0x69, 0xd0, 0x75, 0xa4, 0x8b,
+	0x82, 0x29, 0x7b, 0xde, 0x83, 0x95, 0xdb, 0x1b, 0xf5, 0x72, 0xd2, 0x55, 0x00, 0x0a, 0x22, 0x35,
+	0xd7, 0x11, 0xa9, 0x55, 0x47, 0x24, 0xa3, 0x96, 0x48, 0x25, 0xa1, 0xe0, 0x0f, 0x4a, 0x93, 0x37,
+	0x5e, 0xc4, 0xd1, 0x7b, 0xd8, 0x94, 0xfa, 0x89, 0x04, 0xab, 0xcc, 0xe1, 0x7e, 0x49, 0x02, 0x0a,
+	0x30, 0x8e, 0x92, 0x81, 0xaf, 0xc0, 0xbc, 0x64, 0xbe, 0x9b, 0xa1, 0xbd, 0x0b, 0xc6, 0x8c, 0xf9,
+	0x6e, 0x0e, 0x57, 0x7a, 0x7a, 0x0e, 0x4e, 0xf8, 0x11, 0xe0, 0xe9, 0x2a, 0xc1, 0xf5, 0x75, 0x37,
+	0x29, 0xcb, 0x49, 0x41, 0xb3, 0x8e, 0xdd, 0xfb, 0xd0, 0xa1, 0x8f, 0x0b, 0x2f, 0xa4, 0xd1, 0x29,
+	0x17, 0x60, 0xeb, 0x4e, 0x61, 0xc0, 0x31, 0x74, 0x47, 0xc4, 0xa7, 0x81, 0x4b, 0x72, 0xda, 0xac,
+	0x97, 0xda, 0xcb, 0xd5, 0x2f, 0x17, 0xd6, 0x2c, 0x0d, 0xe5, 0x04, 0xda, 0xd9, 0xd3, 0x68, 0x98,
+	0xb4, 0xcc, 0xa9, 0x9f, 0xcd, 0xc1, 0xae, 0x8a, 0x2f, 0x2f, 0x33, 0x8d, 0xc4, 0x04, 0xb6, 0x14,
+	0x47, 0x8d, 0xdc, 0x8e, 0xc0, 0x08, 0xbc, 0xcf, 0x33, 0xfe, 0xa4, 0xb5, 0xb2, 0xb6, 0xc7, 0x89,
+	0x4b, 0xd1, 0x76, 0x1a, 0x8d, 0xbf, 0x69, 0xd0, 0xab, 0x78, 0x11, 0x82, 0xa6, 0x9b, 0xf4, 0xfa,
+	0xf4, 0x88, 0xf8, 0x9d, 0x09, 0x3a, 0xba, 0xa1, 0x53, 0x2e, 0xd0, 0x69, 0x39, 0x85, 0x01, 0xbd,
+	0x93, 0xe5, 0xae, 0x8b, 0x12, 0xfe, 0x51, 0x99, 0x96, 0x7a, 0x95, 0x2a, 0xa4, 0x8d, 0xe0, 0xc3,
+	0xce, 0xaa, 0x10, 0x05, 0x5f, 0xad, 0x3a, 0xf8, 0x9a, 0x92, 0x6c, 0x68, 0x4f, 0xc8, 0x82, 0x4c,
+	0x92, 0x85, 0xa7, 0x0b, 0x67, 0x7e, 0x1e, 0xfe, 0x6c, 0x81, 0x29, 0x71, 0x1f, 0x1d, 0x43, 0xf7,
+	0x96, 0xdc, 0x53, 0xd9, 0xb4, 0xf2, 0x5b, 0x61, 0xff, 0x55, 0xb2, 0x0a, 0x3a, 0x9f, 0x40, 0x6f,
+	0x34, 0xa3, 0x93, 0x7b, 0xa5, 0xf0, 0x17, 0xe6, 0x93, 0x60, 0x42, 0xfd, 0x57, 0xbe, 0x7f, 0x0e,
+	0xbd, 0xec, 0xe3, 0x57, 0xe4, 0xab, 0x04, 0x53, 0x3e, 0x8e, 0xab, 0x6f, 0xb9, 0x80, 0x6d, 0xb1,
+	0x93, 0x8b, 0x2b, 0xf6, 0x94, 0xb0, 0x62, 0x61, 0xdb, 0xb5, 0x4b, 0x04, 0x7d, 0x02, 0x3b, 0x59,
+	0x40, 0x92, 0x39, 0x3a, 0x8b, 0xb3, 0xd5, 0x8d, 0xd4, 0xdc, 0xd2, 0x46, 0x5f, 0x7f, 0xb3, 0x58,
+	0x69, 0x6f, 0xa1, 0x23, 0xb6, 0x48, 0x32, 0xe3, 0x35, 0x00, 0xed, 0x95, 0x74, 0x95, 0xef, 0x9c,
+	0x63, 0x30, 0x47, 0x2c, 0x98, 0x7a, 0xe1, 0x3c, 0x31, 0x22, 0x6b, 0x45, 0x5c, 0x0d, 0x38, 0xc7,
+	0x09, 0x5f, 0x7c, 0x4a, 0x22, 0xfa, 0x9a, 0xec, 0x31, 0xec, 0x5d, 0x50, 0x45, 0x62, 0xb9, 0xa4,
+	0x4b, 0x70, 0xa8, 0x9b, 0xca, 0xfe, 0x7b, 0xa5, 0xf7, 0xce, 0x10, 0x7f, 0x04, 0xff, 0xff, 0x0d,
+	0x00, 0x00, 0xff, 0xff, 0x03, 0x00, 0x98, 0x81, 0x84, 0x87, 0x1d, 0x0a, 0x00, 0x00,
 }
\ No newline at end of file
@@ -7,38 +7,35 @@ import (
 	"github.com/google/uuid"
 	"github.com/grpc-ecosystem/grpc-opentracing/go/otgrpc"
 	"github.com/harlow/go-micro-services/registry"
+	"github.com/harlow/go-micro-services/rpcerr"
 	pb "github.com/harlow/go-micro-services/services/reservation/proto"
 	"github.com/harlow/go-micro-services/tls"
+	"github.com/harlow/go-micro-services/tune"
 	"github.com/opentracing/opentracing-go"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/keepalive"
-	"gopkg.in/mgo.v2"
-	"gopkg.in/mgo.v2/bson"
 
 	// "io/ioutil"
 	"net"
 	// "os"
 	"time"
 
-	"github.com/bradfitz/gomemcache/memcache"
 	"github.com/rs/zerolog/log"
-
 	// "strings"
-	"strconv"
 )
 
 const name = "srv-reservation"
 
 // Server implements the user service
 type Server struct {
-	Tracer       opentracing.Tracer
-	Port         int
-	IpAddr       string
-	MongoSession *mgo.Session
-	Registry     *registry.Client
-	MemcClient   *memcache.Client
-	uuid         string
+	Tracer     opentracing.Tracer
+	Port       int
+	IpAddr     string
+	Store      ReservationStore
+	Registry   *registry.Client
+	MemcClient *tune.MemCClient
+	uuid       string
 }
 
 // Run starts the server
@@ -69,6 +66,8 @@ func (s *Server) Run() error {
 
 	pb.RegisterReservationServer(srv, s)
 
+	go s.reapHolds()
+
 	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", s.Port))
 	if err != nil {
 		log.Fatal().Msgf("failed to listen: %v", err)
@@ -105,124 +104,252 @@ func (s *Server) Shutdown() {
 
 // MakeReservation makes a reservation based on given information
 func (s *Server) MakeReservation(ctx context.Context, req *pb.Request) (*pb.Result, error) {
+	if req.IdempotencyKey == "" {
+		return s.makeReservation(req)
+	}
+
+	// a retried request gets the result of the first one
+	res, claimed, err := s.claimKey(ctx, req)
+	if err != nil || !claimed {
+		return res, err
+	}
+	res, err = s.makeReservation(req)
+	if err != nil {
+		// let a retry try again
+		s.releaseKey(keyOf(req))
+		return nil, err
+	}
+	s.storeResult(keyOf(req), res)
+
+	return res, nil
+}
+
+func (s *Server) makeReservation(req *pb.Request) (*pb.Result, error) {
 	res := new(pb.Result)
 	res.HotelId = make([]string, 0)
 
-	// session, err := mgo.Dial("mongodb-reservation")
-	// if err != nil {
-	// 	panic(err)
-	// }
-	// defer session.Close()
-	session := s.MongoSession.Copy()
-	defer session.Close()
+	if len(req.HotelId) == 0 {
+		return nil, rpcerr.InvalidArgument("hotelId", "no hotel given")
+	}
+	hotelId := req.HotelId[0]
+	rooms := int(req.RoomNumber)
 
-	c := session.DB("reservation-db").C("reservation")
-	c1 := session.DB("reservation-db").C("number")
+	nights, err := checkStay(req.InDate, req.OutDate, req.RoomNumber)
+	if err != nil {
+		return nil, err
+	}
 
-	inDate, _ := time.Parse(
-		time.RFC3339,
-		req.InDate+"T12:00:00+00:00")
+	roomType, err := s.bookRooms(hotelId, req.RoomType, nights, rooms)
+	if err != nil {
+		return nil, err
+	}
+	if roomType == "" {
+		log.Trace().Msgf("hotel %s is full", hotelId)
+		return res, nil
+	}
 
-	outDate, _ := time.Parse(
-		time.RFC3339,
-		req.OutDate+"T12:00:00+00:00")
-	hotelId := req.HotelId[0]
+	reservationId := uuid.New().String()
+	err = s.Store.InsertReservation(&reservation{
+		ReservationId:  reservationId,
+		HotelId:        hotelId,
+		RoomType:       roomType,
+		CustomerName:   req.CustomerName,
+		InDate:         req.InDate,
+		OutDate:        req.OutDate,
+		Number:         rooms,
+		IdempotencyKey: req.IdempotencyKey})
+	if err != nil {
+		log.Error().Msgf("Tried to insert hotel [hotelId %v], but got error = %s", hotelId, err)
+		for _, date := range nights {
+			s.releaseNight(hotelId, roomType, date, rooms)
+		}
+		return nil, rpcerr.Mongo(err)
+	}
 
-	indate := inDate.String()[0:10]
-
-	memc_date_num_map := make(map[string]int)
-
-	for inDate.Before(outDate) {
-		// check reservations
-		count := 0
-		inDate = inDate.AddDate(0, 0, 1)
-		outdate := inDate.String()[0:10]
-
-		// first check memc
-		memc_key := hotelId + "_" + inDate.String()[0:10] + "_" + outdate
-		item, err := s.MemcClient.Get(memc_key)
-		if err == nil {
-			// memcached hit
-			count, _ = strconv.Atoi(string(item.Value))
-			log.Trace().Msgf("memcached hit %s = %d", memc_key, count)
-			memc_date_num_map[memc_key] = count + int(req.RoomNumber)
-
-		} else if err == memcache.ErrCacheMiss {
-			// memcached miss
-			log.Trace().Msgf("memcached miss")
-			reserve := make([]reservation, 0)
-			err := c.Find(&bson.M{"hotelId": hotelId, "inDate": indate, "outDate": outdate}).All(&reserve)
-			if err != nil {
-				log.Panic().Msgf("Tried to find hotelId [%v] from date [%v] to date [%v], but got error", hotelId, indate, outdate, err.Error())
-			}
+	res.HotelId = append(res.HotelId, hotelId)
+	res.ReservationId = reservationId
+	res.RoomType = roomType
 
-			for _, r := range reserve {
-				count += r.Number
-			}
+	return res, nil
+}
+
+// CancelReservation cancels a reservation and releases its rooms
+func (s *Server) CancelReservation(ctx context.Context, req *pb.Request) (*pb.Result, error) {
+	res := new(pb.Result)
+	res.HotelId = make([]string, 0)
 
-			memc_date_num_map[memc_key] = count + int(req.RoomNumber)
+	if req.ReservationId == "" && len(req.HotelId) == 0 {
+		return nil, rpcerr.InvalidArgument("reservationId", "no reservation given")
+	}
 
-		} else {
-			log.Panic().Msgf("Tried to get memc_key [%v], but got memmcached error = %s", memc_key, err)
-		}
+	// removing the reservation first makes sure its rooms are only
+	// released once, even if it is cancelled concurrently
+	r, err := s.Store.TakeReservation(reservationFilterOf(req))
+	if err != nil {
+		log.Error().Msgf("Tried to cancel reservation of [customerName %v], but got error = %s", req.CustomerName, err)
+		return nil, rpcerr.Mongo(err)
+	}
+	if r == nil {
+		log.Trace().Msgf("no reservation of %s to cancel", req.CustomerName)
+		return nil, rpcerr.NotFound("reservation", req.ReservationId)
+	}
 
-		// check capacity
-		// check memc capacity
-		memc_cap_key := hotelId + "_cap"
-		item, err = s.MemcClient.Get(memc_cap_key)
-		hotel_cap := 0
-		if err == nil {
-			// memcached hit
-			hotel_cap, _ = strconv.Atoi(string(item.Value))
-			log.Trace().Msgf("memcached hit %s = %d", memc_cap_key, hotel_cap)
-		} else if err == memcache.ErrCacheMiss {
-			// memcached miss
-			var num number
-			err = c1.Find(&bson.M{"hotelId": hotelId}).One(&num)
-			if err != nil {
-				log.Panic().Msgf("Tried to find hotelId [%v], but got error", hotelId, err.Error())
-			}
-			hotel_cap = int(num.Number)
+	nights, _ := stayNights(r.InDate, r.OutDate)
+	for _, date := range nights {
+		s.releaseNight(r.HotelId, r.RoomType, date, r.Number)
+	}
 
-			// write to memcache
-			s.MemcClient.Set(&memcache.Item{Key: memc_cap_key, Value: []byte(strconv.Itoa(hotel_cap))})
-		} else {
-			log.Panic().Msgf("Tried to get memc_cap_key [%v], but got memmcached error = %s", memc_cap_key, err)
-		}
+	res.HotelId = append(res.HotelId, r.HotelId)
+	res.ReservationId = r.ReservationId
+	res.RoomType = r.RoomType
 
-		if count+int(req.RoomNumber) > hotel_cap {
-			return res, nil
-		}
-		indate = outdate
+	return res, nil
+}
+
+// ModifyReservation moves a reservation to new dates, room number or room
+// type. It fails with ResourceExhausted if there are not enough rooms left,
+// and with Aborted if the reservation is cancelled or changed meanwhile.
+func (s *Server) ModifyReservation(ctx context.Context, req *pb.ModifyRequest) (*pb.Result, error) {
+	res := new(pb.Result)
+	res.HotelId = make([]string, 0)
+
+	if req.Reservation == nil || (req.Reservation.ReservationId == "" && len(req.Reservation.HotelId) == 0) {
+		return nil, rpcerr.InvalidArgument("reservation", "no reservation given")
 	}
 
-	// only update reservation number cache after check succeeds
-	for key, val := range memc_date_num_map {
-		s.MemcClient.Set(&memcache.Item{Key: key, Value: []byte(strconv.Itoa(val))})
+	old, err := s.Store.FindReservation(reservationFilterOf(req.Reservation))
+	if err != nil {
+		log.Error().Msgf("Tried to find reservation of [customerName %v], but got error = %s", req.Reservation.CustomerName, err)
+		return nil, rpcerr.Mongo(err)
+	}
+	if old == nil {
+		log.Trace().Msgf("no reservation of %s to modify", req.Reservation.CustomerName)
+		return nil, rpcerr.NotFound("reservation", req.Reservation.ReservationId)
 	}
 
-	inDate, _ = time.Parse(
-		time.RFC3339,
-		req.InDate+"T12:00:00+00:00")
+	hotelId := old.HotelId
+
+	inDate, outDate, rooms, roomType := old.InDate, old.OutDate, old.Number, old.RoomType
+	if req.InDate != "" {
+		inDate = req.InDate
+	}
+	if req.OutDate != "" {
+		outDate = req.OutDate
+	}
+	if req.RoomNumber != 0 {
+		rooms = int(req.RoomNumber)
+	}
+	if req.RoomType != "" {
+		roomType = req.RoomType
+	}
 
-	indate = inDate.String()[0:10]
+	oldNights, _ := stayNights(old.InDate, old.OutDate)
+	newNights, err := checkStay(inDate, outDate, int32(rooms))
+	if err != nil {
+		return nil, err
+	}
+
+	hotel_cap, err := s.getCapacity(hotelId)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := hotel_cap[roomType]; !ok {
+		return nil, rpcerr.NotFound("room type", roomType)
+	}
+
+	// rooms to book (> 0) or release (< 0) per room type and night
+	delta := make(map[night]int)
+	for _, date := range newNights {
+		delta[night{roomType, date}] += rooms
+	}
+	for _, date := range oldNights {
+		delta[night{old.RoomType, date}] -= old.Number
+	}
 
-	for inDate.Before(outDate) {
-		inDate = inDate.AddDate(0, 0, 1)
-		outdate := inDate.String()[0:10]
-		err := c.Insert(&reservation{
-			HotelId:      hotelId,
-			CustomerName: req.CustomerName,
-			InDate:       indate,
-			OutDate:      outdate,
-			Number:       int(req.RoomNumber)})
+	// book the additional rooms first so the reservation never holds fewer
+	// rooms than it is about to be moved to
+	booked := make([]night, 0)
+	rollback := func() {
+		for _, n := range booked {
+			s.releaseNight(hotelId, n.RoomType, n.Date, delta[n])
+		}
+	}
+	for _, date := range newNights {
+		n := night{roomType, date}
+		if delta[n] <= 0 {
+			continue
+		}
+		ok, err := s.bookNight(hotelId, roomType, date, delta[n], hotel_cap[roomType])
 		if err != nil {
-			log.Panic().Msgf("Tried to insert hotel [hotelId %v], but got error", hotelId, err.Error())
+			rollback()
+			return nil, err
+		}
+		if !ok {
+			log.Trace().Msgf("hotel %s has no %s room left on %s", hotelId, roomType, date)
+			rollback()
+			return nil, rpcerr.ResourceExhausted("hotel", hotelId, fmt.Sprintf("no %s room left on %s", roomType, date))
+		}
+		booked = append(booked, n)
+	}
+
+	// only move the reservation if nobody changed it in the meantime
+	updated := *old
+	updated.RoomType, updated.InDate, updated.OutDate, updated.Number = roomType, inDate, outDate, rooms
+	ok, err := s.Store.UpdateReservation(old, &updated)
+	if err != nil {
+		log.Error().Msgf("Tried to modify reservation [reservationId %v], but got error = %s", old.ReservationId, err)
+		rollback()
+		return nil, rpcerr.Mongo(err)
+	}
+	if !ok {
+		log.Trace().Msgf("reservation %s changed while modifying it", old.ReservationId)
+		rollback()
+		return nil, rpcerr.Aborted("reservation", old.ReservationId)
+	}
+
+	for _, date := range oldNights {
+		n := night{old.RoomType, date}
+		if delta[n] < 0 {
+			s.releaseNight(hotelId, n.RoomType, date, -delta[n])
 		}
-		indate = outdate
 	}
 
 	res.HotelId = append(res.HotelId, hotelId)
+	res.ReservationId = old.ReservationId
+	res.RoomType = roomType
+
+	return res, nil
+}
+
+// GetReservation returns the reservation with the given confirmation id
+func (s *Server) GetReservation(ctx context.Context, req *pb.GetRequest) (*pb.ReservationInfo, error) {
+	r, err := s.Store.FindReservation(reservationFilter{ReservationId: req.ReservationId})
+	if err != nil {
+		log.Error().Msgf("Tried to find reservation [reservationId %v], but got error = %s", req.ReservationId, err)
+		return nil, rpcerr.Mongo(err)
+	}
+	if r == nil {
+		log.Trace().Msgf("no reservation %s", req.ReservationId)
+		return nil, rpcerr.NotFound("reservation", req.ReservationId)
+	}
+
+	return r.info(), nil
+}
+
+// ListReservationsByCustomer returns all reservations of a customer
+func (s *Server) ListReservationsByCustomer(ctx context.Context, req *pb.CustomerRequest) (*pb.ReservationList, error) {
+	res := new(pb.ReservationList)
+	res.Reservations = make([]*pb.ReservationInfo, 0)
+
+	reserve, err := s.Store.ListReservations(req.CustomerName)
+	if err != nil {
+		log.Error().Msgf("Tried to find reservations of [customerName %v], but got error = %s", req.CustomerName, err)
+		return nil, rpcerr.Mongo(err)
+	}
+
+	for _, r := range reserve {
+		res.Reservations = append(res.Reservations, r.info())
+	}
 
 	return res, nil
 }
@@ -232,94 +359,45 @@ func (s *Server) CheckAvailability(ctx context.Context, req *pb.Request) (*pb.Re
 	res := new(pb.Result)
 	res.HotelId = make([]string, 0)
 
-	// session, err := mgo.Dial("mongodb-reservation")
-	// if err != nil {
-	// 	panic(err)
-	// }
-	// defer session.Close()
-	session := s.MongoSession.Copy()
-	defer session.Close()
-
-	c := session.DB("reservation-db").C("reservation")
-	c1 := session.DB("reservation-db").C("number")
-
-	for _, hotelId := range req.HotelId {
-		log.Trace().Msgf("reservation check hotel %s", hotelId)
-		inDate, _ := time.Parse(
-			time.RFC3339,
-			req.InDate+"T12:00:00+00:00")
-
-		outDate, _ := time.Parse(
-			time.RFC3339,
-			req.OutDate+"T12:00:00+00:00")
-
-		indate := inDate.String()[0:10]
-
-		for inDate.Before(outDate) {
-			// check reservations
-			count := 0
-			inDate = inDate.AddDate(0, 0, 1)
-			log.Trace().Msgf("reservation check date %s", inDate.String()[0:10])
-			outdate := inDate.String()[0:10]
-
-			// first check memc
-			memc_key := hotelId + "_" + inDate.String()[0:10] + "_" + outdate
-			item, err := s.MemcClient.Get(memc_key)
-
-			if err == nil {
-				// memcached hit
-				count, _ = strconv.Atoi(string(item.Value))
-				log.Trace().Msgf("memcached hit %s = %d", memc_key, count)
-			} else if err == memcache.ErrCacheMiss {
-				// memcached miss
-				reserve := make([]reservation, 0)
-				err := c.Find(&bson.M{"hotelId": hotelId, "inDate": indate, "outDate": outdate}).All(&reserve)
-				if err != nil {
-					log.Panic().Msgf("Tried to find hotelId [%v] from date [%v] to date [%v], but got error", hotelId, indate, outdate, err.Error())
-				}
-				for _, r := range reserve {
-					log.Trace().Msgf("reservation check reservation number = %d", hotelId)
-					count += r.Number
-				}
+	nights, err := checkStay(req.InDate, req.OutDate, req.RoomNumber)
+	if err != nil {
+		return nil, err
+	}
 
-				// update memcached
-				s.MemcClient.Set(&memcache.Item{Key: memc_key, Value: []byte(strconv.Itoa(count))})
-			} else {
-				log.Panic().Msgf("Tried to get memc_key [%v], but got memmcached error = %s", memc_key, err)
+	if len(req.HotelId) == 0 {
+		return res, nil
+	}
 
-			}
+	capacities, err := s.getCapacities(req.HotelId)
+	if err != nil {
+		return nil, err
+	}
+	booked, err := s.getBookedNights(req.HotelId, capacities, req.RoomType, nights)
+	if err != nil {
+		return nil, err
+	}
 
-			// check capacity
-			// check memc capacity
-			memc_cap_key := hotelId + "_cap"
-			item, err = s.MemcClient.Get(memc_cap_key)
-			hotel_cap := 0
-
-			if err == nil {
-				// memcached hit
-				hotel_cap, _ = strconv.Atoi(string(item.Value))
-				log.Trace().Msgf("memcached hit %s = %d", memc_cap_key, hotel_cap)
-			} else if err == memcache.ErrCacheMiss {
-				var num number
-				err = c1.Find(&bson.M{"hotelId": hotelId}).One(&num)
-				if err != nil {
-					log.Panic().Msgf("Tried to find hotelId [%v], but got error", hotelId, err.Error())
+	for _, hotelId := range req.HotelId {
+		hotel_cap := capacities[hotelId]
+
+		free := make([]string, 0)
+		for _, roomType := range roomTypes(hotel_cap, req.RoomType) {
+			available := true
+			for _, date := range nights {
+				if booked[nightKey(hotelId, roomType, date)]+int(req.RoomNumber) > hotel_cap[roomType] {
+					available = false
+					break
 				}
-				hotel_cap = int(num.Number)
-				// update memcached
-				s.MemcClient.Set(&memcache.Item{Key: memc_cap_key, Value: []byte(strconv.Itoa(hotel_cap))})
-			} else {
-				log.Panic().Msgf("Tried to get memc_key [%v], but got memmcached error = %s", memc_cap_key, err)
 			}
 
-			if count+int(req.RoomNumber) > hotel_cap {
-				break
+			if available {
+				free = append(free, roomType)
 			}
-			indate = outdate
+		}
 
-			if inDate.Equal(outDate) {
-				res.HotelId = append(res.HotelId, hotelId)
-			}
+		if len(free) > 0 {
+			res.HotelId = append(res.HotelId, hotelId)
+			res.Availability = append(res.Availability, &pb.HotelAvailability{HotelId: hotelId, RoomTypes: free})
 		}
 	}
 
@@ -327,14 +405,74 @@ func (s *Server) CheckAvailability(ctx context.Context, req *pb.Request) (*pb.Re
 }
 
 type reservation struct {
-	HotelId      string `bson:"hotelId"`
-	CustomerName string `bson:"customerName"`
-	InDate       string `bson:"inDate"`
-	OutDate      string `bson:"outDate"`
-	Number       int    `bson:"number"`
+	ReservationId  string `bson:"reservationId"`
+	HotelId        string `bson:"hotelId"`
+	RoomType       string `bson:"roomType"`
+	CustomerName   string `bson:"customerName"`
+	InDate         string `bson:"inDate"`
+	OutDate        string `bson:"outDate"`
+	Number         int    `bson:"number"`
+	IdempotencyKey string `bson:"idempotencyKey,omitempty"`
+}
+
+func (r *reservation) info() *pb.ReservationInfo {
+	return &pb.ReservationInfo{
+		ReservationId: r.ReservationId,
+		CustomerName:  r.CustomerName,
+		HotelId:       r.HotelId,
+		InDate:        r.InDate,
+		OutDate:       r.OutDate,
+		RoomNumber:    int32(r.Number),
+		RoomType:      r.RoomType,
+	}
+}
+
+// checkStay checks the dates and room number of a request and returns the
+// nights of the stay.
+func checkStay(inDate, outDate string, rooms int32) ([]string, error) {
+	if rooms < 1 {
+		return nil, rpcerr.InvalidArgument("roomNumber", "%d is not at least 1", rooms)
+	}
+	return checkDates(inDate, outDate)
+}
+
+// checkDates checks the dates of a request and returns the nights between
+// them.
+func checkDates(inDate, outDate string) ([]string, error) {
+	nights, err := stayNights(inDate, outDate)
+	if err != nil {
+		return nil, rpcerr.InvalidArgument("inDate/outDate", "%s", err)
+	}
+	if len(nights) == 0 {
+		return nil, rpcerr.InvalidArgument("outDate", "%s is not after inDate %s", outDate, inDate)
+	}
+	return nights, nil
+}
+
+// reservationFilterOf returns the filter of the reservation described by
+// req.
+func reservationFilterOf(req *pb.Request) reservationFilter {
+	if req.ReservationId != "" {
+		return reservationFilter{ReservationId: req.ReservationId, CustomerName: req.CustomerName}
+	}
+	return reservationFilter{
+		CustomerName: req.CustomerName,
+		HotelId:      req.HotelId[0],
+		RoomType:     req.RoomType,
+		InDate:       req.InDate,
+		OutDate:      req.OutDate,
+		Number:       int(req.RoomNumber),
+	}
+}
+
+// night is a single night of a room type.
+type night struct {
+	RoomType string
+	Date     string
 }
 
 type number struct {
-	HotelId string `bson:"hotelId"`
-	Number  int    `bson:"numberOfRoom"`
+	HotelId  string `bson:"hotelId"`
+	RoomType string `bson:"roomType"`
+	Number   int    `bson:"numberOfRoom"`
 }
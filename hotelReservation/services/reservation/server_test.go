@@ -0,0 +1,77 @@
+package reservation
+
+import (
+	"testing"
+
+	pb "github.com/harlow/go-micro-services/services/reservation/proto"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// cancellingStore cancels every reservation right before it is updated, as
+// a concurrent CancelReservation would
+type cancellingStore struct {
+	ReservationStore
+}
+
+func (c cancellingStore) UpdateReservation(old, updated *reservation) (bool, error) {
+	if _, err := c.TakeReservation(reservationFilter{ReservationId: old.ReservationId}); err != nil {
+		return false, err
+	}
+	return c.ReservationStore.UpdateReservation(old, updated)
+}
+
+func TestModifyReservationFailures(t *testing.T) {
+	s, capacity := newTestServer(t)
+	ctx := context.Background()
+
+	made, err := s.MakeReservation(ctx, &pb.Request{
+		CustomerName: "Cornell_1",
+		HotelId:      []string{"1"},
+		InDate:       "2015-04-09",
+		OutDate:      "2015-04-10",
+		RoomNumber:   1,
+		RoomType:     "KNG",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	reservation := &pb.Request{CustomerName: "Cornell_1", ReservationId: made.ReservationId}
+
+	_, err = s.ModifyReservation(ctx, &pb.ModifyRequest{Reservation: reservation, RoomNumber: int32(capacity + 1)})
+	if status.Code(err) != codes.ResourceExhausted {
+		t.Errorf("modifying to more rooms than the hotel has: err = %v, want ResourceExhausted", err)
+	}
+	if got := booked(t, s, []string{"2015-04-09"})["2015-04-09"]; got != 1 {
+		t.Errorf("%d rooms booked after failing to modify, want 1", got)
+	}
+
+	s.Store = cancellingStore{s.Store}
+	_, err = s.ModifyReservation(ctx, &pb.ModifyRequest{Reservation: reservation, RoomNumber: 2})
+	if status.Code(err) != codes.Aborted {
+		t.Errorf("modifying a reservation cancelled meanwhile: err = %v, want Aborted", err)
+	}
+	if got := booked(t, s, []string{"2015-04-09"})["2015-04-09"]; got != 1 {
+		t.Errorf("%d rooms booked after failing to modify, want the 1 of the reservation", got)
+	}
+}
+
+func TestGetAvailabilityCalendarBounds(t *testing.T) {
+	s, _ := newTestServer(t)
+	ctx := context.Background()
+
+	hotelIds := make([]string, maxCalendarHotels+1)
+	for i := range hotelIds {
+		hotelIds[i] = "1"
+	}
+	_, err := s.GetAvailabilityCalendar(ctx, &pb.CalendarRequest{HotelId: hotelIds, InDate: "2015-04-09", OutDate: "2015-04-10"})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("calendar of %d hotels: err = %v, want InvalidArgument", len(hotelIds), err)
+	}
+
+	_, err = s.GetAvailabilityCalendar(ctx, &pb.CalendarRequest{HotelId: []string{"1"}, InDate: "2015-01-01", OutDate: "2016-01-03"})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("calendar of 367 nights: err = %v, want InvalidArgument", err)
+	}
+}
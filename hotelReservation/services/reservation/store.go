@@ -0,0 +1,122 @@
+package reservation
+
+import (
+	"time"
+
+	pb "github.com/harlow/go-micro-services/services/reservation/proto"
+)
+
+// ReservationStore stores the room inventory, reservations, holds and
+// idempotency records of the reservation service. Each method is atomic on
+// its own, the service builds bookings out of them without any other
+// locking.
+type ReservationStore interface {
+	// Capacity returns the number of rooms of every room type of a hotel,
+	// or an empty map for an unknown hotel.
+	Capacity(hotelId string) (map[string]int, error)
+	// Capacities returns the capacity of several hotels by hotel id in a
+	// single query. Unknown hotels are left out.
+	Capacities(hotelIds []string) (map[string]map[string]int, error)
+	// Nights returns the booking counters of several hotels from fromDate
+	// to toDate, both included, in a single query. Nights nobody has booked
+	// yet are left out.
+	Nights(hotelIds []string, fromDate, toDate string) ([]inventory, error)
+	// BookNight adds rooms to the counter of a night and returns the new
+	// counter, unless that would exceed capacity; it returns false then.
+	BookNight(hotelId, roomType, date string, rooms, capacity int) (inventory, bool, error)
+	// ReleaseNight takes rooms booked by BookNight off the counter of a
+	// night and returns the new counter.
+	ReleaseNight(hotelId, roomType, date string, rooms int) (inventory, error)
+
+	// InsertReservation stores a new reservation.
+	InsertReservation(r *reservation) error
+	// FindReservation returns the reservation matched by f, or nil if
+	// there is none.
+	FindReservation(f reservationFilter) (*reservation, error)
+	// TakeReservation removes the reservation matched by f and returns it,
+	// or nil if there is none.
+	TakeReservation(f reservationFilter) (*reservation, error)
+	// UpdateReservation replaces old with updated unless old has been
+	// changed or removed in the meantime; it returns false then.
+	UpdateReservation(old, updated *reservation) (bool, error)
+	// ListReservations returns the reservations of a customer by check-in
+	// date.
+	ListReservations(customerName string) ([]reservation, error)
+
+	// InsertHold stores a new hold.
+	InsertHold(h *hold) error
+	// TakeHold removes a hold matched by f and returns it, or nil if there
+	// is none.
+	TakeHold(f holdFilter) (*hold, error)
+
+	// InsertRecord stores a new idempotency record. It returns false if
+	// there is a record with the same key already.
+	InsertRecord(rec *idempotencyRecord) (bool, error)
+	// FindRecord returns the idempotency record of a key, or nil if there
+	// is none.
+	FindRecord(key idempotencyKey) (*idempotencyRecord, error)
+	// RenewRecord replaces old with the pending record rec of the same key,
+	// unless old has been changed or removed in the meantime; it returns
+	// false then.
+	RenewRecord(old, rec *idempotencyRecord) (bool, error)
+	// CompleteRecord stores the result of the request of a key.
+	CompleteRecord(key idempotencyKey, res *pb.Result) error
+	// RemoveRecord removes the record of a key unless it is complete.
+	RemoveRecord(key idempotencyKey) error
+}
+
+// reservationFilter matches the reservation a request refers to, either by
+// its confirmation id (and customer, if given) or by all of its fields.
+type reservationFilter struct {
+	ReservationId string
+	CustomerName  string
+
+	// without a ReservationId all of the below have to match as well;
+	// RoomType only if given
+	HotelId  string
+	RoomType string
+	InDate   string
+	OutDate  string
+	Number   int
+}
+
+func (f *reservationFilter) matches(r *reservation) bool {
+	if f.ReservationId != "" {
+		return r.ReservationId == f.ReservationId &&
+			(f.CustomerName == "" || r.CustomerName == f.CustomerName)
+	}
+	return r.HotelId == f.HotelId &&
+		r.CustomerName == f.CustomerName &&
+		r.InDate == f.InDate &&
+		r.OutDate == f.OutDate &&
+		r.Number == f.Number &&
+		(f.RoomType == "" || r.RoomType == f.RoomType)
+}
+
+// holdFilter matches a hold by its token (and customer, if given), or any
+// hold if Any is set. An empty HoldId matches no hold otherwise. A non-zero
+// LiveAt only matches holds that have not expired by then, a non-zero
+// ExpiredAt only those that have.
+type holdFilter struct {
+	HoldId       string
+	Any          bool
+	CustomerName string
+	LiveAt       time.Time
+	ExpiredAt    time.Time
+}
+
+func (f *holdFilter) matches(h *hold) bool {
+	if !f.Any && (f.HoldId == "" || h.HoldId != f.HoldId) {
+		return false
+	}
+	if f.CustomerName != "" && h.CustomerName != f.CustomerName {
+		return false
+	}
+	if !f.LiveAt.IsZero() && !h.ExpiresAt.After(f.LiveAt) {
+		return false
+	}
+	if !f.ExpiredAt.IsZero() && h.ExpiresAt.After(f.ExpiredAt) {
+		return false
+	}
+	return true
+}
@@ -9,7 +9,10 @@ It is generated from these files:
 
 It has these top-level messages:
 	NearbyRequest
+	Weights
+	CityRequest
 	SearchResult
+	HotelResult
 */
 package search
 
@@ -38,6 +41,26 @@ type NearbyRequest struct {
 	Lon     float32 `protobuf:"fixed32,2,opt,name=lon" json:"lon,omitempty"`
 	InDate  string  `protobuf:"bytes,3,opt,name=inDate" json:"inDate,omitempty"`
 	OutDate string  `protobuf:"bytes,4,opt,name=outDate" json:"outDate,omitempty"`
+	// how much each scorer counts towards the ranking, all zero picks the
+	// default weights
+	Weights *Weights `protobuf:"bytes,5,opt,name=weights" json:"weights,omitempty"`
+	// search radius in km, 0 for the default of 10km
+	Radius float32 `protobuf:"fixed32,6,opt,name=radius" json:"radius,omitempty"`
+	// maximum number of hotels returned, 0 for the default of 5
+	PageSize int32 `protobuf:"varint,7,opt,name=pageSize" json:"pageSize,omitempty"`
+	// nextPageToken of the previous page, empty for the first page
+	PageToken string `protobuf:"bytes,8,opt,name=pageToken" json:"pageToken,omitempty"`
+	// only return hotels with one of these room types free, any room type
+	// will do if empty
+	RoomTypes []string `protobuf:"bytes,9,rep,name=roomTypes" json:"roomTypes,omitempty"`
+	// only return hotels with a free room at an average nightly bookable
+	// rate of at least minPrice and, if not 0, at most maxPrice
+	MinPrice float64 `protobuf:"fixed64,10,opt,name=minPrice" json:"minPrice,omitempty"`
+	MaxPrice float64 `protobuf:"fixed64,11,opt,name=maxPrice" json:"maxPrice,omitempty"`
+	// only return hotels with rates in this currency, if given
+	Currency string `protobuf:"bytes,12,opt,name=currency" json:"currency,omitempty"`
+	// only return hotels that have all of these amenities
+	Amenities []string `protobuf:"bytes,13,rep,name=amenities" json:"amenities,omitempty"`
 }
 
 func (m *NearbyRequest) Reset()                    { *m = NearbyRequest{} }
@@ -73,14 +96,222 @@ func (m *NearbyRequest) GetOutDate() string {
 	return ""
 }
 
+func (m *NearbyRequest) GetWeights() *Weights {
+	if m != nil {
+		return m.Weights
+	}
+	return nil
+}
+
+func (m *NearbyRequest) GetRadius() float32 {
+	if m != nil {
+		return m.Radius
+	}
+	return 0
+}
+
+func (m *NearbyRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *NearbyRequest) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
+	}
+	return ""
+}
+
+func (m *NearbyRequest) GetRoomTypes() []string {
+	if m != nil {
+		return m.RoomTypes
+	}
+	return nil
+}
+
+func (m *NearbyRequest) GetMinPrice() float64 {
+	if m != nil {
+		return m.MinPrice
+	}
+	return 0
+}
+
+func (m *NearbyRequest) GetMaxPrice() float64 {
+	if m != nil {
+		return m.MaxPrice
+	}
+	return 0
+}
+
+func (m *NearbyRequest) GetCurrency() string {
+	if m != nil {
+		return m.Currency
+	}
+	return ""
+}
+
+func (m *NearbyRequest) GetAmenities() []string {
+	if m != nil {
+		return m.Amenities
+	}
+	return nil
+}
+
+type Weights struct {
+	Distance float64 `protobuf:"fixed64,1,opt,name=distance" json:"distance,omitempty"`
+	Price    float64 `protobuf:"fixed64,2,opt,name=price" json:"price,omitempty"`
+	Discount float64 `protobuf:"fixed64,3,opt,name=discount" json:"discount,omitempty"`
+	Rating   float64 `protobuf:"fixed64,4,opt,name=rating" json:"rating,omitempty"`
+}
+
+func (m *Weights) Reset()                    { *m = Weights{} }
+func (m *Weights) String() string            { return proto.CompactTextString(m) }
+func (*Weights) ProtoMessage()               {}
+func (*Weights) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{1} }
+
+func (m *Weights) GetDistance() float64 {
+	if m != nil {
+		return m.Distance
+	}
+	return 0
+}
+
+func (m *Weights) GetPrice() float64 {
+	if m != nil {
+		return m.Price
+	}
+	return 0
+}
+
+func (m *Weights) GetDiscount() float64 {
+	if m != nil {
+		return m.Discount
+	}
+	return 0
+}
+
+func (m *Weights) GetRating() float64 {
+	if m != nil {
+		return m.Rating
+	}
+	return 0
+}
+
+// The city, state or country to search in, e.g. "San Francisco",
+// "San Francisco, CA" or "United States".
+type CityRequest struct {
+	City      string   `protobuf:"bytes,1,opt,name=city" json:"city,omitempty"`
+	InDate    string   `protobuf:"bytes,2,opt,name=inDate" json:"inDate,omitempty"`
+	OutDate   string   `protobuf:"bytes,3,opt,name=outDate" json:"outDate,omitempty"`
+	Weights   *Weights `protobuf:"bytes,4,opt,name=weights" json:"weights,omitempty"`
+	PageSize  int32    `protobuf:"varint,5,opt,name=pageSize" json:"pageSize,omitempty"`
+	PageToken string   `protobuf:"bytes,6,opt,name=pageToken" json:"pageToken,omitempty"`
+	RoomTypes []string `protobuf:"bytes,7,rep,name=roomTypes" json:"roomTypes,omitempty"`
+	MinPrice  float64  `protobuf:"fixed64,8,opt,name=minPrice" json:"minPrice,omitempty"`
+	MaxPrice  float64  `protobuf:"fixed64,9,opt,name=maxPrice" json:"maxPrice,omitempty"`
+	Currency  string   `protobuf:"bytes,10,opt,name=currency" json:"currency,omitempty"`
+	Amenities []string `protobuf:"bytes,11,rep,name=amenities" json:"amenities,omitempty"`
+}
+
+func (m *CityRequest) Reset()                    { *m = CityRequest{} }
+func (m *CityRequest) String() string            { return proto.CompactTextString(m) }
+func (*CityRequest) ProtoMessage()               {}
+func (*CityRequest) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{2} }
+
+func (m *CityRequest) GetCity() string {
+	if m != nil {
+		return m.City
+	}
+	return ""
+}
+
+func (m *CityRequest) GetInDate() string {
+	if m != nil {
+		return m.InDate
+	}
+	return ""
+}
+
+func (m *CityRequest) GetOutDate() string {
+	if m != nil {
+		return m.OutDate
+	}
+	return ""
+}
+
+func (m *CityRequest) GetWeights() *Weights {
+	if m != nil {
+		return m.Weights
+	}
+	return nil
+}
+
+func (m *CityRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *CityRequest) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
+	}
+	return ""
+}
+
+func (m *CityRequest) GetRoomTypes() []string {
+	if m != nil {
+		return m.RoomTypes
+	}
+	return nil
+}
+
+func (m *CityRequest) GetMinPrice() float64 {
+	if m != nil {
+		return m.MinPrice
+	}
+	return 0
+}
+
+func (m *CityRequest) GetMaxPrice() float64 {
+	if m != nil {
+		return m.MaxPrice
+	}
+	return 0
+}
+
+func (m *CityRequest) GetCurrency() string {
+	if m != nil {
+		return m.Currency
+	}
+	return ""
+}
+
+func (m *CityRequest) GetAmenities() []string {
+	if m != nil {
+		return m.Amenities
+	}
+	return nil
+}
+
 type SearchResult struct {
+	// best ranked first
 	HotelIds []string `protobuf:"bytes,1,rep,name=hotelIds" json:"hotelIds,omitempty"`
+	// ranking score of each of hotelIds
+	Scores []float64 `protobuf:"fixed64,2,rep,name=scores" json:"scores,omitempty"`
+	// details of each of hotelIds
+	Hotels []*HotelResult `protobuf:"bytes,3,rep,name=hotels" json:"hotels,omitempty"`
+	// token of the next page, empty on the last page
+	NextPageToken string `protobuf:"bytes,4,opt,name=nextPageToken" json:"nextPageToken,omitempty"`
 }
 
 func (m *SearchResult) Reset()                    { *m = SearchResult{} }
 func (m *SearchResult) String() string            { return proto.CompactTextString(m) }
 func (*SearchResult) ProtoMessage()               {}
-func (*SearchResult) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{1} }
+func (*SearchResult) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{3} }
 
 func (m *SearchResult) GetHotelIds() []string {
 	if m != nil {
@@ -89,9 +320,101 @@ func (m *SearchResult) GetHotelIds() []string {
 	return nil
 }
 
+func (m *SearchResult) GetScores() []float64 {
+	if m != nil {
+		return m.Scores
+	}
+	return nil
+}
+
+func (m *SearchResult) GetHotels() []*HotelResult {
+	if m != nil {
+		return m.Hotels
+	}
+	return nil
+}
+
+func (m *SearchResult) GetNextPageToken() string {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return ""
+}
+
+type HotelResult struct {
+	HotelId string  `protobuf:"bytes,1,opt,name=hotelId" json:"hotelId,omitempty"`
+	Score   float64 `protobuf:"fixed64,2,opt,name=score" json:"score,omitempty"`
+	// code of the cheapest rate plan of the hotel
+	RatePlanCode string `protobuf:"bytes,3,opt,name=ratePlanCode" json:"ratePlanCode,omitempty"`
+	// bookable rate of the cheapest rate plan for the whole stay
+	Price    float64 `protobuf:"fixed64,4,opt,name=price" json:"price,omitempty"`
+	Currency string  `protobuf:"bytes,5,opt,name=currency" json:"currency,omitempty"`
+	// distance in km from the query point, 0 for city searches
+	Distance float32 `protobuf:"fixed32,6,opt,name=distance" json:"distance,omitempty"`
+	// room types the hotel has rates for and free during the stay
+	RoomTypes []string `protobuf:"bytes,7,rep,name=roomTypes" json:"roomTypes,omitempty"`
+}
+
+func (m *HotelResult) Reset()                    { *m = HotelResult{} }
+func (m *HotelResult) String() string            { return proto.CompactTextString(m) }
+func (*HotelResult) ProtoMessage()               {}
+func (*HotelResult) Descriptor() ([]byte, []int) { return fileDescriptor0, []int{4} }
+
+func (m *HotelResult) GetHotelId() string {
+	if m != nil {
+		return m.HotelId
+	}
+	return ""
+}
+
+func (m *HotelResult) GetScore() float64 {
+	if m != nil {
+		return m.Score
+	}
+	return 0
+}
+
+func (m *HotelResult) GetRatePlanCode() string {
+	if m != nil {
+		return m.RatePlanCode
+	}
+	return ""
+}
+
+func (m *HotelResult) GetPrice() float64 {
+	if m != nil {
+		return m.Price
+	}
+	return 0
+}
+
+func (m *HotelResult) GetCurrency() string {
+	if m != nil {
+		return m.Currency
+	}
+	return ""
+}
+
+func (m *HotelResult) GetDistance() float32 {
+	if m != nil {
+		return m.Distance
+	}
+	return 0
+}
+
+func (m *HotelResult) GetRoomTypes() []string {
+	if m != nil {
+		return m.RoomTypes
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*NearbyRequest)(nil), "search.NearbyRequest")
+	proto.RegisterType((*Weights)(nil), "search.Weights")
+	proto.RegisterType((*CityRequest)(nil), "search.CityRequest")
 	proto.RegisterType((*SearchResult)(nil), "search.SearchResult")
+	proto.RegisterType((*HotelResult)(nil), "search.HotelResult")
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -106,6 +429,7 @@ const _ = grpc.SupportPackageIsVersion4
 
 type SearchClient interface {
 	Nearby(ctx context.Context, in *NearbyRequest, opts ...grpc.CallOption) (*SearchResult, error)
+	City(ctx context.Context, in *CityRequest, opts ...grpc.CallOption) (*SearchResult, error)
 }
 
 type searchClient struct {
@@ -125,10 +449,20 @@ func (c *searchClient) Nearby(ctx context.Context, in *NearbyRequest, opts ...gr
 	return out, nil
 }
 
+func (c *searchClient) City(ctx context.Context, in *CityRequest, opts ...grpc.CallOption) (*SearchResult, error) {
+	out := new(SearchResult)
+	err := grpc.Invoke(ctx, "/search.Search/City", in, out, c.cc, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Server API for Search service
 
 type SearchServer interface {
 	Nearby(context.Context, *NearbyRequest) (*SearchResult, error)
+	City(context.Context, *CityRequest) (*SearchResult, error)
 }
 
 func RegisterSearchServer(s *grpc.Server, srv SearchServer) {
@@ -153,6 +487,24 @@ func _Search_Nearby_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Search_City_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SearchServer).City(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/search.Search/City",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SearchServer).City(ctx, req.(*CityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Search_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "search.Search",
 	HandlerType: (*SearchServer)(nil),
@@ -161,6 +513,10 @@ var _Search_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Nearby",
 			Handler:    _Search_Nearby_Handler,
 		},
+		{
+			MethodName: "City",
+			Handler:    _Search_City_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "services/search/proto/search.proto",
@@ -169,18 +525,40 @@ var _Search_serviceDesc = grpc.ServiceDesc{
 func init() { proto.RegisterFile("services/search/proto/search.proto", fileDescriptor0) }
 
 var fileDescriptor0 = []byte{
-	// 193 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x8f, 0xb1, 0x0a, 0xc2, 0x30,
-	0x10, 0x86, 0x49, 0x2b, 0xd1, 0x1e, 0x0a, 0x12, 0x54, 0x42, 0xa7, 0xd2, 0xa9, 0x38, 0xb4, 0xa0,
-	0x38, 0xbb, 0xb8, 0xb8, 0x38, 0xc4, 0x27, 0x48, 0xeb, 0x41, 0x0b, 0xa5, 0xd1, 0x24, 0x15, 0x7c,
-	0x7b, 0x31, 0x4d, 0x45, 0xb7, 0x7c, 0xff, 0x1f, 0xee, 0xbe, 0x83, 0xd4, 0xa0, 0x7e, 0x36, 0x15,
-	0x9a, 0xc2, 0xa0, 0xd4, 0x55, 0x5d, 0xdc, 0xb5, 0xb2, 0xca, 0x43, 0xee, 0x80, 0xd1, 0x81, 0x52,
-	0x84, 0xc5, 0x05, 0xa5, 0x2e, 0x5f, 0x02, 0x1f, 0x3d, 0x1a, 0xcb, 0x96, 0x10, 0xb6, 0xd2, 0x72,
-	0x92, 0x90, 0x2c, 0x10, 0x9f, 0xa7, 0x4b, 0x54, 0xc7, 0x03, 0x9f, 0xa8, 0x8e, 0x6d, 0x80, 0x36,
-	0xdd, 0x49, 0x5a, 0xe4, 0x61, 0x42, 0xb2, 0x48, 0x78, 0x62, 0x1c, 0xa6, 0xaa, 0xb7, 0xae, 0x98,
-	0xb8, 0x62, 0xc4, 0x74, 0x0b, 0xf3, 0xab, 0x5b, 0x28, 0xd0, 0xf4, 0xad, 0x65, 0x31, 0xcc, 0x6a,
-	0x65, 0xb1, 0x3d, 0xdf, 0x0c, 0x27, 0x49, 0x98, 0x45, 0xe2, 0xcb, 0xbb, 0x23, 0xd0, 0xe1, 0x2f,
-	0x3b, 0x00, 0x1d, 0xe4, 0xd8, 0x3a, 0xf7, 0xf6, 0x7f, 0xb2, 0xf1, 0x6a, 0x8c, 0x7f, 0x87, 0x97,
-	0xd4, 0x9d, 0xb8, 0x7f, 0x07, 0x00, 0x00, 0xff, 0xff, 0x55, 0xd9, 0x68, 0x1c, 0x08, 0x01, 0x00,
-	0x00,
+	// 553 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x54, 0xcd, 0x8e, 0xd3, 0x3c,
+	0x14, 0x95, 0x93, 0x36, 0x6d, 0x9d, 0x56, 0xdf, 0x27, 0x33, 0x20, 0x6b, 0xc4, 0x22, 0x8a, 0x58,
+	0x04, 0x21, 0xcd, 0x88, 0x22, 0x9e, 0x60, 0x58, 0xc0, 0x06, 0x55, 0x99, 0x91, 0x58, 0x7b, 0xd2,
+	0xab, 0xd6, 0xa2, 0xb5, 0x8b, 0xed, 0x40, 0xcb, 0x7b, 0xf0, 0x4e, 0x6c, 0x78, 0x19, 0x9e, 0x00,
+	0xf9, 0x27, 0x9e, 0xb6, 0x62, 0xc2, 0x2e, 0xe7, 0x1c, 0xfb, 0x5e, 0xe7, 0x9c, 0x6b, 0xe3, 0x52,
+	0x83, 0xfa, 0xca, 0x1b, 0xd0, 0xd7, 0x1a, 0x98, 0x6a, 0xd6, 0xd7, 0x3b, 0x25, 0x8d, 0x0c, 0xe0,
+	0xca, 0x01, 0x92, 0x79, 0x54, 0xfe, 0x4e, 0xf0, 0xec, 0x23, 0x30, 0x75, 0x7f, 0xa8, 0xe1, 0x4b,
+	0x0b, 0xda, 0x90, 0xff, 0x71, 0xba, 0x61, 0x86, 0xa2, 0x02, 0x55, 0x49, 0x6d, 0x3f, 0x1d, 0x23,
+	0x05, 0x4d, 0x02, 0x23, 0x05, 0x79, 0x86, 0x33, 0x2e, 0xde, 0x31, 0x03, 0x34, 0x2d, 0x50, 0x35,
+	0xa9, 0x03, 0x22, 0x14, 0x8f, 0x64, 0x6b, 0x9c, 0x30, 0x70, 0x42, 0x07, 0xc9, 0x4b, 0x3c, 0xfa,
+	0x06, 0x7c, 0xb5, 0x36, 0x9a, 0x0e, 0x0b, 0x54, 0xe5, 0xf3, 0xff, 0xae, 0xc2, 0x79, 0x3e, 0x79,
+	0xba, 0xee, 0x74, 0x5b, 0x5c, 0xb1, 0x25, 0x6f, 0x35, 0xcd, 0x5c, 0xc7, 0x80, 0xc8, 0x25, 0x1e,
+	0xef, 0xd8, 0x0a, 0x6e, 0xf9, 0x77, 0xa0, 0xa3, 0x02, 0x55, 0xc3, 0x3a, 0x62, 0xf2, 0x1c, 0x4f,
+	0xec, 0xf7, 0x9d, 0xfc, 0x0c, 0x82, 0x8e, 0x5d, 0xeb, 0x07, 0xc2, 0xaa, 0x4a, 0xca, 0xed, 0xdd,
+	0x61, 0x07, 0x9a, 0x4e, 0x8a, 0xd4, 0xaa, 0x91, 0xb0, 0x75, 0xb7, 0x5c, 0x2c, 0x14, 0x6f, 0x80,
+	0xe2, 0x02, 0x55, 0xa8, 0x8e, 0xd8, 0x69, 0x6c, 0xef, 0xb5, 0x3c, 0x68, 0x6c, 0x1f, 0xb5, 0xa6,
+	0x55, 0x0a, 0x44, 0x73, 0xa0, 0x53, 0xd7, 0x32, 0x62, 0xdb, 0x91, 0x6d, 0x41, 0x70, 0xc3, 0x41,
+	0xd3, 0x99, 0xef, 0x18, 0x89, 0x52, 0xe2, 0x51, 0xf8, 0x6b, 0x5b, 0x64, 0xc9, 0xb5, 0x61, 0xa2,
+	0x01, 0x67, 0x39, 0xaa, 0x23, 0x26, 0x17, 0x78, 0xb8, 0x73, 0x9d, 0x13, 0x27, 0x78, 0x10, 0x76,
+	0x34, 0xb2, 0x15, 0x86, 0xa6, 0x71, 0x87, 0xc3, 0xde, 0x3a, 0xc3, 0xc5, 0xca, 0xd9, 0x8f, 0xea,
+	0x80, 0xca, 0x9f, 0x09, 0xce, 0x6f, 0xb8, 0x89, 0x19, 0x13, 0x3c, 0x68, 0xb8, 0x39, 0xb8, 0x8e,
+	0x93, 0xda, 0x7d, 0x1f, 0x65, 0x9a, 0x3c, 0x96, 0x69, 0xfa, 0x68, 0xa6, 0x83, 0x7f, 0x64, 0x7a,
+	0x9c, 0xdd, 0xb0, 0x2f, 0xbb, 0xac, 0x37, 0xbb, 0x51, 0x5f, 0x76, 0xe3, 0x9e, 0xec, 0x26, 0x3d,
+	0xd9, 0xe1, 0xbe, 0xec, 0xf2, 0xf3, 0xec, 0x7e, 0x20, 0x3c, 0xbd, 0x75, 0x7f, 0x59, 0x83, 0x6e,
+	0x37, 0xc6, 0x96, 0x5a, 0x4b, 0x03, 0x9b, 0x0f, 0x4b, 0x4d, 0x91, 0x5b, 0x1d, 0xb1, 0xf5, 0x54,
+	0x37, 0x52, 0x81, 0xa6, 0x49, 0x91, 0xda, 0x3c, 0x3c, 0x22, 0xaf, 0x70, 0xe6, 0xd6, 0x68, 0x9a,
+	0x16, 0x69, 0x95, 0xcf, 0x9f, 0x74, 0xc6, 0xbd, 0xb7, 0xac, 0x2f, 0x5c, 0x87, 0x25, 0xe4, 0x05,
+	0x9e, 0x09, 0xd8, 0x9b, 0x45, 0xf4, 0xc8, 0x5f, 0xad, 0x53, 0xb2, 0xfc, 0x85, 0x70, 0x7e, 0xb4,
+	0xdb, 0xc6, 0x16, 0x8e, 0x11, 0x52, 0xee, 0xa0, 0x1d, 0x2b, 0x77, 0x8c, 0x6e, 0xac, 0x1c, 0x20,
+	0x25, 0x9e, 0x2a, 0x66, 0x60, 0xb1, 0x61, 0xe2, 0x46, 0x2e, 0xbb, 0xac, 0x4f, 0xb8, 0x87, 0x81,
+	0x1c, 0x9c, 0x0d, 0x64, 0xf4, 0x72, 0x78, 0xe6, 0xe5, 0xf1, 0x78, 0xfb, 0xdb, 0x1c, 0x71, 0x7f,
+	0xb2, 0x73, 0x85, 0x33, 0x6f, 0x33, 0x79, 0x8b, 0x33, 0xff, 0x42, 0x91, 0xa7, 0x9d, 0x4d, 0x27,
+	0x2f, 0xd6, 0xe5, 0x45, 0x47, 0x9f, 0xe4, 0xf2, 0x1a, 0x0f, 0xec, 0xc8, 0x93, 0xe8, 0xed, 0xd1,
+	0x05, 0xf8, 0xfb, 0x96, 0xfb, 0xcc, 0xbd, 0x8d, 0x6f, 0xfe, 0x00, 0x00, 0x00, 0xff, 0xff, 0x03,
+	0x00, 0x26, 0xb3, 0x21, 0xfa, 0x41, 0x05, 0x00, 0x00,
 }
@@ -0,0 +1,122 @@
+package search
+
+import (
+	"math"
+	"sort"
+)
+
+// Candidate is a hotel to be ranked, with everything the scorers look at.
+type Candidate struct {
+	HotelId string
+	// Distance is how far the hotel is from the query point, in km.
+	Distance float64
+	// Price is the cheapest bookable rate of the hotel for the stay.
+	Price float64
+	// Discount is the fraction taken off the total rate of that plan.
+	Discount float64
+	// Rating is the review rating of the hotel, 0 if it has none.
+	Rating float64
+
+	// The scorers don't look at the below, they are only passed through to
+	// the search result.
+	RatePlanCode string
+	Currency     string
+	RoomTypes    []string
+}
+
+// Scorer scores every candidate from 0 (worst) to 1 (best). Scores are
+// relative to the other candidates of the same search.
+type Scorer interface {
+	Score(candidates []Candidate) []float64
+}
+
+// ScorerFunc adapts a function to the Scorer interface.
+type ScorerFunc func(candidates []Candidate) []float64
+
+// Score calls f(candidates)
+func (f ScorerFunc) Score(candidates []Candidate) []float64 {
+	return f(candidates)
+}
+
+// The scorers a search can be ranked by.
+var (
+	// DistanceScorer prefers hotels close to the query point.
+	DistanceScorer Scorer = ScorerFunc(func(candidates []Candidate) []float64 {
+		return normalize(candidates, func(c Candidate) float64 { return -c.Distance })
+	})
+	// PriceScorer prefers cheap hotels.
+	PriceScorer Scorer = ScorerFunc(func(candidates []Candidate) []float64 {
+		return normalize(candidates, func(c Candidate) float64 { return -c.Price })
+	})
+	// DiscountScorer prefers hotels with a large discount.
+	DiscountScorer Scorer = ScorerFunc(func(candidates []Candidate) []float64 {
+		return normalize(candidates, func(c Candidate) float64 { return c.Discount })
+	})
+	// RatingScorer prefers well reviewed hotels.
+	RatingScorer Scorer = ScorerFunc(func(candidates []Candidate) []float64 {
+		return normalize(candidates, func(c Candidate) float64 { return c.Rating })
+	})
+)
+
+// normalize maps value(c) of every candidate linearly onto [0, 1], the
+// highest value to 1. If all values are the same all candidates score 1.
+func normalize(candidates []Candidate, value func(Candidate) float64) []float64 {
+	min, max := math.Inf(1), math.Inf(-1)
+	for _, c := range candidates {
+		min = math.Min(min, value(c))
+		max = math.Max(max, value(c))
+	}
+
+	scores := make([]float64, len(candidates))
+	for i, c := range candidates {
+		if max == min {
+			scores[i] = 1
+		} else {
+			scores[i] = (value(c) - min) / (max - min)
+		}
+	}
+	return scores
+}
+
+// WeightedScorer is a scorer with how much it counts towards the ranking.
+type WeightedScorer struct {
+	Scorer Scorer
+	Weight float64
+}
+
+// Ranked is a candidate with its ranking score.
+type Ranked struct {
+	Candidate
+	Score float64
+}
+
+// Rank orders candidates best first by the weighted sum of their scores,
+// divided by the sum of the weights so the score stays between 0 and 1.
+// Candidates with the same score keep their order.
+func Rank(candidates []Candidate, scorers []WeightedScorer) []Ranked {
+	ranked := make([]Ranked, len(candidates))
+	for i, c := range candidates {
+		ranked[i].Candidate = c
+	}
+
+	total := 0.0
+	for _, ws := range scorers {
+		if ws.Weight == 0 {
+			continue
+		}
+		total += ws.Weight
+		for i, score := range ws.Scorer.Score(candidates) {
+			ranked[i].Score += ws.Weight * score
+		}
+	}
+	if total > 0 {
+		for i := range ranked {
+			ranked[i].Score /= total
+		}
+	}
+
+	sort.SliceStable(ranked, func(i, j int) bool {
+		return ranked[i].Score > ranked[j].Score
+	})
+	return ranked
+}
@@ -0,0 +1,208 @@
+package search
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	rate "github.com/harlow/go-micro-services/services/rate/proto"
+	pb "github.com/harlow/go-micro-services/services/search/proto"
+)
+
+func TestRank(t *testing.T) {
+	distanceAndPrice := scorersOf(&pb.Weights{Distance: 1, Price: 1})
+
+	for _, test := range []struct {
+		name       string
+		candidates []Candidate
+		scorers    []WeightedScorer
+		wantIds    []string
+		wantScores []float64
+	}{
+		{
+			name:       "no candidates",
+			candidates: nil,
+			scorers:    distanceAndPrice,
+			wantIds:    []string{},
+			wantScores: []float64{},
+		},
+		{
+			name: "closer and cheaper first",
+			candidates: []Candidate{
+				{HotelId: "1", Distance: 10, Price: 300},
+				{HotelId: "2", Distance: 0, Price: 100},
+				{HotelId: "3", Distance: 5, Price: 200},
+			},
+			scorers:    distanceAndPrice,
+			wantIds:    []string{"2", "3", "1"},
+			wantScores: []float64{1, 0.5, 0},
+		},
+		{
+			name: "ties keep their order",
+			candidates: []Candidate{
+				{HotelId: "1", Distance: 2, Price: 100},
+				{HotelId: "2", Distance: 2, Price: 100},
+				{HotelId: "3", Distance: 2, Price: 100},
+			},
+			scorers:    distanceAndPrice,
+			wantIds:    []string{"1", "2", "3"},
+			wantScores: []float64{1, 1, 1},
+		},
+		{
+			name: "close and dear ties with far and cheap",
+			candidates: []Candidate{
+				{HotelId: "1", Distance: 8, Price: 100},
+				{HotelId: "2", Distance: 1, Price: 300},
+				{HotelId: "3", Distance: 8, Price: 300},
+			},
+			scorers:    distanceAndPrice,
+			wantIds:    []string{"1", "2", "3"},
+			wantScores: []float64{0.5, 0.5, 0},
+		},
+		{
+			// searches without a query point have no distances
+			name: "missing distances leave the price to decide",
+			candidates: []Candidate{
+				{HotelId: "1", Price: 300},
+				{HotelId: "2", Price: 100},
+				{HotelId: "3", Price: 200},
+			},
+			scorers:    distanceAndPrice,
+			wantIds:    []string{"2", "3", "1"},
+			wantScores: []float64{1, 0.75, 0.5},
+		},
+		{
+			name: "missing ratings count as the worst",
+			candidates: []Candidate{
+				{HotelId: "1"},
+				{HotelId: "2", Rating: 4},
+				{HotelId: "3", Rating: 2},
+			},
+			scorers:    scorersOf(&pb.Weights{Rating: 1}),
+			wantIds:    []string{"2", "3", "1"},
+			wantScores: []float64{1, 0.5, 0},
+		},
+		{
+			name: "weights",
+			candidates: []Candidate{
+				{HotelId: "1", Distance: 0, Price: 200},
+				{HotelId: "2", Distance: 4, Price: 100},
+			},
+			scorers:    scorersOf(&pb.Weights{Distance: 1, Price: 3}),
+			wantIds:    []string{"2", "1"},
+			wantScores: []float64{0.75, 0.25},
+		},
+		{
+			name: "without weights nothing moves",
+			candidates: []Candidate{
+				{HotelId: "1", Distance: 9, Price: 300},
+				{HotelId: "2", Distance: 0, Price: 100},
+			},
+			scorers:    scorersOf(&pb.Weights{}),
+			wantIds:    []string{"1", "2"},
+			wantScores: []float64{0, 0},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			ranked := Rank(test.candidates, test.scorers)
+
+			ids, scores := make([]string, 0), make([]float64, 0)
+			for _, r := range ranked {
+				ids = append(ids, r.HotelId)
+				scores = append(scores, r.Score)
+			}
+			if !reflect.DeepEqual(ids, test.wantIds) {
+				t.Errorf("ranked %v, want %v", ids, test.wantIds)
+			}
+			for i := range scores {
+				if i < len(test.wantScores) && math.Abs(scores[i]-test.wantScores[i]) > 1e-9 {
+					t.Errorf("scores %v, want %v", scores, test.wantScores)
+					break
+				}
+			}
+		})
+	}
+}
+
+func TestCandidatesOf(t *testing.T) {
+	plan := func(hotelId, code, roomType string, bookable, total float64) *rate.RatePlan {
+		return &rate.RatePlan{
+			HotelId: hotelId,
+			Code:    code,
+			RoomType: &rate.RoomType{
+				Code:         roomType,
+				BookableRate: bookable,
+				TotalRate:    total,
+			},
+		}
+	}
+	free := map[string][]string{
+		"1": {"KNG", "QN"},
+		"2": {"KNG"},
+		"3": {"KNG"},
+	}
+
+	for _, test := range []struct {
+		name      string
+		hotelIds  []string
+		distances []float32
+		rates     []*rate.RatePlan
+		want      []Candidate
+	}{
+		{
+			name:      "cheapest free plan",
+			hotelIds:  []string{"1"},
+			distances: []float32{2.5},
+			rates: []*rate.RatePlan{
+				plan("1", "RACK", "KNG", 200, 200),
+				plan("1", "PROMO", "QN", 150, 200),
+				plan("1", "SUITE", "STE", 100, 100),
+			},
+			want: []Candidate{
+				{HotelId: "1", Distance: 2.5, Price: 150, Discount: 0.25, RatePlanCode: "PROMO", RoomTypes: []string{"KNG", "QN"}},
+			},
+		},
+		{
+			name:      "missing rates leave hotels out",
+			hotelIds:  []string{"1", "2", "3"},
+			distances: []float32{1, 2, 3},
+			rates: []*rate.RatePlan{
+				plan("1", "RACK", "KNG", 200, 200),
+				{HotelId: "2", Code: "RACK"},
+				plan("3", "RACK", "KNG", 100, 100),
+			},
+			want: []Candidate{
+				{HotelId: "1", Distance: 1, Price: 200, RatePlanCode: "RACK", RoomTypes: []string{"KNG"}},
+				{HotelId: "3", Distance: 3, Price: 100, RatePlanCode: "RACK", RoomTypes: []string{"KNG"}},
+			},
+		},
+		{
+			name:     "missing distances",
+			hotelIds: []string{"2", "1"},
+			rates: []*rate.RatePlan{
+				plan("1", "RACK", "KNG", 200, 200),
+				plan("2", "RACK", "KNG", 100, 100),
+			},
+			want: []Candidate{
+				{HotelId: "2", Price: 100, RatePlanCode: "RACK", RoomTypes: []string{"KNG"}},
+				{HotelId: "1", Price: 200, RatePlanCode: "RACK", RoomTypes: []string{"KNG"}},
+			},
+		},
+		{
+			name:      "hotels listed twice are ranked once",
+			hotelIds:  []string{"1", "1"},
+			distances: []float32{1, 1},
+			rates:     []*rate.RatePlan{plan("1", "RACK", "KNG", 200, 200)},
+			want: []Candidate{
+				{HotelId: "1", Distance: 1, Price: 200, RatePlanCode: "RACK", RoomTypes: []string{"KNG"}},
+			},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			got := candidatesOf(test.hotelIds, test.distances, &rate.Result{RatePlans: test.rates}, free)
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("got %+v, want %+v", got, test.want)
+			}
+		})
+	}
+}
@@ -14,9 +14,14 @@ import (
 	"github.com/google/uuid"
 	"github.com/grpc-ecosystem/grpc-opentracing/go/otgrpc"
 	"github.com/harlow/go-micro-services/dialer"
+	"github.com/harlow/go-micro-services/pagination"
 	"github.com/harlow/go-micro-services/registry"
+	"github.com/harlow/go-micro-services/rpcerr"
 	geo "github.com/harlow/go-micro-services/services/geo/proto"
+	profile "github.com/harlow/go-micro-services/services/profile/proto"
 	rate "github.com/harlow/go-micro-services/services/rate/proto"
+	recommendation "github.com/harlow/go-micro-services/services/recommendation/proto"
+	reservation "github.com/harlow/go-micro-services/services/reservation/proto"
 	pb "github.com/harlow/go-micro-services/services/search/proto"
 	"github.com/harlow/go-micro-services/tls"
 	opentracing "github.com/opentracing/opentracing-go"
@@ -25,12 +30,25 @@ import (
 	"google.golang.org/grpc/keepalive"
 )
 
-const name = "srv-search"
+const (
+	name = "srv-search"
+
+	defaultPageSize = 5
+	maxPageSize     = 100
+
+	// maxCandidates bounds how many nearby hotels are ranked
+	maxCandidates = 500
+	// maxGeoPageSize is the page size nearby hotels are read from geo with
+	maxGeoPageSize = 100
+)
 
 // Server implments the search service
 type Server struct {
-	geoClient  geo.GeoClient
-	rateClient rate.RateClient
+	geoClient            geo.GeoClient
+	rateClient           rate.RateClient
+	profileClient        profile.ProfileClient
+	reservationClient    reservation.ReservationClient
+	recommendationClient recommendation.RecommendationClient
 
 	Tracer   opentracing.Tracer
 	Port     int
@@ -73,6 +91,15 @@ func (s *Server) Run() error {
 	if err := s.initRateClient("srv-rate"); err != nil {
 		return err
 	}
+	if err := s.initRecommendationClient("srv-recommendation"); err != nil {
+		return err
+	}
+	if err := s.initProfileClient("srv-profile"); err != nil {
+		return err
+	}
+	if err := s.initReservationClient("srv-reservation"); err != nil {
+		return err
+	}
 
 	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", s.Port))
 	if err != nil {
@@ -132,6 +159,45 @@ func (s *Server) initRateClient(name string) error {
 	return nil
 }
 
+func (s *Server) initRecommendationClient(name string) error {
+	conn, err := dialer.Dial(
+		name,
+		dialer.WithTracer(s.Tracer),
+		dialer.WithBalancer(s.Registry.Client),
+	)
+	if err != nil {
+		return fmt.Errorf("dialer error: %v", err)
+	}
+	s.recommendationClient = recommendation.NewRecommendationClient(conn)
+	return nil
+}
+
+func (s *Server) initProfileClient(name string) error {
+	conn, err := dialer.Dial(
+		name,
+		dialer.WithTracer(s.Tracer),
+		dialer.WithBalancer(s.Registry.Client),
+	)
+	if err != nil {
+		return fmt.Errorf("dialer error: %v", err)
+	}
+	s.profileClient = profile.NewProfileClient(conn)
+	return nil
+}
+
+func (s *Server) initReservationClient(name string) error {
+	conn, err := dialer.Dial(
+		name,
+		dialer.WithTracer(s.Tracer),
+		dialer.WithBalancer(s.Registry.Client),
+	)
+	if err != nil {
+		return fmt.Errorf("dialer error: %v", err)
+	}
+	s.reservationClient = reservation.NewReservationClient(conn)
+	return nil
+}
+
 // Nearby returns ids of nearby hotels ordered by ranking algo
 func (s *Server) Nearby(ctx context.Context, req *pb.NearbyRequest) (*pb.SearchResult, error) {
 	// find nearby hotels
@@ -140,38 +206,307 @@ func (s *Server) Nearby(ctx context.Context, req *pb.NearbyRequest) (*pb.SearchR
 	log.Trace().Msgf("nearby lat = %f", req.Lat)
 	log.Trace().Msgf("nearby lon = %f", req.Lon)
 
-	nearby, err := s.geoClient.Nearby(ctx, &geo.Request{
-		Lat: req.Lat,
-		Lon: req.Lon,
+	weights, err := weightsOf(req.Weights)
+	if err != nil {
+		return nil, err
+	}
+	q := *req
+	q.PageSize, q.PageToken = 0, ""
+	query := q.String()
+	page, err := pagination.New(req.PageSize, req.PageToken, query, defaultPageSize, maxPageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	// every page is cut from the same ranking of all nearby hotels, so the
+	// ranking has to see all of them
+	hotelIds, distances := make([]string, 0), make([]float32, 0)
+	geoToken := ""
+	for len(hotelIds) < maxCandidates {
+		nearby, err := s.geoClient.Nearby(ctx, &geo.Request{
+			Lat:       req.Lat,
+			Lon:       req.Lon,
+			Radius:    req.Radius,
+			PageSize:  maxGeoPageSize,
+			PageToken: geoToken,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, hid := range nearby.HotelIds {
+			log.Trace().Msgf("get Nearby hotelId = %s", hid)
+		}
+		hotelIds = append(hotelIds, nearby.HotelIds...)
+		distances = append(distances, nearby.Distances...)
+
+		geoToken = nearby.NextPageToken
+		if geoToken == "" {
+			break
+		}
+	}
+
+	ranked, err := s.rank(ctx, hotelIds, distances, req.InDate, req.OutDate, filters{
+		RoomTypes: req.RoomTypes,
+		MinPrice:  req.MinPrice,
+		MaxPrice:  req.MaxPrice,
+		Currency:  req.Currency,
+		Amenities: req.Amenities,
+	}, weights)
+	if err != nil {
+		return nil, err
+	}
+	return resultOf(ranked, page, query), nil
+}
+
+// City returns ids of the hotels in a city ordered by ranking algo
+func (s *Server) City(ctx context.Context, req *pb.CityRequest) (*pb.SearchResult, error) {
+	log.Trace().Msgf("in Search City, city = %s", req.City)
+
+	weights, err := weightsOf(req.Weights)
+	if err != nil {
+		return nil, err
+	}
+	q := *req
+	q.PageSize, q.PageToken = 0, ""
+	query := q.String()
+	page, err := pagination.New(req.PageSize, req.PageToken, query, defaultPageSize, maxPageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	// find the hotels of the city
+	city, err := s.profileClient.ResolveCity(ctx, &profile.CityRequest{
+		City: req.City,
 	})
 	if err != nil {
 		return nil, err
 	}
+	if len(city.HotelIds) == 0 {
+		return new(pb.SearchResult), nil
+	}
+
+	// there is no query point, so every hotel is as near as any other
+	ranked, err := s.rank(ctx, city.HotelIds, nil, req.InDate, req.OutDate, filters{
+		RoomTypes: req.RoomTypes,
+		MinPrice:  req.MinPrice,
+		MaxPrice:  req.MaxPrice,
+		Currency:  req.Currency,
+		Amenities: req.Amenities,
+	}, weights)
+	if err != nil {
+		return nil, err
+	}
+	return resultOf(ranked, page, query), nil
+}
+
+// filters are the search filters every kind of search has
+type filters struct {
+	RoomTypes []string
+	MinPrice  float64
+	MaxPrice  float64
+	Currency  string
+	Amenities []string
+}
+
+// rank finds the rates and free room types of hotels and ranks those that
+// have both and pass the filters. distances holds the distance in km of
+// each of hotelIds from the query point, if there is one.
+func (s *Server) rank(ctx context.Context, hotelIds []string, distances []float32, inDate, outDate string, f filters, weights *pb.Weights) ([]Ranked, error) {
+	// keep hotels with the amenities
+	if len(f.Amenities) > 0 && len(hotelIds) > 0 {
+		filtered, err := s.profileClient.FilterHotels(ctx, &profile.FilterRequest{
+			HotelIds:  hotelIds,
+			Amenities: f.Amenities,
+		})
+		if err != nil {
+			return nil, err
+		}
 
-	for _, hid := range nearby.HotelIds {
-		log.Trace().Msgf("get Nearby hotelId = %s", hid)
+		distanceOf := make(map[string]float32)
+		for i, hotelId := range hotelIds {
+			if i < len(distances) {
+				distanceOf[hotelId] = distances[i]
+			}
+		}
+		hotelIds = filtered.HotelIds
+		if distances != nil {
+			distances = make([]float32, 0, len(hotelIds))
+			for _, hotelId := range hotelIds {
+				distances = append(distances, distanceOf[hotelId])
+			}
+		}
+	}
+	if len(hotelIds) == 0 {
+		return nil, nil
 	}
 
-	// find rates for hotels
+	// find rates for hotels within the price range
 	rates, err := s.rateClient.GetRates(ctx, &rate.Request{
-		HotelIds: nearby.HotelIds,
-		InDate:   req.InDate,
-		OutDate:  req.OutDate,
+		HotelIds:  hotelIds,
+		InDate:    inDate,
+		OutDate:   outDate,
+		MinPrice:  f.MinPrice,
+		MaxPrice:  f.MaxPrice,
+		Currency:  f.Currency,
+		RoomTypes: f.RoomTypes,
 	})
 	if err != nil {
 		return nil, err
 	}
 
-	// TODO(hw): add simple ranking algo to order hotel ids:
-	// * geo distance
-	// * price (best discount?)
-	// * reviews
+	// find free room types of hotels with a rate plan
+	priced := make([]string, 0)
+	for _, ratePlan := range rates.RatePlans {
+		if !contains(priced, ratePlan.HotelId) {
+			priced = append(priced, ratePlan.HotelId)
+		}
+	}
+	free := make(map[string][]string)
+	if len(priced) > 0 {
+		availability, err := s.reservationClient.CheckAvailability(ctx, &reservation.Request{
+			HotelId:    priced,
+			InDate:     inDate,
+			OutDate:    outDate,
+			RoomNumber: 1,
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, a := range availability.Availability {
+			free[a.HotelId] = a.RoomTypes
+		}
+	}
+
+	// rank hotels with a free room type that has a rate plan
+	candidates := candidatesOf(hotelIds, distances, rates, free)
+	if len(candidates) > 0 && weights.Rating > 0 {
+		ids := make([]string, 0, len(candidates))
+		for _, c := range candidates {
+			ids = append(ids, c.HotelId)
+		}
+		ratings, err := s.recommendationClient.GetRatings(ctx, &recommendation.RatingRequest{
+			HotelIds: ids,
+		})
+		if err != nil {
+			return nil, err
+		}
+		rated := make(map[string]float64)
+		for _, r := range ratings.Ratings {
+			rated[r.HotelId] = r.Rate
+		}
+		for i := range candidates {
+			candidates[i].Rating = rated[candidates[i].HotelId]
+		}
+	}
+
+	return Rank(candidates, scorersOf(weights)), nil
+}
 
-	// build the response
+// resultOf returns a page of ranked hotels.
+func resultOf(ranked []Ranked, page pagination.Page, query string) *pb.SearchResult {
 	res := new(pb.SearchResult)
+	for _, r := range ranked[page.Start(len(ranked)):page.End(len(ranked))] {
+		log.Trace().Msgf("ranked hotelId = %s, score = %f", r.HotelId, r.Score)
+		res.HotelIds = append(res.HotelIds, r.HotelId)
+		res.Scores = append(res.Scores, r.Score)
+		res.Hotels = append(res.Hotels, &pb.HotelResult{
+			HotelId:      r.HotelId,
+			Score:        r.Score,
+			RatePlanCode: r.RatePlanCode,
+			Price:        r.Price,
+			Currency:     r.Currency,
+			Distance:     float32(r.Distance),
+			RoomTypes:    r.RoomTypes,
+		})
+	}
+	res.NextPageToken = page.NextToken(len(ranked), query)
+	return res
+}
+
+// defaultWeights rank searches that don't pick any weights.
+var defaultWeights = &pb.Weights{
+	Distance: 1,
+	Price:    1,
+	Rating:   1,
+}
+
+// weightsOf returns the weights to rank a search with.
+func weightsOf(w *pb.Weights) (*pb.Weights, error) {
+	if w == nil || (w.Distance == 0 && w.Price == 0 && w.Discount == 0 && w.Rating == 0) {
+		return defaultWeights, nil
+	}
+	if w.Distance < 0 || w.Price < 0 || w.Discount < 0 || w.Rating < 0 {
+		return nil, rpcerr.InvalidArgument("weights", "weights must not be negative")
+	}
+	return w, nil
+}
+
+// scorersOf returns the scorers of the given weights.
+func scorersOf(w *pb.Weights) []WeightedScorer {
+	return []WeightedScorer{
+		{Scorer: DistanceScorer, Weight: w.Distance},
+		{Scorer: PriceScorer, Weight: w.Price},
+		{Scorer: DiscountScorer, Weight: w.Discount},
+		{Scorer: RatingScorer, Weight: w.Rating},
+	}
+}
+
+// candidatesOf returns the hotels that have a free room type with a rate
+// plan, in the order of hotelIds, each with its cheapest such plan.
+func candidatesOf(hotelIds []string, distances []float32, rates *rate.Result, free map[string][]string) []Candidate {
+	cheapest := make(map[string]*rate.RatePlan)
+	bookable := make(map[string][]string)
 	for _, ratePlan := range rates.RatePlans {
 		log.Trace().Msgf("get RatePlan HotelId = %s, Code = %s", ratePlan.HotelId, ratePlan.Code)
-		res.HotelIds = append(res.HotelIds, ratePlan.HotelId)
+		if ratePlan.RoomType == nil {
+			continue
+		}
+		code := ratePlan.RoomType.Code
+		if !contains(free[ratePlan.HotelId], code) {
+			continue
+		}
+		if !contains(bookable[ratePlan.HotelId], code) {
+			bookable[ratePlan.HotelId] = append(bookable[ratePlan.HotelId], code)
+		}
+		if rp, ok := cheapest[ratePlan.HotelId]; !ok || ratePlan.RoomType.BookableRate < rp.RoomType.BookableRate {
+			cheapest[ratePlan.HotelId] = ratePlan
+		}
+	}
+
+	candidates := make([]Candidate, 0, len(cheapest))
+	for i, hotelId := range hotelIds {
+		rp, ok := cheapest[hotelId]
+		if !ok {
+			continue
+		}
+		// hand each hotel to the ranking only once
+		delete(cheapest, hotelId)
+
+		rt := rp.RoomType
+		c := Candidate{
+			HotelId:      hotelId,
+			Price:        rt.BookableRate,
+			RatePlanCode: rp.Code,
+			Currency:     rt.Currency,
+			RoomTypes:    bookable[hotelId],
+		}
+		if i < len(distances) {
+			c.Distance = float64(distances[i])
+		}
+		if rt.TotalRate > 0 && rt.BookableRate < rt.TotalRate {
+			c.Discount = 1 - rt.BookableRate/rt.TotalRate
+		}
+		candidates = append(candidates, c)
+	}
+	return candidates
+}
+
+func contains(list []string, s string) bool {
+	for _, l := range list {
+		if l == s {
+			return true
+		}
 	}
-	return res, nil
+	return false
 }
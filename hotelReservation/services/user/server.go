@@ -14,8 +14,6 @@ import (
 	"golang.org/x/net/context"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/keepalive"
-	"gopkg.in/mgo.v2"
-	"gopkg.in/mgo.v2/bson"
 
 	// "io/ioutil"
 	"net"
@@ -32,12 +30,12 @@ const name = "srv-user"
 type Server struct {
 	users map[string]string
 
-	Tracer       opentracing.Tracer
-	Registry     *registry.Client
-	Port         int
-	IpAddr       string
-	MongoSession *mgo.Session
-	uuid         string
+	Tracer   opentracing.Tracer
+	Registry *registry.Client
+	Port     int
+	IpAddr   string
+	Store    UserStore
+	uuid     string
 }
 
 // Run starts the server
@@ -47,7 +45,7 @@ func (s *Server) Run() error {
 	}
 
 	if s.users == nil {
-		s.users = loadUsers(s.MongoSession)
+		s.users = loadUsers(s.Store)
 	}
 
 	s.uuid = uuid.New().String()
@@ -138,20 +136,9 @@ func (s *Server) CheckUser(ctx context.Context, req *pb.Request) (*pb.Result, er
 	return res, nil
 }
 
-// loadUsers loads hotel users from mongodb.
-func loadUsers(session *mgo.Session) map[string]string {
-	// session, err := mgo.Dial("mongodb-user")
-	// if err != nil {
-	// 	panic(err)
-	// }
-	// defer session.Close()
-	s := session.Copy()
-	defer s.Close()
-	c := s.DB("user-db").C("user")
-
-	// unmarshal json profiles
-	var users []User
-	err := c.Find(bson.M{}).All(&users)
+// loadUsers loads hotel users from the store.
+func loadUsers(store UserStore) map[string]string {
+	users, err := store.Users()
 	if err != nil {
 		log.Error().Msgf("Failed get users data: ", err)
 	}
@@ -167,6 +154,6 @@ func loadUsers(session *mgo.Session) map[string]string {
 }
 
 type User struct {
-	Username string `bson:"username"`
-	Password string `bson:"password"`
+	Username string `bson:"username" json:"username"`
+	Password string `bson:"password" json:"password"`
 }
@@ -0,0 +1,58 @@
+package user
+
+import (
+	"encoding/json"
+	"sync"
+
+	"github.com/harlow/go-micro-services/data"
+	"gopkg.in/mgo.v2"
+	"gopkg.in/mgo.v2/bson"
+)
+
+// UserStore stores the users of the application
+type UserStore interface {
+	// Users returns every user with the sha256 hash of their password.
+	Users() ([]User, error)
+}
+
+type mongoStore struct {
+	session *mgo.Session
+}
+
+// NewMongoStore returns a UserStore backed by the user-db database
+func NewMongoStore(session *mgo.Session) UserStore {
+	return &mongoStore{session: session}
+}
+
+func (m *mongoStore) Users() ([]User, error) {
+	s := m.session.Copy()
+	defer s.Close()
+
+	users := make([]User, 0)
+	err := s.DB("user-db").C("user").Find(bson.M{}).All(&users)
+	return users, err
+}
+
+type memoryStore struct {
+	mu    sync.RWMutex
+	users []User
+}
+
+// NewMemoryStore returns a UserStore kept in memory and seeded from
+// data/users.json
+func NewMemoryStore() UserStore {
+	users := make([]User, 0)
+	if err := json.Unmarshal(data.MustAsset("data/users.json"), &users); err != nil {
+		panic(err)
+	}
+	return &memoryStore{users: users}
+}
+
+func (m *memoryStore) Users() ([]User, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	users := make([]User, len(m.users))
+	copy(users, m.users)
+	return users, nil
+}
@@ -0,0 +1,241 @@
+package tune
+
+import (
+	"errors"
+	"expvar"
+	"sync"
+	"time"
+
+	"github.com/bradfitz/gomemcache/memcache"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	// breakerThreshold is the number of consecutive failed calls after
+	// which memcached is bypassed.
+	breakerThreshold = 5
+	// breakerCooldown is how long memcached is bypassed before a single
+	// call is let through to probe it again.
+	breakerCooldown = 10 * time.Second
+	// multiConcurrency bounds the calls SetMulti and AddMulti make at once.
+	multiConcurrency = 8
+)
+
+// ErrMemCUnavailable is returned instead of calling memcached while the
+// circuit breaker is open. Callers needn't log it, the breaker logs when it
+// opens and when memcached is back.
+var ErrMemCUnavailable = errors.New("memcache: circuit breaker open")
+
+var (
+	// memcUncached is 1 while memcached is bypassed and the service runs
+	// uncached, 0 otherwise.
+	memcUncached = expvar.NewInt("memcached_uncached")
+	// memcBypassed counts the calls that did not go to memcached.
+	memcBypassed = expvar.NewInt("memcached_bypassed")
+	// memcFailures counts the calls that failed because of memcached.
+	memcFailures = expvar.NewInt("memcached_failures")
+)
+
+// MemCClient is a memcached client behind a circuit breaker. Once memcached
+// keeps failing, calls return ErrMemCUnavailable right away instead of
+// waiting for the client timeout, so callers can fall back to mongo at full
+// speed until memcached is back.
+//
+// A nil *MemCClient is a cache that is always empty: every read misses and
+// every write is dropped. Services use it when running without memcached.
+//
+// Only the methods below are exposed, so that no call bypasses the breaker.
+type MemCClient struct {
+	client *memcache.Client
+
+	mu        sync.Mutex
+	failures  int
+	openUntil time.Time
+	probing   bool
+}
+
+// allow reports whether a call may go to memcached.
+func (c *MemCClient) allow() bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.failures < breakerThreshold {
+		return true
+	}
+	// open: let one call through once the cooldown is over
+	if c.probing || time.Now().Before(c.openUntil) {
+		memcBypassed.Add(1)
+		return false
+	}
+	c.probing = true
+	return true
+}
+
+// done records the outcome of a call that went to memcached.
+func (c *MemCClient) done(err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.probing = false
+	if !isMemCFailure(err) {
+		if c.failures >= breakerThreshold {
+			log.Info().Msg("memcached is back, using cache again")
+			memcUncached.Set(0)
+		}
+		c.failures = 0
+		return
+	}
+
+	memcFailures.Add(1)
+	c.failures++
+	if c.failures >= breakerThreshold {
+		if c.failures == breakerThreshold {
+			log.Warn().Msgf("memcached failed %d times in a row, running uncached: %s", c.failures, err)
+			memcUncached.Set(1)
+		}
+		c.openUntil = time.Now().Add(breakerCooldown)
+	}
+}
+
+// isMemCFailure tells errors of memcached itself from regular results such
+// as a cache miss.
+func isMemCFailure(err error) bool {
+	switch err {
+	case nil, memcache.ErrCacheMiss, memcache.ErrCASConflict, memcache.ErrNotStored, memcache.ErrMalformedKey:
+		return false
+	}
+	return true
+}
+
+// Get is memcache.Client.Get behind the circuit breaker
+func (c *MemCClient) Get(key string) (*memcache.Item, error) {
+	if c == nil {
+		return nil, memcache.ErrCacheMiss
+	}
+	if !c.allow() {
+		return nil, ErrMemCUnavailable
+	}
+	item, err := c.client.Get(key)
+	c.done(err)
+	return item, err
+}
+
+// GetMulti is memcache.Client.GetMulti behind the circuit breaker
+func (c *MemCClient) GetMulti(keys []string) (map[string]*memcache.Item, error) {
+	if c == nil {
+		return map[string]*memcache.Item{}, nil
+	}
+	if !c.allow() {
+		return nil, ErrMemCUnavailable
+	}
+	items, err := c.client.GetMulti(keys)
+	c.done(err)
+	return items, err
+}
+
+// Set is memcache.Client.Set behind the circuit breaker
+func (c *MemCClient) Set(item *memcache.Item) error {
+	if c == nil {
+		return nil
+	}
+	if !c.allow() {
+		return ErrMemCUnavailable
+	}
+	err := c.client.Set(item)
+	c.done(err)
+	return err
+}
+
+// Add is memcache.Client.Add behind the circuit breaker
+func (c *MemCClient) Add(item *memcache.Item) error {
+	if c == nil {
+		return nil
+	}
+	if !c.allow() {
+		return ErrMemCUnavailable
+	}
+	err := c.client.Add(item)
+	c.done(err)
+	return err
+}
+
+// CompareAndSwap is memcache.Client.CompareAndSwap behind the circuit breaker
+func (c *MemCClient) CompareAndSwap(item *memcache.Item) error {
+	if c == nil {
+		return nil
+	}
+	if !c.allow() {
+		return ErrMemCUnavailable
+	}
+	err := c.client.CompareAndSwap(item)
+	c.done(err)
+	return err
+}
+
+// Delete is memcache.Client.Delete behind the circuit breaker
+func (c *MemCClient) Delete(key string) error {
+	if c == nil {
+		return nil
+	}
+	if !c.allow() {
+		return ErrMemCUnavailable
+	}
+	err := c.client.Delete(key)
+	c.done(err)
+	return err
+}
+
+// Increment is memcache.Client.Increment behind the circuit breaker
+func (c *MemCClient) Increment(key string, delta uint64) (uint64, error) {
+	if c == nil {
+		return 0, memcache.ErrCacheMiss
+	}
+	if !c.allow() {
+		return 0, ErrMemCUnavailable
+	}
+	newValue, err := c.client.Increment(key, delta)
+	c.done(err)
+	return newValue, err
+}
+
+// SetMulti sets several items, concurrently since memcached has no multi
+// set, and returns the first error. Services use it to backfill the items
+// a GetMulti missed.
+func (c *MemCClient) SetMulti(items []*memcache.Item) error {
+	return c.multi(items, c.Set)
+}
+
+// AddMulti is SetMulti with Add
+func (c *MemCClient) AddMulti(items []*memcache.Item) error {
+	return c.multi(items, c.Add)
+}
+
+func (c *MemCClient) multi(items []*memcache.Item, call func(item *memcache.Item) error) error {
+	if c == nil || len(items) == 0 {
+		return nil
+	}
+
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		firstErr error
+	)
+	sem := make(chan struct{}, multiConcurrency)
+	for _, item := range items {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(item *memcache.Item) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			if err := call(item); err != nil && err != memcache.ErrNotStored {
+				mu.Lock()
+				if firstErr == nil {
+					firstErr = err
+				}
+				mu.Unlock()
+			}
+		}(item)
+	}
+	wg.Wait()
+	return firstErr
+}
@@ -1,6 +1,7 @@
 package tune
 
 import (
+	"net/http"
 	"os"
 	"runtime/debug"
 	"strconv"
@@ -62,7 +63,7 @@ func GetMemCTimeout() int {
 }
 
 // Hack of memcache.New to avoid 'no server error' during running
-func NewMemCClient(server ...string) (*memcache.Client) {
+func NewMemCClient(server ...string) (*MemCClient) {
 	ss := new(memcache.ServerList)
 	err := ss.SetServers(server...)
 	if err != nil {
@@ -73,11 +74,26 @@ func NewMemCClient(server ...string) (*memcache.Client) {
 		memc_client := memcache.NewFromSelector(ss)
 		memc_client.Timeout = time.Second * time.Duration(GetMemCTimeout())
 		memc_client.MaxIdleConns = defaultMemCMaxIdleConns
-		return memc_client
+		return &MemCClient{client: memc_client}
 	}
 }
 
+// serveMetrics serves expvar metrics on /debug/vars if METRICS_PORT is set
+func serveMetrics()  {
+	port, ok := os.LookupEnv("METRICS_PORT")
+	if !ok || port == "" {
+		return
+	}
+
+	go func() {
+		err := http.ListenAndServe(":"+port, nil)
+		log.Error().Msgf("Tune: metrics server stopped: %v", err)
+	}()
+	log.Info().Msgf("Tune: serving metrics on port %s", port)
+}
+
 func Init() {
 	setLogLevel()
 	setGCPercent()
+	serveMetrics()
 }